@@ -6,6 +6,8 @@ import (
 
 	"github.com/wylu1037/go-micro-boilerplate/gateway/internal/bootstrap"
 	"github.com/wylu1037/go-micro-boilerplate/gateway/internal/module"
+	"github.com/wylu1037/go-micro-boilerplate/gateway/internal/stream"
+	"github.com/wylu1037/go-micro-boilerplate/gateway/internal/webhook"
 	sdklog "go.opentelemetry.io/otel/sdk/log"
 	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
 	sdktrace "go.opentelemetry.io/otel/sdk/trace"
@@ -19,7 +21,14 @@ func main() {
 		fx.Provide(module.NewLogger),
 		fx.Provide(module.NewTracer),
 		fx.Provide(bootstrap.NewMicroService),
+		fx.Provide(module.NewMicroAuth),
+		fx.Provide(module.NewRedis),
+		fx.Provide(module.NewRevocationStore),
+		fx.Provide(webhook.NewPaymentWebhook),
+		fx.Provide(stream.NewHub),
+		fx.Provide(stream.NewHandler),
 		fx.Provide(bootstrap.NewHTTPServer),
+		fx.Invoke(stream.RunHub),
 		fx.Invoke(bootstrap.Start),
 		fx.Invoke(
 			func(
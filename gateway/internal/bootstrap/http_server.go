@@ -24,12 +24,16 @@ import (
 
 	"github.com/wylu1037/go-micro-boilerplate/gateway/internal/config"
 	"github.com/wylu1037/go-micro-boilerplate/gateway/internal/middleware"
+	"github.com/wylu1037/go-micro-boilerplate/gateway/internal/stream"
+	"github.com/wylu1037/go-micro-boilerplate/gateway/internal/webhook"
 )
 
 func NewHTTPServer(
 	cfg *config.Config,
 	logger *zap.Logger,
 	microService micro.Service,
+	paymentWebhook *webhook.PaymentWebhook,
+	eventStream *stream.Handler,
 ) *http.Server {
 	r := chi.NewRouter()
 	// OpenTelemetry Trace Middleware
@@ -54,8 +58,6 @@ func NewHTTPServer(
 		AllowCredentials: false,
 		MaxAge:           3600,
 	}))
-	r.Use(chimiddleware.Timeout(60 * time.Second))
-
 	microRouter := registry.NewRouter(
 		router.WithRegistry(microService.Options().Registry),
 	)
@@ -93,9 +95,25 @@ func NewHTTPServer(
 		router.Use(spanNameFormatter)
 		router.Use(middleware.TraceContextInjector) // Bridge OTel context to go-micro metadata
 		router.Use(middleware.RateLimiter(cfg.RateLimit.RPS, cfg.RateLimit.Burst))
+		router.Use(middleware.ClientIPInjector)
+		router.Use(chimiddleware.Timeout(60 * time.Second))
 		router.Mount("/", microHandler)
 	})
 
+	// Provider callbacks are authenticated by their signature, not by a user token or the API rate limit
+	r.Route("/webhooks", func(router chi.Router) {
+		router.Use(spanNameFormatter)
+		router.Use(chimiddleware.Timeout(60 * time.Second))
+		router.Post("/payments/{provider}", paymentWebhook.ServeHTTP)
+	})
+
+	// Long-lived server-sent event streams; they authenticate the bearer token themselves and are exempt
+	// from the request timeout
+	r.Route("/stream", func(router chi.Router) {
+		router.Use(middleware.RateLimiter(cfg.RateLimit.RPS, cfg.RateLimit.Burst))
+		router.Get("/events", eventStream.ServeHTTP)
+	})
+
 	server := &http.Server{
 		Addr:         cfg.Service.Address,
 		Handler:      r,
@@ -3,6 +3,7 @@ package config
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/spf13/viper"
 )
@@ -12,6 +13,10 @@ type Config struct {
 	Log       LogConfig       `mapstructure:"log"`
 	RateLimit RateLimitConfig `mapstructure:"rate_limit"`
 	Telemetry TelemetryConfig `mapstructure:"telemetry"`
+	JWT       JWTConfig       `mapstructure:"jwt"`
+	Redis     RedisConfig     `mapstructure:"redis"`
+	Webhook   WebhookConfig   `mapstructure:"webhook"`
+	Stream    StreamConfig    `mapstructure:"stream"`
 }
 
 type ServiceConfig struct {
@@ -36,6 +41,32 @@ type TelemetryConfig struct {
 	Sampling float64 `mapstructure:"sampling"` // Sampling rate (0.0 - 1.0)
 }
 
+// JWTConfig lets the gateway mint service tokens for calls it makes on its own behalf, e.g. webhooks.
+type JWTConfig struct {
+	PrivateKey string `mapstructure:"privateKey"` // RSA private key (Base64 encoded)
+	PublicKey  string `mapstructure:"publicKey"`  // RSA public key (Base64 encoded)
+	Namespace  string `mapstructure:"namespace"`
+}
+
+// RedisConfig points at the Redis the services share token revocations through.
+type RedisConfig struct {
+	URL                string `mapstructure:"url"`                  // Leave empty to skip revocation checks; the gateway revokes nothing itself
+	RevocationFailOpen bool   `mapstructure:"revocation_fail_open"` // Accept tokens when revocations cannot be looked up instead of refusing them
+}
+
+type WebhookConfig struct {
+	PaymentSecrets map[string]string `mapstructure:"payment_secrets"` // HMAC signing secret per payment provider
+	Tolerance      time.Duration     `mapstructure:"tolerance"`       // Maximum age of a signed webhook timestamp
+}
+
+// StreamConfig tunes the server-sent event stream at /stream/events.
+type StreamConfig struct {
+	Heartbeat             time.Duration `mapstructure:"heartbeat"`                // Interval of keep-alive comments
+	MaxConnectionsPerUser int           `mapstructure:"max_connections_per_user"` // Open streams per user on one gateway instance
+	MaxSessions           int           `mapstructure:"max_sessions"`             // Sessions one stream may watch
+	BufferSize            int           `mapstructure:"buffer_size"`              // Events queued per stream before it is dropped as too slow
+}
+
 func Load() (*Config, error) {
 	v := viper.New()
 
@@ -56,6 +87,12 @@ func Load() (*Config, error) {
 	v.SetEnvKeyReplacer(strings.NewReplacer(".", "_"))
 	v.AutomaticEnv()
 
+	v.SetDefault("webhook.tolerance", 5*time.Minute)
+	v.SetDefault("stream.heartbeat", 15*time.Second)
+	v.SetDefault("stream.max_connections_per_user", 3)
+	v.SetDefault("stream.max_sessions", 10)
+	v.SetDefault("stream.buffer_size", 32)
+
 	var cfg Config
 	if err := v.Unmarshal(&cfg); err != nil {
 		return nil, fmt.Errorf("failed to unmarshal config: %w", err)
@@ -0,0 +1,12 @@
+package middleware
+
+import "net/http"
+
+// ClientIPInjector resolves the client IP once and passes it on as X-Real-IP, so go-micro handlers that
+// record where a request came from (e.g. identity sessions) see the caller rather than the gateway.
+func ClientIPInjector(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		r.Header.Set("X-Real-IP", getClientIP(r))
+		next.ServeHTTP(w, r)
+	})
+}
@@ -2,6 +2,7 @@ package middleware
 
 import (
 	"net/http"
+	"net/url"
 	"time"
 
 	chimiddleware "github.com/go-chi/chi/v5/middleware"
@@ -34,7 +35,7 @@ func Logging(logger *zap.Logger) func(next http.Handler) http.Handler {
 				zap.String("request_id", requestID),
 				zap.String("method", r.Method),
 				zap.String("path", r.URL.Path),
-				zap.String("query", r.URL.RawQuery),
+				zap.String("query", redactQuery(r.URL.Query())),
 				zap.Int("status", status),
 				zap.Duration("latency", latency),
 				zap.String("remote_addr", r.RemoteAddr),
@@ -43,3 +44,17 @@ func Logging(logger *zap.Logger) func(next http.Handler) http.Handler {
 		})
 	}
 }
+
+// redactedParams are query parameters carrying credentials, e.g. the access token of the event stream,
+// which browsers cannot send in a header.
+var redactedParams = []string{"access_token"}
+
+// redactQuery encodes query with the values of redactedParams replaced.
+func redactQuery(query url.Values) string {
+	for _, name := range redactedParams {
+		if query.Has(name) {
+			query.Set(name, "REDACTED")
+		}
+	}
+	return query.Encode()
+}
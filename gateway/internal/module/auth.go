@@ -0,0 +1,23 @@
+package module
+
+import (
+	"github.com/go-micro/plugins/v4/auth/jwt"
+	"go-micro.dev/v4/auth"
+
+	"github.com/wylu1037/go-micro-boilerplate/gateway/internal/config"
+)
+
+// NewMicroAuth creates the JWT auth used to mint service tokens for requests the gateway makes on its own
+// behalf; user requests keep forwarding the caller's Authorization header.
+func NewMicroAuth(cfg *config.Config) auth.Auth {
+	opts := []auth.Option{
+		auth.PublicKey(cfg.JWT.PublicKey),
+		auth.Namespace(cfg.JWT.Namespace),
+	}
+
+	if cfg.JWT.PrivateKey != "" {
+		opts = append(opts, auth.PrivateKey(cfg.JWT.PrivateKey))
+	}
+
+	return jwt.NewAuth(opts...)
+}
@@ -0,0 +1,39 @@
+package module
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/redis/go-redis/v9"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+
+	"github.com/wylu1037/go-micro-boilerplate/gateway/internal/config"
+	"github.com/wylu1037/go-micro-boilerplate/pkg/middleware"
+)
+
+// NewRedis connects to the Redis shared with the services. It returns a nil client if no URL is configured.
+func NewRedis(lc fx.Lifecycle, cfg *config.Config) (*redis.Client, error) {
+	if cfg.Redis.URL == "" {
+		return nil, nil
+	}
+
+	opt, err := redis.ParseURL(cfg.Redis.URL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid redis url: %w", err)
+	}
+	client := redis.NewClient(opt)
+
+	lc.Append(fx.Hook{
+		OnStop: func(_ context.Context) error {
+			return client.Close()
+		},
+	})
+
+	return client, nil
+}
+
+// NewRevocationStore checks tokens against the revocations the services share through Redis, if configured.
+func NewRevocationStore(client *redis.Client, cfg *config.Config, logger *zap.Logger) middleware.RevocationStore {
+	return middleware.NewRevocationStore(client, cfg.Redis.RevocationFailOpen, logger)
+}
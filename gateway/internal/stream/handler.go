@@ -0,0 +1,180 @@
+package stream
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"go-micro.dev/v4/auth"
+	"go.uber.org/zap"
+
+	"github.com/wylu1037/go-micro-boilerplate/gateway/internal/config"
+	"github.com/wylu1037/go-micro-boilerplate/pkg/middleware"
+)
+
+// reconnectDelay is how long clients wait before reconnecting after the stream drops.
+const reconnectDelay = 3 * time.Second
+
+// Handler serves a server-sent event stream with the caller's booking events and the inventory events of
+// the sessions listed in the session_id query parameter (repeated or comma separated). Browsers cannot set
+// headers on an EventSource, so the bearer token may also be passed as the access_token query parameter.
+// Tokens revoked by logout, session revocation or a password reset are rejected like invalid ones. The
+// token is checked again on every heartbeat, so a stream is closed soon after its token expires or is
+// revoked.
+type Handler struct {
+	hub         *Hub
+	auth        auth.Auth
+	revocations middleware.RevocationStore
+	cfg         config.StreamConfig
+	logger      *zap.Logger
+}
+
+func NewHandler(cfg *config.Config, hub *Hub, microAuth auth.Auth, revocations middleware.RevocationStore, logger *zap.Logger) *Handler {
+	return &Handler{hub: hub, auth: microAuth, revocations: revocations, cfg: cfg.Stream, logger: logger}
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	token := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if token == "" {
+		token = r.URL.Query().Get("access_token")
+	}
+	if token == "" {
+		writeError(w, http.StatusUnauthorized, "no auth token provided")
+		return
+	}
+	account, err := h.auth.Inspect(token)
+	if err != nil {
+		writeError(w, http.StatusUnauthorized, "invalid token")
+		return
+	}
+	revoked, revokedErr := h.revocations.IsRevoked(r.Context(), account)
+	if revokedErr != nil {
+		h.logger.Error("failed to check token revocation", zap.String("user_id", account.ID), zap.Error(revokedErr))
+		writeError(w, http.StatusInternalServerError, "failed to check token revocation")
+		return
+	}
+	if revoked {
+		writeError(w, http.StatusUnauthorized, "token has been revoked")
+		return
+	}
+
+	sessionIDs, err := h.sessionIDs(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	sub, err := h.hub.Subscribe(account.ID, sessionIDs)
+	if err != nil {
+		writeError(w, http.StatusTooManyRequests, err.Error())
+		return
+	}
+	defer h.hub.Unsubscribe(sub)
+
+	// The server's write timeout is meant for request/response calls; a stream stays open until either
+	// side leaves.
+	rc := http.NewResponseController(w)
+	if deadlineErr := rc.SetWriteDeadline(time.Time{}); deadlineErr != nil {
+		h.logger.Warn("failed to clear write deadline for event stream", zap.Error(deadlineErr))
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.Header().Set("X-Accel-Buffering", "no") // Keep reverse proxies such as nginx from buffering events
+	w.WriteHeader(http.StatusOK)
+
+	h.logger.Info("Event stream opened",
+		zap.String("user_id", account.ID),
+		zap.Strings("session_ids", sessionIDs),
+	)
+	defer h.logger.Info("Event stream closed", zap.String("user_id", account.ID))
+
+	heartbeat := time.NewTicker(h.cfg.Heartbeat)
+	defer heartbeat.Stop()
+
+	// Tell the client how long to wait before reconnecting, and that the stream is live.
+	if _, writeErr := fmt.Fprintf(w, "retry: %d\n\n: connected\n\n", reconnectDelay.Milliseconds()); writeErr != nil {
+		return
+	}
+	if flushErr := rc.Flush(); flushErr != nil {
+		return
+	}
+
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case <-sub.Dropped():
+			return
+		case <-heartbeat.C:
+			if reason := h.recheck(r.Context(), token, account); reason != "" {
+				h.logger.Info("Closing event stream", zap.String("user_id", account.ID), zap.String("reason", reason))
+				// Tell the client why, so it signs in again instead of reconnecting with the same token.
+				_, _ = fmt.Fprintf(w, "event: unauthorized\ndata: %q\n\n", reason)
+				_ = rc.Flush()
+				return
+			}
+			if _, writeErr := fmt.Fprint(w, ": heartbeat\n\n"); writeErr != nil {
+				return
+			}
+		case event := <-sub.Events():
+			if _, writeErr := fmt.Fprintf(w, "id: %s\nevent: %s\ndata: %s\n\n", event.ID, event.Name, event.Data); writeErr != nil {
+				return
+			}
+		}
+		if flushErr := rc.Flush(); flushErr != nil {
+			return
+		}
+	}
+}
+
+// recheck returns why the stream's token is no longer good, or "" if it still is. Inspect fails once the
+// token has expired. A failed revocation lookup closes the stream too, as it would refuse a new one.
+func (h *Handler) recheck(ctx context.Context, token string, account *auth.Account) string {
+	if _, err := h.auth.Inspect(token); err != nil {
+		return "token expired"
+	}
+	revoked, err := h.revocations.IsRevoked(ctx, account)
+	if err != nil {
+		h.logger.Error("failed to check token revocation", zap.String("user_id", account.ID), zap.Error(err))
+		return "failed to check token revocation"
+	}
+	if revoked {
+		return "token has been revoked"
+	}
+	return ""
+}
+
+// sessionIDs returns the sessions whose inventory the client wants to watch, at most MaxSessions of them.
+func (h *Handler) sessionIDs(r *http.Request) ([]string, error) {
+	var ids []string
+	seen := make(map[string]bool)
+	for _, value := range r.URL.Query()["session_id"] {
+		for id := range strings.SplitSeq(value, ",") {
+			id = strings.TrimSpace(id)
+			if id == "" || seen[id] {
+				continue
+			}
+			if uuid.Validate(id) != nil {
+				return nil, fmt.Errorf("invalid session_id %q", id)
+			}
+			seen[id] = true
+			ids = append(ids, id)
+		}
+	}
+	if len(ids) > h.cfg.MaxSessions {
+		return nil, fmt.Errorf("at most %d sessions can be watched per stream", h.cfg.MaxSessions)
+	}
+	return ids, nil
+}
+
+func writeError(w http.ResponseWriter, code int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(map[string]any{"error": message, "code": code})
+}
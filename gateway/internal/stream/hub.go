@@ -0,0 +1,238 @@
+package stream
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"go-micro.dev/v4"
+	"go-micro.dev/v4/broker"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/wylu1037/go-micro-boilerplate/gateway/internal/config"
+	bookingv1 "github.com/wylu1037/go-micro-boilerplate/gen/go/booking/v1"
+	catalogv1 "github.com/wylu1037/go-micro-boilerplate/gen/go/catalog/v1"
+)
+
+// Topics the hub fans out. Order events come from the booking outbox, inventory events from the catalog.
+var orderEventTopics = []string{
+	"ticketing.booking.order.created",
+	"ticketing.booking.order.paid",
+	"ticketing.booking.order.cancelled",
+	"ticketing.booking.order.refunded",
+	"ticketing.booking.order.show_changed",
+}
+
+const inventoryEventTopic = "ticketing.catalog.inventory.changed"
+
+// SSE event names sent to clients.
+const (
+	EventBooking   = "booking"
+	EventInventory = "inventory"
+)
+
+var ErrTooManyConnections = errors.New("too many open streams for this user")
+
+// Event is one message for a client; Data is the protojson encoded broker event.
+type Event struct {
+	ID   string
+	Name string
+	Data []byte
+}
+
+// Subscription receives the order events of one user and the inventory events of the sessions it watches.
+type Subscription struct {
+	userID     string
+	sessionIDs []string
+	events     chan Event
+	dropped    chan struct{}
+	dropOnce   sync.Once
+}
+
+// Events delivers the subscribed events.
+func (s *Subscription) Events() <-chan Event {
+	return s.events
+}
+
+// Dropped is closed when the hub gives up on the subscription because it did not keep up with its events.
+func (s *Subscription) Dropped() <-chan struct{} {
+	return s.dropped
+}
+
+func (s *Subscription) drop() {
+	s.dropOnce.Do(func() { close(s.dropped) })
+}
+
+// Hub subscribes to the broker once per gateway instance, without a queue so every instance sees every
+// event, and fans events out to the open streams of this instance.
+type Hub struct {
+	broker broker.Broker
+	cfg    config.StreamConfig
+	logger *zap.Logger
+
+	mu          sync.RWMutex
+	byUser      map[string]map[*Subscription]struct{}
+	bySession   map[string]map[*Subscription]struct{}
+	subscribers []broker.Subscriber
+}
+
+func NewHub(cfg *config.Config, microService micro.Service, logger *zap.Logger) *Hub {
+	return &Hub{
+		broker:    microService.Options().Broker,
+		cfg:       cfg.Stream,
+		logger:    logger,
+		byUser:    make(map[string]map[*Subscription]struct{}),
+		bySession: make(map[string]map[*Subscription]struct{}),
+	}
+}
+
+// RunHub connects the hub to the broker for the lifetime of the gateway.
+func RunHub(lc fx.Lifecycle, hub *Hub) {
+	lc.Append(fx.Hook{
+		OnStart: func(ctx context.Context) error {
+			return hub.Start()
+		},
+		OnStop: func(ctx context.Context) error {
+			hub.Stop()
+			return nil
+		},
+	})
+}
+
+func (h *Hub) Start() error {
+	if err := h.broker.Connect(); err != nil {
+		return err
+	}
+
+	for _, topic := range orderEventTopics {
+		sub, err := h.broker.Subscribe(topic, h.handleOrderEvent)
+		if err != nil {
+			return err
+		}
+		h.subscribers = append(h.subscribers, sub)
+	}
+
+	sub, err := h.broker.Subscribe(inventoryEventTopic, h.handleInventoryEvent)
+	if err != nil {
+		return err
+	}
+	h.subscribers = append(h.subscribers, sub)
+
+	h.logger.Info("Event stream hub started", zap.String("broker", h.broker.String()))
+	return nil
+}
+
+func (h *Hub) Stop() {
+	for _, sub := range h.subscribers {
+		if err := sub.Unsubscribe(); err != nil {
+			h.logger.Warn("failed to unsubscribe from broker", zap.String("topic", sub.Topic()), zap.Error(err))
+		}
+	}
+	h.subscribers = nil
+}
+
+// Subscribe opens a subscription for the user; at most MaxConnectionsPerUser may be open at once on this
+// gateway instance.
+func (h *Hub) Subscribe(userID string, sessionIDs []string) (*Subscription, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if len(h.byUser[userID]) >= h.cfg.MaxConnectionsPerUser {
+		return nil, ErrTooManyConnections
+	}
+
+	sub := &Subscription{
+		userID:     userID,
+		sessionIDs: sessionIDs,
+		events:     make(chan Event, h.cfg.BufferSize),
+		dropped:    make(chan struct{}),
+	}
+	addSubscription(h.byUser, userID, sub)
+	for _, sessionID := range sessionIDs {
+		addSubscription(h.bySession, sessionID, sub)
+	}
+	return sub, nil
+}
+
+func (h *Hub) Unsubscribe(sub *Subscription) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	removeSubscription(h.byUser, sub.userID, sub)
+	for _, sessionID := range sub.sessionIDs {
+		removeSubscription(h.bySession, sessionID, sub)
+	}
+}
+
+func (h *Hub) handleOrderEvent(p broker.Event) error {
+	event := &bookingv1.OrderEvent{}
+	data, err := decodeEvent(p.Message(), event)
+	if err != nil {
+		h.logger.Warn("failed to decode order event", zap.String("topic", p.Topic()), zap.Error(err))
+		return nil
+	}
+
+	h.fanOut(h.byUser, event.UserId, Event{ID: event.EventId, Name: EventBooking, Data: data})
+	return nil
+}
+
+func (h *Hub) handleInventoryEvent(p broker.Event) error {
+	event := &catalogv1.InventoryEvent{}
+	data, err := decodeEvent(p.Message(), event)
+	if err != nil {
+		h.logger.Warn("failed to decode inventory event", zap.String("topic", p.Topic()), zap.Error(err))
+		return nil
+	}
+
+	h.fanOut(h.bySession, event.SessionId, Event{ID: event.EventId, Name: EventInventory, Data: data})
+	return nil
+}
+
+// fanOut never blocks the broker: a subscription whose buffer is full is dropped, and its client is
+// expected to reconnect and reload what it shows.
+func (h *Hub) fanOut(index map[string]map[*Subscription]struct{}, key string, event Event) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	for sub := range index[key] {
+		select {
+		case sub.events <- event:
+		default:
+			h.logger.Warn("dropping slow event stream", zap.String("user_id", sub.userID))
+			sub.drop()
+		}
+	}
+}
+
+// decodeEvent reads a broker message into event and returns it re-encoded as protojson for clients.
+// Publishers in this repo send protojson; protobuf bodies are accepted too.
+func decodeEvent(msg *broker.Message, event proto.Message) ([]byte, error) {
+	var err error
+	switch msg.Header["Content-Type"] {
+	case "application/protobuf", "application/proto-rpc", "application/octet-stream":
+		err = proto.Unmarshal(msg.Body, event)
+	default:
+		err = protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(msg.Body, event)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return protojson.Marshal(event)
+}
+
+func addSubscription(index map[string]map[*Subscription]struct{}, key string, sub *Subscription) {
+	if index[key] == nil {
+		index[key] = make(map[*Subscription]struct{})
+	}
+	index[key][sub] = struct{}{}
+}
+
+func removeSubscription(index map[string]map[*Subscription]struct{}, key string, sub *Subscription) {
+	delete(index[key], sub)
+	if len(index[key]) == 0 {
+		delete(index, key)
+	}
+}
@@ -0,0 +1,191 @@
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"go-micro.dev/v4"
+	"go-micro.dev/v4/auth"
+	microerrors "go-micro.dev/v4/errors"
+	"go.uber.org/zap"
+
+	"github.com/wylu1037/go-micro-boilerplate/gateway/internal/config"
+	bookingv1 "github.com/wylu1037/go-micro-boilerplate/gen/go/booking/v1"
+	"github.com/wylu1037/go-micro-boilerplate/pkg/middleware"
+)
+
+const (
+	SignatureHeader = "X-Webhook-Signature"
+	TimestampHeader = "X-Webhook-Timestamp"
+
+	maxPaymentEventSize = 64 << 10
+)
+
+// paymentEvent is the notification body providers (or their adapters) post to the gateway.
+type paymentEvent struct {
+	EventID       string `json:"eventId"`
+	BookingID     string `json:"bookingId"`
+	PaymentID     string `json:"paymentId"`
+	TransactionID string `json:"transactionId"`
+	Status        string `json:"status"` // succeeded or failed
+	Amount        string `json:"amount"`
+	FailureReason string `json:"failureReason"`
+}
+
+// PaymentWebhook receives asynchronous payment results. Each request must carry the unix timestamp it was
+// signed at and a hex HMAC-SHA256 of "<timestamp>.<body>" keyed with the provider's secret; verified events
+// are forwarded to BookingService.HandlePaymentNotification, which applies each provider event ID once.
+type PaymentWebhook struct {
+	cfg         *config.Config
+	logger      *zap.Logger
+	auth        auth.Auth
+	bookingSvc  bookingv1.BookingService
+	serviceName string
+}
+
+func NewPaymentWebhook(
+	cfg *config.Config,
+	logger *zap.Logger,
+	microService micro.Service,
+	microAuth auth.Auth,
+) *PaymentWebhook {
+	return &PaymentWebhook{
+		cfg:         cfg,
+		logger:      logger,
+		auth:        microAuth,
+		bookingSvc:  bookingv1.NewBookingService("ticketing.booking", microService.Client()),
+		serviceName: cfg.Service.Name,
+	}
+}
+
+func (h *PaymentWebhook) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	provider := chi.URLParam(r, "provider")
+	secret, ok := h.cfg.Webhook.PaymentSecrets[provider]
+	if !ok || secret == "" {
+		writeError(w, http.StatusNotFound, "unknown payment provider")
+		return
+	}
+
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxPaymentEventSize))
+	if err != nil {
+		writeError(w, http.StatusRequestEntityTooLarge, "payload too large")
+		return
+	}
+
+	if verifyErr := h.verify(secret, r.Header.Get(TimestampHeader), r.Header.Get(SignatureHeader), body); verifyErr != "" {
+		h.logger.Warn("rejected payment webhook", zap.String("provider", provider), zap.String("reason", verifyErr))
+		writeError(w, http.StatusUnauthorized, verifyErr)
+		return
+	}
+
+	var event paymentEvent
+	if unmarshalErr := json.Unmarshal(body, &event); unmarshalErr != nil {
+		writeError(w, http.StatusBadRequest, "invalid payload")
+		return
+	}
+
+	status := bookingv1.PaymentNotificationStatus_PAYMENT_NOTIFICATION_STATUS_UNSPECIFIED
+	switch event.Status {
+	case "succeeded":
+		status = bookingv1.PaymentNotificationStatus_PAYMENT_NOTIFICATION_STATUS_SUCCEEDED
+	case "failed":
+		status = bookingv1.PaymentNotificationStatus_PAYMENT_NOTIFICATION_STATUS_FAILED
+	}
+
+	ctx, err := middleware.ServiceContext(r.Context(), h.auth, h.serviceName)
+	if err != nil {
+		h.logger.Error("failed to create service context", zap.Error(err))
+		writeError(w, http.StatusInternalServerError, "internal error")
+		return
+	}
+
+	resp, err := h.bookingSvc.HandlePaymentNotification(ctx, &bookingv1.HandlePaymentNotificationRequest{
+		Provider:      provider,
+		EventId:       event.EventID,
+		BookingId:     event.BookingID,
+		PaymentId:     event.PaymentID,
+		TransactionId: event.TransactionID,
+		Status:        status,
+		Amount:        event.Amount,
+		FailureReason: event.FailureReason,
+	})
+	if err != nil {
+		h.handleRPCError(w, provider, event.EventID, err)
+		return
+	}
+
+	h.logger.Info("payment webhook processed",
+		zap.String("provider", provider),
+		zap.String("event_id", event.EventID),
+		zap.String("booking_id", event.BookingID),
+		zap.Bool("duplicate", resp.Duplicate),
+	)
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(map[string]any{
+		"duplicate": resp.Duplicate,
+		"status":    resp.Status.String(),
+	})
+}
+
+// verify checks the timestamp and signature headers and returns a rejection reason, or "" if they are valid.
+func (h *PaymentWebhook) verify(secret, timestamp, signature string, body []byte) string {
+	if timestamp == "" || signature == "" {
+		return "missing signature"
+	}
+
+	signedAt, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return "invalid timestamp"
+	}
+	age := time.Since(time.Unix(signedAt, 0))
+	if age > h.cfg.Webhook.Tolerance || age < -h.cfg.Webhook.Tolerance {
+		return "timestamp outside tolerance"
+	}
+
+	given, err := hex.DecodeString(strings.TrimPrefix(signature, "sha256="))
+	if err != nil {
+		return "invalid signature"
+	}
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	if !hmac.Equal(given, mac.Sum(nil)) {
+		return "invalid signature"
+	}
+
+	return ""
+}
+
+// handleRPCError maps booking errors to HTTP statuses so providers stop retrying notifications that can
+// never be applied (4xx) but retry everything else (5xx), including auth failures caused by gateway config.
+func (h *PaymentWebhook) handleRPCError(w http.ResponseWriter, provider, eventID string, err error) {
+	microErr := microerrors.Parse(err.Error())
+	h.logger.Warn("failed to forward payment webhook",
+		zap.String("provider", provider),
+		zap.String("event_id", eventID),
+		zap.Error(err),
+	)
+
+	if microErr.Code == http.StatusBadRequest || microErr.Code == http.StatusNotFound {
+		writeError(w, http.StatusUnprocessableEntity, microErr.Detail)
+		return
+	}
+	writeError(w, http.StatusBadGateway, "failed to process payment notification")
+}
+
+func writeError(w http.ResponseWriter, code int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(map[string]any{"error": message, "code": code})
+}
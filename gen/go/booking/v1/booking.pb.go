@@ -28,11 +28,15 @@ const (
 type BookingStatus int32
 
 const (
-	BookingStatus_BOOKING_STATUS_UNSPECIFIED BookingStatus = 0
-	BookingStatus_BOOKING_STATUS_PENDING     BookingStatus = 1
-	BookingStatus_BOOKING_STATUS_PAID        BookingStatus = 2
-	BookingStatus_BOOKING_STATUS_CANCELLED   BookingStatus = 3
-	BookingStatus_BOOKING_STATUS_FAILED      BookingStatus = 4
+	BookingStatus_BOOKING_STATUS_UNSPECIFIED    BookingStatus = 0
+	BookingStatus_BOOKING_STATUS_PENDING        BookingStatus = 1
+	BookingStatus_BOOKING_STATUS_PAID           BookingStatus = 2
+	BookingStatus_BOOKING_STATUS_CANCELLED      BookingStatus = 3
+	BookingStatus_BOOKING_STATUS_FAILED         BookingStatus = 4
+	BookingStatus_BOOKING_STATUS_EXPIRED        BookingStatus = 5
+	BookingStatus_BOOKING_STATUS_REFUNDED       BookingStatus = 6
+	BookingStatus_BOOKING_STATUS_COMPLETED      BookingStatus = 7
+	BookingStatus_BOOKING_STATUS_REFUND_PENDING BookingStatus = 8 // Refund requested, waiting for the payment provider
 )
 
 // Enum value maps for BookingStatus.
@@ -43,13 +47,21 @@ var (
 		2: "BOOKING_STATUS_PAID",
 		3: "BOOKING_STATUS_CANCELLED",
 		4: "BOOKING_STATUS_FAILED",
+		5: "BOOKING_STATUS_EXPIRED",
+		6: "BOOKING_STATUS_REFUNDED",
+		7: "BOOKING_STATUS_COMPLETED",
+		8: "BOOKING_STATUS_REFUND_PENDING",
 	}
 	BookingStatus_value = map[string]int32{
-		"BOOKING_STATUS_UNSPECIFIED": 0,
-		"BOOKING_STATUS_PENDING":     1,
-		"BOOKING_STATUS_PAID":        2,
-		"BOOKING_STATUS_CANCELLED":   3,
-		"BOOKING_STATUS_FAILED":      4,
+		"BOOKING_STATUS_UNSPECIFIED":    0,
+		"BOOKING_STATUS_PENDING":        1,
+		"BOOKING_STATUS_PAID":           2,
+		"BOOKING_STATUS_CANCELLED":      3,
+		"BOOKING_STATUS_FAILED":         4,
+		"BOOKING_STATUS_EXPIRED":        5,
+		"BOOKING_STATUS_REFUNDED":       6,
+		"BOOKING_STATUS_COMPLETED":      7,
+		"BOOKING_STATUS_REFUND_PENDING": 8,
 	}
 )
 
@@ -80,6 +92,165 @@ func (BookingStatus) EnumDescriptor() ([]byte, []int) {
 	return file_booking_v1_booking_proto_rawDescGZIP(), []int{0}
 }
 
+type TicketStatus int32
+
+const (
+	TicketStatus_TICKET_STATUS_UNSPECIFIED TicketStatus = 0
+	TicketStatus_TICKET_STATUS_VALID       TicketStatus = 1
+	TicketStatus_TICKET_STATUS_USED        TicketStatus = 2
+	TicketStatus_TICKET_STATUS_REFUNDED    TicketStatus = 3
+)
+
+// Enum value maps for TicketStatus.
+var (
+	TicketStatus_name = map[int32]string{
+		0: "TICKET_STATUS_UNSPECIFIED",
+		1: "TICKET_STATUS_VALID",
+		2: "TICKET_STATUS_USED",
+		3: "TICKET_STATUS_REFUNDED",
+	}
+	TicketStatus_value = map[string]int32{
+		"TICKET_STATUS_UNSPECIFIED": 0,
+		"TICKET_STATUS_VALID":       1,
+		"TICKET_STATUS_USED":        2,
+		"TICKET_STATUS_REFUNDED":    3,
+	}
+)
+
+func (x TicketStatus) Enum() *TicketStatus {
+	p := new(TicketStatus)
+	*p = x
+	return p
+}
+
+func (x TicketStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TicketStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_booking_v1_booking_proto_enumTypes[1].Descriptor()
+}
+
+func (TicketStatus) Type() protoreflect.EnumType {
+	return &file_booking_v1_booking_proto_enumTypes[1]
+}
+
+func (x TicketStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TicketStatus.Descriptor instead.
+func (TicketStatus) EnumDescriptor() ([]byte, []int) {
+	return file_booking_v1_booking_proto_rawDescGZIP(), []int{1}
+}
+
+type CheckInResult int32
+
+const (
+	CheckInResult_CHECK_IN_RESULT_UNSPECIFIED   CheckInResult = 0
+	CheckInResult_CHECK_IN_RESULT_ACCEPTED      CheckInResult = 1
+	CheckInResult_CHECK_IN_RESULT_DUPLICATE     CheckInResult = 2 // Already checked in, see ticket.used_at and ticket.gate_id
+	CheckInResult_CHECK_IN_RESULT_INVALID       CheckInResult = 3 // Bad signature or unknown ticket
+	CheckInResult_CHECK_IN_RESULT_WRONG_SESSION CheckInResult = 4
+	CheckInResult_CHECK_IN_RESULT_REVOKED       CheckInResult = 5 // Ticket was refunded
+)
+
+// Enum value maps for CheckInResult.
+var (
+	CheckInResult_name = map[int32]string{
+		0: "CHECK_IN_RESULT_UNSPECIFIED",
+		1: "CHECK_IN_RESULT_ACCEPTED",
+		2: "CHECK_IN_RESULT_DUPLICATE",
+		3: "CHECK_IN_RESULT_INVALID",
+		4: "CHECK_IN_RESULT_WRONG_SESSION",
+		5: "CHECK_IN_RESULT_REVOKED",
+	}
+	CheckInResult_value = map[string]int32{
+		"CHECK_IN_RESULT_UNSPECIFIED":   0,
+		"CHECK_IN_RESULT_ACCEPTED":      1,
+		"CHECK_IN_RESULT_DUPLICATE":     2,
+		"CHECK_IN_RESULT_INVALID":       3,
+		"CHECK_IN_RESULT_WRONG_SESSION": 4,
+		"CHECK_IN_RESULT_REVOKED":       5,
+	}
+)
+
+func (x CheckInResult) Enum() *CheckInResult {
+	p := new(CheckInResult)
+	*p = x
+	return p
+}
+
+func (x CheckInResult) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CheckInResult) Descriptor() protoreflect.EnumDescriptor {
+	return file_booking_v1_booking_proto_enumTypes[2].Descriptor()
+}
+
+func (CheckInResult) Type() protoreflect.EnumType {
+	return &file_booking_v1_booking_proto_enumTypes[2]
+}
+
+func (x CheckInResult) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CheckInResult.Descriptor instead.
+func (CheckInResult) EnumDescriptor() ([]byte, []int) {
+	return file_booking_v1_booking_proto_rawDescGZIP(), []int{2}
+}
+
+type PaymentNotificationStatus int32
+
+const (
+	PaymentNotificationStatus_PAYMENT_NOTIFICATION_STATUS_UNSPECIFIED PaymentNotificationStatus = 0
+	PaymentNotificationStatus_PAYMENT_NOTIFICATION_STATUS_SUCCEEDED   PaymentNotificationStatus = 1
+	PaymentNotificationStatus_PAYMENT_NOTIFICATION_STATUS_FAILED      PaymentNotificationStatus = 2
+)
+
+// Enum value maps for PaymentNotificationStatus.
+var (
+	PaymentNotificationStatus_name = map[int32]string{
+		0: "PAYMENT_NOTIFICATION_STATUS_UNSPECIFIED",
+		1: "PAYMENT_NOTIFICATION_STATUS_SUCCEEDED",
+		2: "PAYMENT_NOTIFICATION_STATUS_FAILED",
+	}
+	PaymentNotificationStatus_value = map[string]int32{
+		"PAYMENT_NOTIFICATION_STATUS_UNSPECIFIED": 0,
+		"PAYMENT_NOTIFICATION_STATUS_SUCCEEDED":   1,
+		"PAYMENT_NOTIFICATION_STATUS_FAILED":      2,
+	}
+)
+
+func (x PaymentNotificationStatus) Enum() *PaymentNotificationStatus {
+	p := new(PaymentNotificationStatus)
+	*p = x
+	return p
+}
+
+func (x PaymentNotificationStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PaymentNotificationStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_booking_v1_booking_proto_enumTypes[3].Descriptor()
+}
+
+func (PaymentNotificationStatus) Type() protoreflect.EnumType {
+	return &file_booking_v1_booking_proto_enumTypes[3]
+}
+
+func (x PaymentNotificationStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PaymentNotificationStatus.Descriptor instead.
+func (PaymentNotificationStatus) EnumDescriptor() ([]byte, []int) {
+	return file_booking_v1_booking_proto_rawDescGZIP(), []int{3}
+}
+
 type Booking struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	BookingId     string                 `protobuf:"bytes,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
@@ -92,6 +263,7 @@ type Booking struct {
 	Status        BookingStatus          `protobuf:"varint,8,opt,name=status,proto3,enum=booking.v1.BookingStatus" json:"status,omitempty"`
 	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	SeatIds       []string               `protobuf:"bytes,11,rep,name=seat_ids,json=seatIds,proto3" json:"seat_ids,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -196,12 +368,22 @@ func (x *Booking) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Booking) GetSeatIds() []string {
+	if x != nil {
+		return x.SeatIds
+	}
+	return nil
+}
+
 type CreateBookingRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ShowId        string                 `protobuf:"bytes,1,opt,name=show_id,json=showId,proto3" json:"show_id,omitempty"`
-	SessionId     string                 `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
-	SeatAreaId    string                 `protobuf:"bytes,3,opt,name=seat_area_id,json=seatAreaId,proto3" json:"seat_area_id,omitempty"`
-	Quantity      int32                  `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	ShowId     string                 `protobuf:"bytes,1,opt,name=show_id,json=showId,proto3" json:"show_id,omitempty"`
+	SessionId  string                 `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	SeatAreaId string                 `protobuf:"bytes,3,opt,name=seat_area_id,json=seatAreaId,proto3" json:"seat_area_id,omitempty"`
+	Quantity   int32                  `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"` // May be 0 when seat_ids is set
+	// Specific seats to book instead of any quantity seats in the area
+	SeatIds       []string `protobuf:"bytes,5,rep,name=seat_ids,json=seatIds,proto3" json:"seat_ids,omitempty"`
+	HoldToken     string   `protobuf:"bytes,6,opt,name=hold_token,json=holdToken,proto3" json:"hold_token,omitempty"` // Seat hold from CatalogService.HoldSeats to convert into this booking
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -264,6 +446,20 @@ func (x *CreateBookingRequest) GetQuantity() int32 {
 	return 0
 }
 
+func (x *CreateBookingRequest) GetSeatIds() []string {
+	if x != nil {
+		return x.SeatIds
+	}
+	return nil
+}
+
+func (x *CreateBookingRequest) GetHoldToken() string {
+	if x != nil {
+		return x.HoldToken
+	}
+	return ""
+}
+
 type CreateBookingResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Booking       *Booking               `protobuf:"bytes,1,opt,name=booking,proto3" json:"booking,omitempty"`
@@ -511,7 +707,7 @@ func (x *ListBookingsResponse) GetPagination() *v1.PaginationResponse {
 type ProcessPaymentRequest struct {
 	state     protoimpl.MessageState `protogen:"open.v1"`
 	BookingId string                 `protobuf:"bytes,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
-	// Simple payment simulation, might add payment token later
+	// Payment provider to charge through, e.g. "fake" (always approves) or "fail" (always declines)
 	PaymentMethod string `protobuf:"bytes,2,opt,name=payment_method,json=paymentMethod,proto3" json:"payment_method,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -621,74 +817,1542 @@ func (x *ProcessPaymentResponse) GetTransactionId() string {
 	return ""
 }
 
-var File_booking_v1_booking_proto protoreflect.FileDescriptor
+type CancelBookingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BookingId     string                 `protobuf:"bytes,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_booking_v1_booking_proto_rawDesc = "" +
-	"\n" +
-	"\x18booking/v1/booking.proto\x12\n" +
-	"booking.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1acommon/v1/pagination.proto\"\x81\x03\n" +
-	"\aBooking\x12\x1d\n" +
-	"\n" +
-	"booking_id\x18\x01 \x01(\tR\tbookingId\x12\x17\n" +
-	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x17\n" +
-	"\ashow_id\x18\x03 \x01(\tR\x06showId\x12\x1d\n" +
-	"\n" +
-	"session_id\x18\x04 \x01(\tR\tsessionId\x12 \n" +
-	"\fseat_area_id\x18\x05 \x01(\tR\n" +
-	"seatAreaId\x12\x1a\n" +
-	"\bquantity\x18\x06 \x01(\x05R\bquantity\x12\x1f\n" +
-	"\vtotal_price\x18\a \x01(\tR\n" +
-	"totalPrice\x121\n" +
-	"\x06status\x18\b \x01(\x0e2\x19.booking.v1.BookingStatusR\x06status\x129\n" +
-	"\n" +
-	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
-	"\n" +
-	"updated_at\x18\n" +
-	" \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xb3\x01\n" +
-	"\x14CreateBookingRequest\x12!\n" +
-	"\ashow_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06showId\x12'\n" +
-	"\n" +
-	"session_id\x18\x02 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\tsessionId\x12*\n" +
-	"\fseat_area_id\x18\x03 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\n" +
-	"seatAreaId\x12#\n" +
-	"\bquantity\x18\x04 \x01(\x05B\a\xbaH\x04\x1a\x02 \x00R\bquantity\"F\n" +
-	"\x15CreateBookingResponse\x12-\n" +
-	"\abooking\x18\x01 \x01(\v2\x13.booking.v1.BookingR\abooking\"<\n" +
-	"\x11GetBookingRequest\x12'\n" +
-	"\n" +
-	"booking_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\tbookingId\"C\n" +
-	"\x12GetBookingResponse\x12-\n" +
-	"\abooking\x18\x01 \x01(\v2\x13.booking.v1.BookingR\abooking\"\x89\x01\n" +
-	"\x13ListBookingsRequest\x12\x12\n" +
-	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x1b\n" +
-	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x126\n" +
-	"\x06status\x18\x03 \x01(\x0e2\x19.booking.v1.BookingStatusH\x00R\x06status\x88\x01\x01B\t\n" +
-	"\a_status\"\x86\x01\n" +
-	"\x14ListBookingsResponse\x12/\n" +
-	"\bbookings\x18\x01 \x03(\v2\x13.booking.v1.BookingR\bbookings\x12=\n" +
-	"\n" +
-	"pagination\x18\x02 \x01(\v2\x1d.common.v1.PaginationResponseR\n" +
-	"pagination\"g\n" +
-	"\x15ProcessPaymentRequest\x12'\n" +
-	"\n" +
-	"booking_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\tbookingId\x12%\n" +
-	"\x0epayment_method\x18\x02 \x01(\tR\rpaymentMethod\"s\n" +
-	"\x16ProcessPaymentResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\x12%\n" +
-	"\x0etransaction_id\x18\x03 \x01(\tR\rtransactionId*\x9d\x01\n" +
+func (x *CancelBookingRequest) Reset() {
+	*x = CancelBookingRequest{}
+	mi := &file_booking_v1_booking_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelBookingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelBookingRequest) ProtoMessage() {}
+
+func (x *CancelBookingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_v1_booking_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelBookingRequest.ProtoReflect.Descriptor instead.
+func (*CancelBookingRequest) Descriptor() ([]byte, []int) {
+	return file_booking_v1_booking_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *CancelBookingRequest) GetBookingId() string {
+	if x != nil {
+		return x.BookingId
+	}
+	return ""
+}
+
+type CancelBookingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Booking       *Booking               `protobuf:"bytes,1,opt,name=booking,proto3" json:"booking,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelBookingResponse) Reset() {
+	*x = CancelBookingResponse{}
+	mi := &file_booking_v1_booking_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelBookingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelBookingResponse) ProtoMessage() {}
+
+func (x *CancelBookingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_v1_booking_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelBookingResponse.ProtoReflect.Descriptor instead.
+func (*CancelBookingResponse) Descriptor() ([]byte, []int) {
+	return file_booking_v1_booking_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *CancelBookingResponse) GetBooking() *Booking {
+	if x != nil {
+		return x.Booking
+	}
+	return nil
+}
+
+type RefundBookingRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	BookingId string                 `protobuf:"bytes,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	// Amount to refund; empty refunds the maximum the refund policy allows
+	Amount        string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefundBookingRequest) Reset() {
+	*x = RefundBookingRequest{}
+	mi := &file_booking_v1_booking_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefundBookingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefundBookingRequest) ProtoMessage() {}
+
+func (x *RefundBookingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_v1_booking_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefundBookingRequest.ProtoReflect.Descriptor instead.
+func (*RefundBookingRequest) Descriptor() ([]byte, []int) {
+	return file_booking_v1_booking_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *RefundBookingRequest) GetBookingId() string {
+	if x != nil {
+		return x.BookingId
+	}
+	return ""
+}
+
+func (x *RefundBookingRequest) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+type RefundBookingResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Booking        *Booking               `protobuf:"bytes,1,opt,name=booking,proto3" json:"booking,omitempty"`
+	RefundedAmount string                 `protobuf:"bytes,2,opt,name=refunded_amount,json=refundedAmount,proto3" json:"refunded_amount,omitempty"` // Amount being refunded
+	TransactionId  string                 `protobuf:"bytes,3,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *RefundBookingResponse) Reset() {
+	*x = RefundBookingResponse{}
+	mi := &file_booking_v1_booking_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefundBookingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefundBookingResponse) ProtoMessage() {}
+
+func (x *RefundBookingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_v1_booking_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefundBookingResponse.ProtoReflect.Descriptor instead.
+func (*RefundBookingResponse) Descriptor() ([]byte, []int) {
+	return file_booking_v1_booking_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *RefundBookingResponse) GetBooking() *Booking {
+	if x != nil {
+		return x.Booking
+	}
+	return nil
+}
+
+func (x *RefundBookingResponse) GetRefundedAmount() string {
+	if x != nil {
+		return x.RefundedAmount
+	}
+	return ""
+}
+
+func (x *RefundBookingResponse) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+type BookingStatusChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FromStatus    BookingStatus          `protobuf:"varint,1,opt,name=from_status,json=fromStatus,proto3,enum=booking.v1.BookingStatus" json:"from_status,omitempty"` // UNSPECIFIED for the creation of the booking
+	ToStatus      BookingStatus          `protobuf:"varint,2,opt,name=to_status,json=toStatus,proto3,enum=booking.v1.BookingStatus" json:"to_status,omitempty"`
+	ActorType     string                 `protobuf:"bytes,3,opt,name=actor_type,json=actorType,proto3" json:"actor_type,omitempty"` // user, system or provider
+	ActorId       string                 `protobuf:"bytes,4,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	ChangedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=changed_at,json=changedAt,proto3" json:"changed_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BookingStatusChange) Reset() {
+	*x = BookingStatusChange{}
+	mi := &file_booking_v1_booking_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BookingStatusChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BookingStatusChange) ProtoMessage() {}
+
+func (x *BookingStatusChange) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_v1_booking_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BookingStatusChange.ProtoReflect.Descriptor instead.
+func (*BookingStatusChange) Descriptor() ([]byte, []int) {
+	return file_booking_v1_booking_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *BookingStatusChange) GetFromStatus() BookingStatus {
+	if x != nil {
+		return x.FromStatus
+	}
+	return BookingStatus_BOOKING_STATUS_UNSPECIFIED
+}
+
+func (x *BookingStatusChange) GetToStatus() BookingStatus {
+	if x != nil {
+		return x.ToStatus
+	}
+	return BookingStatus_BOOKING_STATUS_UNSPECIFIED
+}
+
+func (x *BookingStatusChange) GetActorType() string {
+	if x != nil {
+		return x.ActorType
+	}
+	return ""
+}
+
+func (x *BookingStatusChange) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+func (x *BookingStatusChange) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *BookingStatusChange) GetChangedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ChangedAt
+	}
+	return nil
+}
+
+type GetBookingHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BookingId     string                 `protobuf:"bytes,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBookingHistoryRequest) Reset() {
+	*x = GetBookingHistoryRequest{}
+	mi := &file_booking_v1_booking_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBookingHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBookingHistoryRequest) ProtoMessage() {}
+
+func (x *GetBookingHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_v1_booking_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBookingHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetBookingHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_booking_v1_booking_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetBookingHistoryRequest) GetBookingId() string {
+	if x != nil {
+		return x.BookingId
+	}
+	return ""
+}
+
+type GetBookingHistoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Changes       []*BookingStatusChange `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBookingHistoryResponse) Reset() {
+	*x = GetBookingHistoryResponse{}
+	mi := &file_booking_v1_booking_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBookingHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBookingHistoryResponse) ProtoMessage() {}
+
+func (x *GetBookingHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_v1_booking_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBookingHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetBookingHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_booking_v1_booking_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetBookingHistoryResponse) GetChanges() []*BookingStatusChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+type Ticket struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TicketId      string                 `protobuf:"bytes,1,opt,name=ticket_id,json=ticketId,proto3" json:"ticket_id,omitempty"`
+	TicketNo      string                 `protobuf:"bytes,2,opt,name=ticket_no,json=ticketNo,proto3" json:"ticket_no,omitempty"`
+	BookingId     string                 `protobuf:"bytes,3,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	SessionId     string                 `protobuf:"bytes,4,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	SeatId        string                 `protobuf:"bytes,5,opt,name=seat_id,json=seatId,proto3" json:"seat_id,omitempty"` // Empty when the seat area has no seat map
+	QrCode        string                 `protobuf:"bytes,6,opt,name=qr_code,json=qrCode,proto3" json:"qr_code,omitempty"` // Signed payload to render as a QR code
+	Status        TicketStatus           `protobuf:"varint,7,opt,name=status,proto3,enum=booking.v1.TicketStatus" json:"status,omitempty"`
+	UsedAt        *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=used_at,json=usedAt,proto3" json:"used_at,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	GateId        string                 `protobuf:"bytes,10,opt,name=gate_id,json=gateId,proto3" json:"gate_id,omitempty"` // Gate the ticket was checked in at
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Ticket) Reset() {
+	*x = Ticket{}
+	mi := &file_booking_v1_booking_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Ticket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Ticket) ProtoMessage() {}
+
+func (x *Ticket) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_v1_booking_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Ticket.ProtoReflect.Descriptor instead.
+func (*Ticket) Descriptor() ([]byte, []int) {
+	return file_booking_v1_booking_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *Ticket) GetTicketId() string {
+	if x != nil {
+		return x.TicketId
+	}
+	return ""
+}
+
+func (x *Ticket) GetTicketNo() string {
+	if x != nil {
+		return x.TicketNo
+	}
+	return ""
+}
+
+func (x *Ticket) GetBookingId() string {
+	if x != nil {
+		return x.BookingId
+	}
+	return ""
+}
+
+func (x *Ticket) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *Ticket) GetSeatId() string {
+	if x != nil {
+		return x.SeatId
+	}
+	return ""
+}
+
+func (x *Ticket) GetQrCode() string {
+	if x != nil {
+		return x.QrCode
+	}
+	return ""
+}
+
+func (x *Ticket) GetStatus() TicketStatus {
+	if x != nil {
+		return x.Status
+	}
+	return TicketStatus_TICKET_STATUS_UNSPECIFIED
+}
+
+func (x *Ticket) GetUsedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UsedAt
+	}
+	return nil
+}
+
+func (x *Ticket) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Ticket) GetGateId() string {
+	if x != nil {
+		return x.GateId
+	}
+	return ""
+}
+
+type ListTicketsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTicketsRequest) Reset() {
+	*x = ListTicketsRequest{}
+	mi := &file_booking_v1_booking_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTicketsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTicketsRequest) ProtoMessage() {}
+
+func (x *ListTicketsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_v1_booking_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTicketsRequest.ProtoReflect.Descriptor instead.
+func (*ListTicketsRequest) Descriptor() ([]byte, []int) {
+	return file_booking_v1_booking_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ListTicketsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListTicketsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListTicketsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tickets       []*Ticket              `protobuf:"bytes,1,rep,name=tickets,proto3" json:"tickets,omitempty"`
+	Pagination    *v1.PaginationResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTicketsResponse) Reset() {
+	*x = ListTicketsResponse{}
+	mi := &file_booking_v1_booking_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTicketsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTicketsResponse) ProtoMessage() {}
+
+func (x *ListTicketsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_v1_booking_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTicketsResponse.ProtoReflect.Descriptor instead.
+func (*ListTicketsResponse) Descriptor() ([]byte, []int) {
+	return file_booking_v1_booking_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ListTicketsResponse) GetTickets() []*Ticket {
+	if x != nil {
+		return x.Tickets
+	}
+	return nil
+}
+
+func (x *ListTicketsResponse) GetPagination() *v1.PaginationResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type GetTicketRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TicketId      string                 `protobuf:"bytes,1,opt,name=ticket_id,json=ticketId,proto3" json:"ticket_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTicketRequest) Reset() {
+	*x = GetTicketRequest{}
+	mi := &file_booking_v1_booking_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTicketRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTicketRequest) ProtoMessage() {}
+
+func (x *GetTicketRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_v1_booking_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTicketRequest.ProtoReflect.Descriptor instead.
+func (*GetTicketRequest) Descriptor() ([]byte, []int) {
+	return file_booking_v1_booking_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetTicketRequest) GetTicketId() string {
+	if x != nil {
+		return x.TicketId
+	}
+	return ""
+}
+
+type GetTicketResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ticket        *Ticket                `protobuf:"bytes,1,opt,name=ticket,proto3" json:"ticket,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTicketResponse) Reset() {
+	*x = GetTicketResponse{}
+	mi := &file_booking_v1_booking_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTicketResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTicketResponse) ProtoMessage() {}
+
+func (x *GetTicketResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_v1_booking_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTicketResponse.ProtoReflect.Descriptor instead.
+func (*GetTicketResponse) Descriptor() ([]byte, []int) {
+	return file_booking_v1_booking_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetTicketResponse) GetTicket() *Ticket {
+	if x != nil {
+		return x.Ticket
+	}
+	return nil
+}
+
+type CheckInOutcome struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Result        CheckInResult          `protobuf:"varint,1,opt,name=result,proto3,enum=booking.v1.CheckInResult" json:"result,omitempty"`
+	Ticket        *Ticket                `protobuf:"bytes,2,opt,name=ticket,proto3" json:"ticket,omitempty"` // Unset when the payload does not match a ticket
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckInOutcome) Reset() {
+	*x = CheckInOutcome{}
+	mi := &file_booking_v1_booking_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckInOutcome) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckInOutcome) ProtoMessage() {}
+
+func (x *CheckInOutcome) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_v1_booking_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckInOutcome.ProtoReflect.Descriptor instead.
+func (*CheckInOutcome) Descriptor() ([]byte, []int) {
+	return file_booking_v1_booking_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *CheckInOutcome) GetResult() CheckInResult {
+	if x != nil {
+		return x.Result
+	}
+	return CheckInResult_CHECK_IN_RESULT_UNSPECIFIED
+}
+
+func (x *CheckInOutcome) GetTicket() *Ticket {
+	if x != nil {
+		return x.Ticket
+	}
+	return nil
+}
+
+type GetTicketVerificationKeyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTicketVerificationKeyRequest) Reset() {
+	*x = GetTicketVerificationKeyRequest{}
+	mi := &file_booking_v1_booking_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTicketVerificationKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTicketVerificationKeyRequest) ProtoMessage() {}
+
+func (x *GetTicketVerificationKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_v1_booking_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTicketVerificationKeyRequest.ProtoReflect.Descriptor instead.
+func (*GetTicketVerificationKeyRequest) Descriptor() ([]byte, []int) {
+	return file_booking_v1_booking_proto_rawDescGZIP(), []int{22}
+}
+
+type GetTicketVerificationKeyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Algorithm     string                 `protobuf:"bytes,1,opt,name=algorithm,proto3" json:"algorithm,omitempty"`                  // Always "Ed25519"
+	PublicKey     string                 `protobuf:"bytes,2,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"` // Base64
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTicketVerificationKeyResponse) Reset() {
+	*x = GetTicketVerificationKeyResponse{}
+	mi := &file_booking_v1_booking_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTicketVerificationKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTicketVerificationKeyResponse) ProtoMessage() {}
+
+func (x *GetTicketVerificationKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_v1_booking_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTicketVerificationKeyResponse.ProtoReflect.Descriptor instead.
+func (*GetTicketVerificationKeyResponse) Descriptor() ([]byte, []int) {
+	return file_booking_v1_booking_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GetTicketVerificationKeyResponse) GetAlgorithm() string {
+	if x != nil {
+		return x.Algorithm
+	}
+	return ""
+}
+
+func (x *GetTicketVerificationKeyResponse) GetPublicKey() string {
+	if x != nil {
+		return x.PublicKey
+	}
+	return ""
+}
+
+type ValidateTicketRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	QrPayload     string                 `protobuf:"bytes,1,opt,name=qr_payload,json=qrPayload,proto3" json:"qr_payload,omitempty"`
+	SessionId     string                 `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidateTicketRequest) Reset() {
+	*x = ValidateTicketRequest{}
+	mi := &file_booking_v1_booking_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidateTicketRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateTicketRequest) ProtoMessage() {}
+
+func (x *ValidateTicketRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_v1_booking_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateTicketRequest.ProtoReflect.Descriptor instead.
+func (*ValidateTicketRequest) Descriptor() ([]byte, []int) {
+	return file_booking_v1_booking_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ValidateTicketRequest) GetQrPayload() string {
+	if x != nil {
+		return x.QrPayload
+	}
+	return ""
+}
+
+func (x *ValidateTicketRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+type ValidateTicketResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Outcome       *CheckInOutcome        `protobuf:"bytes,1,opt,name=outcome,proto3" json:"outcome,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidateTicketResponse) Reset() {
+	*x = ValidateTicketResponse{}
+	mi := &file_booking_v1_booking_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidateTicketResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateTicketResponse) ProtoMessage() {}
+
+func (x *ValidateTicketResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_v1_booking_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateTicketResponse.ProtoReflect.Descriptor instead.
+func (*ValidateTicketResponse) Descriptor() ([]byte, []int) {
+	return file_booking_v1_booking_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ValidateTicketResponse) GetOutcome() *CheckInOutcome {
+	if x != nil {
+		return x.Outcome
+	}
+	return nil
+}
+
+type CheckInTicketRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	QrPayload     string                 `protobuf:"bytes,1,opt,name=qr_payload,json=qrPayload,proto3" json:"qr_payload,omitempty"`
+	SessionId     string                 `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	GateId        string                 `protobuf:"bytes,3,opt,name=gate_id,json=gateId,proto3" json:"gate_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckInTicketRequest) Reset() {
+	*x = CheckInTicketRequest{}
+	mi := &file_booking_v1_booking_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckInTicketRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckInTicketRequest) ProtoMessage() {}
+
+func (x *CheckInTicketRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_v1_booking_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckInTicketRequest.ProtoReflect.Descriptor instead.
+func (*CheckInTicketRequest) Descriptor() ([]byte, []int) {
+	return file_booking_v1_booking_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *CheckInTicketRequest) GetQrPayload() string {
+	if x != nil {
+		return x.QrPayload
+	}
+	return ""
+}
+
+func (x *CheckInTicketRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *CheckInTicketRequest) GetGateId() string {
+	if x != nil {
+		return x.GateId
+	}
+	return ""
+}
+
+type CheckInTicketResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Outcome       *CheckInOutcome        `protobuf:"bytes,1,opt,name=outcome,proto3" json:"outcome,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckInTicketResponse) Reset() {
+	*x = CheckInTicketResponse{}
+	mi := &file_booking_v1_booking_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckInTicketResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckInTicketResponse) ProtoMessage() {}
+
+func (x *CheckInTicketResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_v1_booking_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckInTicketResponse.ProtoReflect.Descriptor instead.
+func (*CheckInTicketResponse) Descriptor() ([]byte, []int) {
+	return file_booking_v1_booking_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *CheckInTicketResponse) GetOutcome() *CheckInOutcome {
+	if x != nil {
+		return x.Outcome
+	}
+	return nil
+}
+
+type CheckInScan struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	QrPayload     string                 `protobuf:"bytes,1,opt,name=qr_payload,json=qrPayload,proto3" json:"qr_payload,omitempty"`
+	ScannedAt     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=scanned_at,json=scannedAt,proto3" json:"scanned_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckInScan) Reset() {
+	*x = CheckInScan{}
+	mi := &file_booking_v1_booking_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckInScan) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckInScan) ProtoMessage() {}
+
+func (x *CheckInScan) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_v1_booking_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckInScan.ProtoReflect.Descriptor instead.
+func (*CheckInScan) Descriptor() ([]byte, []int) {
+	return file_booking_v1_booking_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *CheckInScan) GetQrPayload() string {
+	if x != nil {
+		return x.QrPayload
+	}
+	return ""
+}
+
+func (x *CheckInScan) GetScannedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ScannedAt
+	}
+	return nil
+}
+
+type SyncCheckInsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	GateId        string                 `protobuf:"bytes,2,opt,name=gate_id,json=gateId,proto3" json:"gate_id,omitempty"`
+	Scans         []*CheckInScan         `protobuf:"bytes,3,rep,name=scans,proto3" json:"scans,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SyncCheckInsRequest) Reset() {
+	*x = SyncCheckInsRequest{}
+	mi := &file_booking_v1_booking_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyncCheckInsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncCheckInsRequest) ProtoMessage() {}
+
+func (x *SyncCheckInsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_v1_booking_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncCheckInsRequest.ProtoReflect.Descriptor instead.
+func (*SyncCheckInsRequest) Descriptor() ([]byte, []int) {
+	return file_booking_v1_booking_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *SyncCheckInsRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *SyncCheckInsRequest) GetGateId() string {
+	if x != nil {
+		return x.GateId
+	}
+	return ""
+}
+
+func (x *SyncCheckInsRequest) GetScans() []*CheckInScan {
+	if x != nil {
+		return x.Scans
+	}
+	return nil
+}
+
+type SyncCheckInsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Outcomes      []*CheckInOutcome      `protobuf:"bytes,1,rep,name=outcomes,proto3" json:"outcomes,omitempty"` // In the order of the request's scans
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SyncCheckInsResponse) Reset() {
+	*x = SyncCheckInsResponse{}
+	mi := &file_booking_v1_booking_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyncCheckInsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncCheckInsResponse) ProtoMessage() {}
+
+func (x *SyncCheckInsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_v1_booking_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncCheckInsResponse.ProtoReflect.Descriptor instead.
+func (*SyncCheckInsResponse) Descriptor() ([]byte, []int) {
+	return file_booking_v1_booking_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *SyncCheckInsResponse) GetOutcomes() []*CheckInOutcome {
+	if x != nil {
+		return x.Outcomes
+	}
+	return nil
+}
+
+type HandlePaymentNotificationRequest struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Provider      string                    `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`              // Payment method the provider is registered under
+	EventId       string                    `protobuf:"bytes,2,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"` // Provider event ID, notifications are applied once per event
+	BookingId     string                    `protobuf:"bytes,3,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	PaymentId     string                    `protobuf:"bytes,4,opt,name=payment_id,json=paymentId,proto3" json:"payment_id,omitempty"` // Our payment attempt ID if the provider echoes it back
+	TransactionId string                    `protobuf:"bytes,5,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	Status        PaymentNotificationStatus `protobuf:"varint,6,opt,name=status,proto3,enum=booking.v1.PaymentNotificationStatus" json:"status,omitempty"`
+	Amount        string                    `protobuf:"bytes,7,opt,name=amount,proto3" json:"amount,omitempty"`
+	FailureReason string                    `protobuf:"bytes,8,opt,name=failure_reason,json=failureReason,proto3" json:"failure_reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HandlePaymentNotificationRequest) Reset() {
+	*x = HandlePaymentNotificationRequest{}
+	mi := &file_booking_v1_booking_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HandlePaymentNotificationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HandlePaymentNotificationRequest) ProtoMessage() {}
+
+func (x *HandlePaymentNotificationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_v1_booking_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HandlePaymentNotificationRequest.ProtoReflect.Descriptor instead.
+func (*HandlePaymentNotificationRequest) Descriptor() ([]byte, []int) {
+	return file_booking_v1_booking_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *HandlePaymentNotificationRequest) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *HandlePaymentNotificationRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *HandlePaymentNotificationRequest) GetBookingId() string {
+	if x != nil {
+		return x.BookingId
+	}
+	return ""
+}
+
+func (x *HandlePaymentNotificationRequest) GetPaymentId() string {
+	if x != nil {
+		return x.PaymentId
+	}
+	return ""
+}
+
+func (x *HandlePaymentNotificationRequest) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+func (x *HandlePaymentNotificationRequest) GetStatus() PaymentNotificationStatus {
+	if x != nil {
+		return x.Status
+	}
+	return PaymentNotificationStatus_PAYMENT_NOTIFICATION_STATUS_UNSPECIFIED
+}
+
+func (x *HandlePaymentNotificationRequest) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *HandlePaymentNotificationRequest) GetFailureReason() string {
+	if x != nil {
+		return x.FailureReason
+	}
+	return ""
+}
+
+type HandlePaymentNotificationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Duplicate     bool                   `protobuf:"varint,1,opt,name=duplicate,proto3" json:"duplicate,omitempty"`                         // The event had already been applied
+	Status        BookingStatus          `protobuf:"varint,2,opt,name=status,proto3,enum=booking.v1.BookingStatus" json:"status,omitempty"` // Booking status after applying the notification
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HandlePaymentNotificationResponse) Reset() {
+	*x = HandlePaymentNotificationResponse{}
+	mi := &file_booking_v1_booking_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HandlePaymentNotificationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HandlePaymentNotificationResponse) ProtoMessage() {}
+
+func (x *HandlePaymentNotificationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_v1_booking_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HandlePaymentNotificationResponse.ProtoReflect.Descriptor instead.
+func (*HandlePaymentNotificationResponse) Descriptor() ([]byte, []int) {
+	return file_booking_v1_booking_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *HandlePaymentNotificationResponse) GetDuplicate() bool {
+	if x != nil {
+		return x.Duplicate
+	}
+	return false
+}
+
+func (x *HandlePaymentNotificationResponse) GetStatus() BookingStatus {
+	if x != nil {
+		return x.Status
+	}
+	return BookingStatus_BOOKING_STATUS_UNSPECIFIED
+}
+
+var File_booking_v1_booking_proto protoreflect.FileDescriptor
+
+const file_booking_v1_booking_proto_rawDesc = "" +
+	"\n" +
+	"\x18booking/v1/booking.proto\x12\n" +
+	"booking.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1acommon/v1/pagination.proto\"\x9c\x03\n" +
+	"\aBooking\x12\x1d\n" +
+	"\n" +
+	"booking_id\x18\x01 \x01(\tR\tbookingId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x17\n" +
+	"\ashow_id\x18\x03 \x01(\tR\x06showId\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x04 \x01(\tR\tsessionId\x12 \n" +
+	"\fseat_area_id\x18\x05 \x01(\tR\n" +
+	"seatAreaId\x12\x1a\n" +
+	"\bquantity\x18\x06 \x01(\x05R\bquantity\x12\x1f\n" +
+	"\vtotal_price\x18\a \x01(\tR\n" +
+	"totalPrice\x121\n" +
+	"\x06status\x18\b \x01(\x0e2\x19.booking.v1.BookingStatusR\x06status\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x19\n" +
+	"\bseat_ids\x18\v \x03(\tR\aseatIds\"\x80\x02\n" +
+	"\x14CreateBookingRequest\x12!\n" +
+	"\ashow_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06showId\x12'\n" +
+	"\n" +
+	"session_id\x18\x02 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\tsessionId\x12*\n" +
+	"\fseat_area_id\x18\x03 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\n" +
+	"seatAreaId\x12#\n" +
+	"\bquantity\x18\x04 \x01(\x05B\a\xbaH\x04\x1a\x02(\x00R\bquantity\x12,\n" +
+	"\bseat_ids\x18\x05 \x03(\tB\x11\xbaH\x0e\x92\x01\v\x102\x18\x01\"\x05r\x03\xb0\x01\x01R\aseatIds\x12\x1d\n" +
+	"\n" +
+	"hold_token\x18\x06 \x01(\tR\tholdToken\"F\n" +
+	"\x15CreateBookingResponse\x12-\n" +
+	"\abooking\x18\x01 \x01(\v2\x13.booking.v1.BookingR\abooking\"<\n" +
+	"\x11GetBookingRequest\x12'\n" +
+	"\n" +
+	"booking_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\tbookingId\"C\n" +
+	"\x12GetBookingResponse\x12-\n" +
+	"\abooking\x18\x01 \x01(\v2\x13.booking.v1.BookingR\abooking\"\x89\x01\n" +
+	"\x13ListBookingsRequest\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x126\n" +
+	"\x06status\x18\x03 \x01(\x0e2\x19.booking.v1.BookingStatusH\x00R\x06status\x88\x01\x01B\t\n" +
+	"\a_status\"\x86\x01\n" +
+	"\x14ListBookingsResponse\x12/\n" +
+	"\bbookings\x18\x01 \x03(\v2\x13.booking.v1.BookingR\bbookings\x12=\n" +
+	"\n" +
+	"pagination\x18\x02 \x01(\v2\x1d.common.v1.PaginationResponseR\n" +
+	"pagination\"p\n" +
+	"\x15ProcessPaymentRequest\x12'\n" +
+	"\n" +
+	"booking_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\tbookingId\x12.\n" +
+	"\x0epayment_method\x18\x02 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\rpaymentMethod\"s\n" +
+	"\x16ProcessPaymentResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12%\n" +
+	"\x0etransaction_id\x18\x03 \x01(\tR\rtransactionId\"?\n" +
+	"\x14CancelBookingRequest\x12'\n" +
+	"\n" +
+	"booking_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\tbookingId\"F\n" +
+	"\x15CancelBookingResponse\x12-\n" +
+	"\abooking\x18\x01 \x01(\v2\x13.booking.v1.BookingR\abooking\"W\n" +
+	"\x14RefundBookingRequest\x12'\n" +
+	"\n" +
+	"booking_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\tbookingId\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\tR\x06amount\"\x96\x01\n" +
+	"\x15RefundBookingResponse\x12-\n" +
+	"\abooking\x18\x01 \x01(\v2\x13.booking.v1.BookingR\abooking\x12'\n" +
+	"\x0frefunded_amount\x18\x02 \x01(\tR\x0erefundedAmount\x12%\n" +
+	"\x0etransaction_id\x18\x03 \x01(\tR\rtransactionId\"\x96\x02\n" +
+	"\x13BookingStatusChange\x12:\n" +
+	"\vfrom_status\x18\x01 \x01(\x0e2\x19.booking.v1.BookingStatusR\n" +
+	"fromStatus\x126\n" +
+	"\tto_status\x18\x02 \x01(\x0e2\x19.booking.v1.BookingStatusR\btoStatus\x12\x1d\n" +
+	"\n" +
+	"actor_type\x18\x03 \x01(\tR\tactorType\x12\x19\n" +
+	"\bactor_id\x18\x04 \x01(\tR\aactorId\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\x129\n" +
+	"\n" +
+	"changed_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tchangedAt\"C\n" +
+	"\x18GetBookingHistoryRequest\x12'\n" +
+	"\n" +
+	"booking_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\tbookingId\"V\n" +
+	"\x19GetBookingHistoryResponse\x129\n" +
+	"\achanges\x18\x01 \x03(\v2\x1f.booking.v1.BookingStatusChangeR\achanges\"\xed\x02\n" +
+	"\x06Ticket\x12\x1b\n" +
+	"\tticket_id\x18\x01 \x01(\tR\bticketId\x12\x1b\n" +
+	"\tticket_no\x18\x02 \x01(\tR\bticketNo\x12\x1d\n" +
+	"\n" +
+	"booking_id\x18\x03 \x01(\tR\tbookingId\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x04 \x01(\tR\tsessionId\x12\x17\n" +
+	"\aseat_id\x18\x05 \x01(\tR\x06seatId\x12\x17\n" +
+	"\aqr_code\x18\x06 \x01(\tR\x06qrCode\x120\n" +
+	"\x06status\x18\a \x01(\x0e2\x18.booking.v1.TicketStatusR\x06status\x123\n" +
+	"\aused_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\x06usedAt\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x17\n" +
+	"\agate_id\x18\n" +
+	" \x01(\tR\x06gateId\"E\n" +
+	"\x12ListTicketsRequest\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\"\x82\x01\n" +
+	"\x13ListTicketsResponse\x12,\n" +
+	"\atickets\x18\x01 \x03(\v2\x12.booking.v1.TicketR\atickets\x12=\n" +
+	"\n" +
+	"pagination\x18\x02 \x01(\v2\x1d.common.v1.PaginationResponseR\n" +
+	"pagination\"9\n" +
+	"\x10GetTicketRequest\x12%\n" +
+	"\tticket_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\bticketId\"?\n" +
+	"\x11GetTicketResponse\x12*\n" +
+	"\x06ticket\x18\x01 \x01(\v2\x12.booking.v1.TicketR\x06ticket\"o\n" +
+	"\x0eCheckInOutcome\x121\n" +
+	"\x06result\x18\x01 \x01(\x0e2\x19.booking.v1.CheckInResultR\x06result\x12*\n" +
+	"\x06ticket\x18\x02 \x01(\v2\x12.booking.v1.TicketR\x06ticket\"!\n" +
+	"\x1fGetTicketVerificationKeyRequest\"_\n" +
+	" GetTicketVerificationKeyResponse\x12\x1c\n" +
+	"\talgorithm\x18\x01 \x01(\tR\talgorithm\x12\x1d\n" +
+	"\n" +
+	"public_key\x18\x02 \x01(\tR\tpublicKey\"h\n" +
+	"\x15ValidateTicketRequest\x12&\n" +
+	"\n" +
+	"qr_payload\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\tqrPayload\x12'\n" +
+	"\n" +
+	"session_id\x18\x02 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\tsessionId\"N\n" +
+	"\x16ValidateTicketResponse\x124\n" +
+	"\aoutcome\x18\x01 \x01(\v2\x1a.booking.v1.CheckInOutcomeR\aoutcome\"\x8b\x01\n" +
+	"\x14CheckInTicketRequest\x12&\n" +
+	"\n" +
+	"qr_payload\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\tqrPayload\x12'\n" +
+	"\n" +
+	"session_id\x18\x02 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\tsessionId\x12\"\n" +
+	"\agate_id\x18\x03 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x18@R\x06gateId\"M\n" +
+	"\x15CheckInTicketResponse\x124\n" +
+	"\aoutcome\x18\x01 \x01(\v2\x1a.booking.v1.CheckInOutcomeR\aoutcome\"x\n" +
+	"\vCheckInScan\x12&\n" +
+	"\n" +
+	"qr_payload\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\tqrPayload\x12A\n" +
+	"\n" +
+	"scanned_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xc8\x01\x01R\tscannedAt\"\x9e\x01\n" +
+	"\x13SyncCheckInsRequest\x12'\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\tsessionId\x12\"\n" +
+	"\agate_id\x18\x02 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x18@R\x06gateId\x12:\n" +
+	"\x05scans\x18\x03 \x03(\v2\x17.booking.v1.CheckInScanB\v\xbaH\b\x92\x01\x05\b\x01\x10\xf4\x03R\x05scans\"N\n" +
+	"\x14SyncCheckInsResponse\x126\n" +
+	"\boutcomes\x18\x01 \x03(\v2\x1a.booking.v1.CheckInOutcomeR\boutcomes\"\xf6\x02\n" +
+	" HandlePaymentNotificationRequest\x12#\n" +
+	"\bprovider\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\bprovider\x12\"\n" +
+	"\bevent_id\x18\x02 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\aeventId\x12'\n" +
+	"\n" +
+	"booking_id\x18\x03 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\tbookingId\x12\x1d\n" +
+	"\n" +
+	"payment_id\x18\x04 \x01(\tR\tpaymentId\x12.\n" +
+	"\x0etransaction_id\x18\x05 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\rtransactionId\x12I\n" +
+	"\x06status\x18\x06 \x01(\x0e2%.booking.v1.PaymentNotificationStatusB\n" +
+	"\xbaH\a\x82\x01\x04\x10\x01 \x00R\x06status\x12\x1f\n" +
+	"\x06amount\x18\a \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x06amount\x12%\n" +
+	"\x0efailure_reason\x18\b \x01(\tR\rfailureReason\"t\n" +
+	"!HandlePaymentNotificationResponse\x12\x1c\n" +
+	"\tduplicate\x18\x01 \x01(\bR\tduplicate\x121\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x19.booking.v1.BookingStatusR\x06status*\x97\x02\n" +
 	"\rBookingStatus\x12\x1e\n" +
 	"\x1aBOOKING_STATUS_UNSPECIFIED\x10\x00\x12\x1a\n" +
 	"\x16BOOKING_STATUS_PENDING\x10\x01\x12\x17\n" +
 	"\x13BOOKING_STATUS_PAID\x10\x02\x12\x1c\n" +
 	"\x18BOOKING_STATUS_CANCELLED\x10\x03\x12\x19\n" +
-	"\x15BOOKING_STATUS_FAILED\x10\x042\xf0\x03\n" +
+	"\x15BOOKING_STATUS_FAILED\x10\x04\x12\x1a\n" +
+	"\x16BOOKING_STATUS_EXPIRED\x10\x05\x12\x1b\n" +
+	"\x17BOOKING_STATUS_REFUNDED\x10\x06\x12\x1c\n" +
+	"\x18BOOKING_STATUS_COMPLETED\x10\a\x12!\n" +
+	"\x1dBOOKING_STATUS_REFUND_PENDING\x10\b*z\n" +
+	"\fTicketStatus\x12\x1d\n" +
+	"\x19TICKET_STATUS_UNSPECIFIED\x10\x00\x12\x17\n" +
+	"\x13TICKET_STATUS_VALID\x10\x01\x12\x16\n" +
+	"\x12TICKET_STATUS_USED\x10\x02\x12\x1a\n" +
+	"\x16TICKET_STATUS_REFUNDED\x10\x03*\xca\x01\n" +
+	"\rCheckInResult\x12\x1f\n" +
+	"\x1bCHECK_IN_RESULT_UNSPECIFIED\x10\x00\x12\x1c\n" +
+	"\x18CHECK_IN_RESULT_ACCEPTED\x10\x01\x12\x1d\n" +
+	"\x19CHECK_IN_RESULT_DUPLICATE\x10\x02\x12\x1b\n" +
+	"\x17CHECK_IN_RESULT_INVALID\x10\x03\x12!\n" +
+	"\x1dCHECK_IN_RESULT_WRONG_SESSION\x10\x04\x12\x1b\n" +
+	"\x17CHECK_IN_RESULT_REVOKED\x10\x05*\x9b\x01\n" +
+	"\x19PaymentNotificationStatus\x12+\n" +
+	"'PAYMENT_NOTIFICATION_STATUS_UNSPECIFIED\x10\x00\x12)\n" +
+	"%PAYMENT_NOTIFICATION_STATUS_SUCCEEDED\x10\x01\x12&\n" +
+	"\"PAYMENT_NOTIFICATION_STATUS_FAILED\x10\x022\xf2\r\n" +
 	"\x0eBookingService\x12q\n" +
 	"\rCreateBooking\x12 .booking.v1.CreateBookingRequest\x1a!.booking.v1.CreateBookingResponse\"\x1b\x82\xd3\xe4\x93\x02\x15:\x01*\"\x10/api/v1/bookings\x12r\n" +
 	"\n" +
 	"GetBooking\x12\x1d.booking.v1.GetBookingRequest\x1a\x1e.booking.v1.GetBookingResponse\"%\x82\xd3\xe4\x93\x02\x1f\x12\x1d/api/v1/bookings/{booking_id}\x12k\n" +
 	"\fListBookings\x12\x1f.booking.v1.ListBookingsRequest\x1a .booking.v1.ListBookingsResponse\"\x18\x82\xd3\xe4\x93\x02\x12\x12\x10/api/v1/bookings\x12\x89\x01\n" +
-	"\x0eProcessPayment\x12!.booking.v1.ProcessPaymentRequest\x1a\".booking.v1.ProcessPaymentResponse\"0\x82\xd3\xe4\x93\x02*:\x01*\"%/api/v1/bookings/{booking_id}/paymentB\xad\x01\n" +
+	"\x0eProcessPayment\x12!.booking.v1.ProcessPaymentRequest\x1a\".booking.v1.ProcessPaymentResponse\"0\x82\xd3\xe4\x93\x02*:\x01*\"%/api/v1/bookings/{booking_id}/payment\x12\x85\x01\n" +
+	"\rCancelBooking\x12 .booking.v1.CancelBookingRequest\x1a!.booking.v1.CancelBookingResponse\"/\x82\xd3\xe4\x93\x02):\x01*\"$/api/v1/bookings/{booking_id}/cancel\x12\x85\x01\n" +
+	"\rRefundBooking\x12 .booking.v1.RefundBookingRequest\x1a!.booking.v1.RefundBookingResponse\"/\x82\xd3\xe4\x93\x02):\x01*\"$/api/v1/bookings/{booking_id}/refund\x12\x8f\x01\n" +
+	"\x11GetBookingHistory\x12$.booking.v1.GetBookingHistoryRequest\x1a%.booking.v1.GetBookingHistoryResponse\"-\x82\xd3\xe4\x93\x02'\x12%/api/v1/bookings/{booking_id}/history\x12g\n" +
+	"\vListTickets\x12\x1e.booking.v1.ListTicketsRequest\x1a\x1f.booking.v1.ListTicketsResponse\"\x17\x82\xd3\xe4\x93\x02\x11\x12\x0f/api/v1/tickets\x12m\n" +
+	"\tGetTicket\x12\x1c.booking.v1.GetTicketRequest\x1a\x1d.booking.v1.GetTicketResponse\"#\x82\xd3\xe4\x93\x02\x1d\x12\x1b/api/v1/tickets/{ticket_id}\x12\xa1\x01\n" +
+	"\x18GetTicketVerificationKey\x12+.booking.v1.GetTicketVerificationKeyRequest\x1a,.booking.v1.GetTicketVerificationKeyResponse\"*\x82\xd3\xe4\x93\x02$\x12\"/api/v1/check-ins/verification-key\x12~\n" +
+	"\x0eValidateTicket\x12!.booking.v1.ValidateTicketRequest\x1a\".booking.v1.ValidateTicketResponse\"%\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/api/v1/check-ins/validate\x12r\n" +
+	"\rCheckInTicket\x12 .booking.v1.CheckInTicketRequest\x1a!.booking.v1.CheckInTicketResponse\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/api/v1/check-ins\x12t\n" +
+	"\fSyncCheckIns\x12\x1f.booking.v1.SyncCheckInsRequest\x1a .booking.v1.SyncCheckInsResponse\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/api/v1/check-ins/sync\x12x\n" +
+	"\x19HandlePaymentNotification\x12,.booking.v1.HandlePaymentNotificationRequest\x1a-.booking.v1.HandlePaymentNotificationResponseB\xad\x01\n" +
 	"\x0ecom.booking.v1B\fBookingProtoP\x01ZDgithub.com/wylu1037/go-micro-boilerplate/gen/go/booking/v1;bookingv1\xa2\x02\x03BXX\xaa\x02\n" +
 	"Booking.V1\xca\x02\n" +
 	"Booking\\V1\xe2\x02\x16Booking\\V1\\GPBMetadata\xea\x02\vBooking::V1b\x06proto3"
@@ -705,44 +2369,112 @@ func file_booking_v1_booking_proto_rawDescGZIP() []byte {
 	return file_booking_v1_booking_proto_rawDescData
 }
 
-var file_booking_v1_booking_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_booking_v1_booking_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_booking_v1_booking_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_booking_v1_booking_proto_msgTypes = make([]protoimpl.MessageInfo, 33)
 var file_booking_v1_booking_proto_goTypes = []any{
-	(BookingStatus)(0),             // 0: booking.v1.BookingStatus
-	(*Booking)(nil),                // 1: booking.v1.Booking
-	(*CreateBookingRequest)(nil),   // 2: booking.v1.CreateBookingRequest
-	(*CreateBookingResponse)(nil),  // 3: booking.v1.CreateBookingResponse
-	(*GetBookingRequest)(nil),      // 4: booking.v1.GetBookingRequest
-	(*GetBookingResponse)(nil),     // 5: booking.v1.GetBookingResponse
-	(*ListBookingsRequest)(nil),    // 6: booking.v1.ListBookingsRequest
-	(*ListBookingsResponse)(nil),   // 7: booking.v1.ListBookingsResponse
-	(*ProcessPaymentRequest)(nil),  // 8: booking.v1.ProcessPaymentRequest
-	(*ProcessPaymentResponse)(nil), // 9: booking.v1.ProcessPaymentResponse
-	(*timestamppb.Timestamp)(nil),  // 10: google.protobuf.Timestamp
-	(*v1.PaginationResponse)(nil),  // 11: common.v1.PaginationResponse
+	(BookingStatus)(0),                        // 0: booking.v1.BookingStatus
+	(TicketStatus)(0),                         // 1: booking.v1.TicketStatus
+	(CheckInResult)(0),                        // 2: booking.v1.CheckInResult
+	(PaymentNotificationStatus)(0),            // 3: booking.v1.PaymentNotificationStatus
+	(*Booking)(nil),                           // 4: booking.v1.Booking
+	(*CreateBookingRequest)(nil),              // 5: booking.v1.CreateBookingRequest
+	(*CreateBookingResponse)(nil),             // 6: booking.v1.CreateBookingResponse
+	(*GetBookingRequest)(nil),                 // 7: booking.v1.GetBookingRequest
+	(*GetBookingResponse)(nil),                // 8: booking.v1.GetBookingResponse
+	(*ListBookingsRequest)(nil),               // 9: booking.v1.ListBookingsRequest
+	(*ListBookingsResponse)(nil),              // 10: booking.v1.ListBookingsResponse
+	(*ProcessPaymentRequest)(nil),             // 11: booking.v1.ProcessPaymentRequest
+	(*ProcessPaymentResponse)(nil),            // 12: booking.v1.ProcessPaymentResponse
+	(*CancelBookingRequest)(nil),              // 13: booking.v1.CancelBookingRequest
+	(*CancelBookingResponse)(nil),             // 14: booking.v1.CancelBookingResponse
+	(*RefundBookingRequest)(nil),              // 15: booking.v1.RefundBookingRequest
+	(*RefundBookingResponse)(nil),             // 16: booking.v1.RefundBookingResponse
+	(*BookingStatusChange)(nil),               // 17: booking.v1.BookingStatusChange
+	(*GetBookingHistoryRequest)(nil),          // 18: booking.v1.GetBookingHistoryRequest
+	(*GetBookingHistoryResponse)(nil),         // 19: booking.v1.GetBookingHistoryResponse
+	(*Ticket)(nil),                            // 20: booking.v1.Ticket
+	(*ListTicketsRequest)(nil),                // 21: booking.v1.ListTicketsRequest
+	(*ListTicketsResponse)(nil),               // 22: booking.v1.ListTicketsResponse
+	(*GetTicketRequest)(nil),                  // 23: booking.v1.GetTicketRequest
+	(*GetTicketResponse)(nil),                 // 24: booking.v1.GetTicketResponse
+	(*CheckInOutcome)(nil),                    // 25: booking.v1.CheckInOutcome
+	(*GetTicketVerificationKeyRequest)(nil),   // 26: booking.v1.GetTicketVerificationKeyRequest
+	(*GetTicketVerificationKeyResponse)(nil),  // 27: booking.v1.GetTicketVerificationKeyResponse
+	(*ValidateTicketRequest)(nil),             // 28: booking.v1.ValidateTicketRequest
+	(*ValidateTicketResponse)(nil),            // 29: booking.v1.ValidateTicketResponse
+	(*CheckInTicketRequest)(nil),              // 30: booking.v1.CheckInTicketRequest
+	(*CheckInTicketResponse)(nil),             // 31: booking.v1.CheckInTicketResponse
+	(*CheckInScan)(nil),                       // 32: booking.v1.CheckInScan
+	(*SyncCheckInsRequest)(nil),               // 33: booking.v1.SyncCheckInsRequest
+	(*SyncCheckInsResponse)(nil),              // 34: booking.v1.SyncCheckInsResponse
+	(*HandlePaymentNotificationRequest)(nil),  // 35: booking.v1.HandlePaymentNotificationRequest
+	(*HandlePaymentNotificationResponse)(nil), // 36: booking.v1.HandlePaymentNotificationResponse
+	(*timestamppb.Timestamp)(nil),             // 37: google.protobuf.Timestamp
+	(*v1.PaginationResponse)(nil),             // 38: common.v1.PaginationResponse
 }
 var file_booking_v1_booking_proto_depIdxs = []int32{
 	0,  // 0: booking.v1.Booking.status:type_name -> booking.v1.BookingStatus
-	10, // 1: booking.v1.Booking.created_at:type_name -> google.protobuf.Timestamp
-	10, // 2: booking.v1.Booking.updated_at:type_name -> google.protobuf.Timestamp
-	1,  // 3: booking.v1.CreateBookingResponse.booking:type_name -> booking.v1.Booking
-	1,  // 4: booking.v1.GetBookingResponse.booking:type_name -> booking.v1.Booking
+	37, // 1: booking.v1.Booking.created_at:type_name -> google.protobuf.Timestamp
+	37, // 2: booking.v1.Booking.updated_at:type_name -> google.protobuf.Timestamp
+	4,  // 3: booking.v1.CreateBookingResponse.booking:type_name -> booking.v1.Booking
+	4,  // 4: booking.v1.GetBookingResponse.booking:type_name -> booking.v1.Booking
 	0,  // 5: booking.v1.ListBookingsRequest.status:type_name -> booking.v1.BookingStatus
-	1,  // 6: booking.v1.ListBookingsResponse.bookings:type_name -> booking.v1.Booking
-	11, // 7: booking.v1.ListBookingsResponse.pagination:type_name -> common.v1.PaginationResponse
-	2,  // 8: booking.v1.BookingService.CreateBooking:input_type -> booking.v1.CreateBookingRequest
-	4,  // 9: booking.v1.BookingService.GetBooking:input_type -> booking.v1.GetBookingRequest
-	6,  // 10: booking.v1.BookingService.ListBookings:input_type -> booking.v1.ListBookingsRequest
-	8,  // 11: booking.v1.BookingService.ProcessPayment:input_type -> booking.v1.ProcessPaymentRequest
-	3,  // 12: booking.v1.BookingService.CreateBooking:output_type -> booking.v1.CreateBookingResponse
-	5,  // 13: booking.v1.BookingService.GetBooking:output_type -> booking.v1.GetBookingResponse
-	7,  // 14: booking.v1.BookingService.ListBookings:output_type -> booking.v1.ListBookingsResponse
-	9,  // 15: booking.v1.BookingService.ProcessPayment:output_type -> booking.v1.ProcessPaymentResponse
-	12, // [12:16] is the sub-list for method output_type
-	8,  // [8:12] is the sub-list for method input_type
-	8,  // [8:8] is the sub-list for extension type_name
-	8,  // [8:8] is the sub-list for extension extendee
-	0,  // [0:8] is the sub-list for field type_name
+	4,  // 6: booking.v1.ListBookingsResponse.bookings:type_name -> booking.v1.Booking
+	38, // 7: booking.v1.ListBookingsResponse.pagination:type_name -> common.v1.PaginationResponse
+	4,  // 8: booking.v1.CancelBookingResponse.booking:type_name -> booking.v1.Booking
+	4,  // 9: booking.v1.RefundBookingResponse.booking:type_name -> booking.v1.Booking
+	0,  // 10: booking.v1.BookingStatusChange.from_status:type_name -> booking.v1.BookingStatus
+	0,  // 11: booking.v1.BookingStatusChange.to_status:type_name -> booking.v1.BookingStatus
+	37, // 12: booking.v1.BookingStatusChange.changed_at:type_name -> google.protobuf.Timestamp
+	17, // 13: booking.v1.GetBookingHistoryResponse.changes:type_name -> booking.v1.BookingStatusChange
+	1,  // 14: booking.v1.Ticket.status:type_name -> booking.v1.TicketStatus
+	37, // 15: booking.v1.Ticket.used_at:type_name -> google.protobuf.Timestamp
+	37, // 16: booking.v1.Ticket.created_at:type_name -> google.protobuf.Timestamp
+	20, // 17: booking.v1.ListTicketsResponse.tickets:type_name -> booking.v1.Ticket
+	38, // 18: booking.v1.ListTicketsResponse.pagination:type_name -> common.v1.PaginationResponse
+	20, // 19: booking.v1.GetTicketResponse.ticket:type_name -> booking.v1.Ticket
+	2,  // 20: booking.v1.CheckInOutcome.result:type_name -> booking.v1.CheckInResult
+	20, // 21: booking.v1.CheckInOutcome.ticket:type_name -> booking.v1.Ticket
+	25, // 22: booking.v1.ValidateTicketResponse.outcome:type_name -> booking.v1.CheckInOutcome
+	25, // 23: booking.v1.CheckInTicketResponse.outcome:type_name -> booking.v1.CheckInOutcome
+	37, // 24: booking.v1.CheckInScan.scanned_at:type_name -> google.protobuf.Timestamp
+	32, // 25: booking.v1.SyncCheckInsRequest.scans:type_name -> booking.v1.CheckInScan
+	25, // 26: booking.v1.SyncCheckInsResponse.outcomes:type_name -> booking.v1.CheckInOutcome
+	3,  // 27: booking.v1.HandlePaymentNotificationRequest.status:type_name -> booking.v1.PaymentNotificationStatus
+	0,  // 28: booking.v1.HandlePaymentNotificationResponse.status:type_name -> booking.v1.BookingStatus
+	5,  // 29: booking.v1.BookingService.CreateBooking:input_type -> booking.v1.CreateBookingRequest
+	7,  // 30: booking.v1.BookingService.GetBooking:input_type -> booking.v1.GetBookingRequest
+	9,  // 31: booking.v1.BookingService.ListBookings:input_type -> booking.v1.ListBookingsRequest
+	11, // 32: booking.v1.BookingService.ProcessPayment:input_type -> booking.v1.ProcessPaymentRequest
+	13, // 33: booking.v1.BookingService.CancelBooking:input_type -> booking.v1.CancelBookingRequest
+	15, // 34: booking.v1.BookingService.RefundBooking:input_type -> booking.v1.RefundBookingRequest
+	18, // 35: booking.v1.BookingService.GetBookingHistory:input_type -> booking.v1.GetBookingHistoryRequest
+	21, // 36: booking.v1.BookingService.ListTickets:input_type -> booking.v1.ListTicketsRequest
+	23, // 37: booking.v1.BookingService.GetTicket:input_type -> booking.v1.GetTicketRequest
+	26, // 38: booking.v1.BookingService.GetTicketVerificationKey:input_type -> booking.v1.GetTicketVerificationKeyRequest
+	28, // 39: booking.v1.BookingService.ValidateTicket:input_type -> booking.v1.ValidateTicketRequest
+	30, // 40: booking.v1.BookingService.CheckInTicket:input_type -> booking.v1.CheckInTicketRequest
+	33, // 41: booking.v1.BookingService.SyncCheckIns:input_type -> booking.v1.SyncCheckInsRequest
+	35, // 42: booking.v1.BookingService.HandlePaymentNotification:input_type -> booking.v1.HandlePaymentNotificationRequest
+	6,  // 43: booking.v1.BookingService.CreateBooking:output_type -> booking.v1.CreateBookingResponse
+	8,  // 44: booking.v1.BookingService.GetBooking:output_type -> booking.v1.GetBookingResponse
+	10, // 45: booking.v1.BookingService.ListBookings:output_type -> booking.v1.ListBookingsResponse
+	12, // 46: booking.v1.BookingService.ProcessPayment:output_type -> booking.v1.ProcessPaymentResponse
+	14, // 47: booking.v1.BookingService.CancelBooking:output_type -> booking.v1.CancelBookingResponse
+	16, // 48: booking.v1.BookingService.RefundBooking:output_type -> booking.v1.RefundBookingResponse
+	19, // 49: booking.v1.BookingService.GetBookingHistory:output_type -> booking.v1.GetBookingHistoryResponse
+	22, // 50: booking.v1.BookingService.ListTickets:output_type -> booking.v1.ListTicketsResponse
+	24, // 51: booking.v1.BookingService.GetTicket:output_type -> booking.v1.GetTicketResponse
+	27, // 52: booking.v1.BookingService.GetTicketVerificationKey:output_type -> booking.v1.GetTicketVerificationKeyResponse
+	29, // 53: booking.v1.BookingService.ValidateTicket:output_type -> booking.v1.ValidateTicketResponse
+	31, // 54: booking.v1.BookingService.CheckInTicket:output_type -> booking.v1.CheckInTicketResponse
+	34, // 55: booking.v1.BookingService.SyncCheckIns:output_type -> booking.v1.SyncCheckInsResponse
+	36, // 56: booking.v1.BookingService.HandlePaymentNotification:output_type -> booking.v1.HandlePaymentNotificationResponse
+	43, // [43:57] is the sub-list for method output_type
+	29, // [29:43] is the sub-list for method input_type
+	29, // [29:29] is the sub-list for extension type_name
+	29, // [29:29] is the sub-list for extension extendee
+	0,  // [0:29] is the sub-list for field type_name
 }
 
 func init() { file_booking_v1_booking_proto_init() }
@@ -756,8 +2488,8 @@ func file_booking_v1_booking_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_booking_v1_booking_proto_rawDesc), len(file_booking_v1_booking_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   9,
+			NumEnums:      4,
+			NumMessages:   33,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -59,6 +59,60 @@ func NewBookingServiceEndpoints() []*api.Endpoint {
 			Method:  []string{"POST"},
 			Handler: "rpc",
 		},
+		{
+			Name:    "BookingService.CancelBooking",
+			Path:    []string{"/api/v1/bookings/{booking_id}/cancel"},
+			Method:  []string{"POST"},
+			Handler: "rpc",
+		},
+		{
+			Name:    "BookingService.RefundBooking",
+			Path:    []string{"/api/v1/bookings/{booking_id}/refund"},
+			Method:  []string{"POST"},
+			Handler: "rpc",
+		},
+		{
+			Name:    "BookingService.GetBookingHistory",
+			Path:    []string{"/api/v1/bookings/{booking_id}/history"},
+			Method:  []string{"GET"},
+			Handler: "rpc",
+		},
+		{
+			Name:    "BookingService.ListTickets",
+			Path:    []string{"/api/v1/tickets"},
+			Method:  []string{"GET"},
+			Handler: "rpc",
+		},
+		{
+			Name:    "BookingService.GetTicket",
+			Path:    []string{"/api/v1/tickets/{ticket_id}"},
+			Method:  []string{"GET"},
+			Handler: "rpc",
+		},
+		{
+			Name:    "BookingService.GetTicketVerificationKey",
+			Path:    []string{"/api/v1/check-ins/verification-key"},
+			Method:  []string{"GET"},
+			Handler: "rpc",
+		},
+		{
+			Name:    "BookingService.ValidateTicket",
+			Path:    []string{"/api/v1/check-ins/validate"},
+			Method:  []string{"POST"},
+			Handler: "rpc",
+		},
+		{
+			Name:    "BookingService.CheckInTicket",
+			Path:    []string{"/api/v1/check-ins"},
+			Method:  []string{"POST"},
+			Handler: "rpc",
+		},
+		{
+			Name:    "BookingService.SyncCheckIns",
+			Path:    []string{"/api/v1/check-ins/sync"},
+			Method:  []string{"POST"},
+			Handler: "rpc",
+		},
 	}
 }
 
@@ -73,6 +127,28 @@ type BookingService interface {
 	ListBookings(ctx context.Context, in *ListBookingsRequest, opts ...client.CallOption) (*ListBookingsResponse, error)
 	// Process payment for a potential booking
 	ProcessPayment(ctx context.Context, in *ProcessPaymentRequest, opts ...client.CallOption) (*ProcessPaymentResponse, error)
+	// Cancel an unpaid booking and release its seats
+	CancelBooking(ctx context.Context, in *CancelBookingRequest, opts ...client.CallOption) (*CancelBookingResponse, error)
+	// Request the refund of a paid booking, fully or partially as the refund policy allows. The booking is
+	// refund pending until the payment provider returns the money; then it is refunded, its tickets voided
+	// and its seats released
+	RefundBooking(ctx context.Context, in *RefundBookingRequest, opts ...client.CallOption) (*RefundBookingResponse, error)
+	// List the status changes of a booking, oldest first
+	GetBookingHistory(ctx context.Context, in *GetBookingHistoryRequest, opts ...client.CallOption) (*GetBookingHistoryResponse, error)
+	// List the current user's tickets
+	ListTickets(ctx context.Context, in *ListTicketsRequest, opts ...client.CallOption) (*ListTicketsResponse, error)
+	// Get one of the current user's tickets, including its QR payload
+	GetTicket(ctx context.Context, in *GetTicketRequest, opts ...client.CallOption) (*GetTicketResponse, error)
+	// Public key gate devices use to verify ticket QR codes while offline
+	GetTicketVerificationKey(ctx context.Context, in *GetTicketVerificationKeyRequest, opts ...client.CallOption) (*GetTicketVerificationKeyResponse, error)
+	// Check whether a scanned ticket would be admitted, without checking it in
+	ValidateTicket(ctx context.Context, in *ValidateTicketRequest, opts ...client.CallOption) (*ValidateTicketResponse, error)
+	// Check a scanned ticket in at a gate
+	CheckInTicket(ctx context.Context, in *CheckInTicketRequest, opts ...client.CallOption) (*CheckInTicketResponse, error)
+	// Upload the scans a gate made while it was offline
+	SyncCheckIns(ctx context.Context, in *SyncCheckInsRequest, opts ...client.CallOption) (*SyncCheckInsResponse, error)
+	// Apply an asynchronous payment result reported by a provider (for the gateway payment webhook; service accounts only)
+	HandlePaymentNotification(ctx context.Context, in *HandlePaymentNotificationRequest, opts ...client.CallOption) (*HandlePaymentNotificationResponse, error)
 }
 
 type bookingService struct {
@@ -127,6 +203,106 @@ func (c *bookingService) ProcessPayment(ctx context.Context, in *ProcessPaymentR
 	return out, nil
 }
 
+func (c *bookingService) CancelBooking(ctx context.Context, in *CancelBookingRequest, opts ...client.CallOption) (*CancelBookingResponse, error) {
+	req := c.c.NewRequest(c.name, "BookingService.CancelBooking", in)
+	out := new(CancelBookingResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingService) RefundBooking(ctx context.Context, in *RefundBookingRequest, opts ...client.CallOption) (*RefundBookingResponse, error) {
+	req := c.c.NewRequest(c.name, "BookingService.RefundBooking", in)
+	out := new(RefundBookingResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingService) GetBookingHistory(ctx context.Context, in *GetBookingHistoryRequest, opts ...client.CallOption) (*GetBookingHistoryResponse, error) {
+	req := c.c.NewRequest(c.name, "BookingService.GetBookingHistory", in)
+	out := new(GetBookingHistoryResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingService) ListTickets(ctx context.Context, in *ListTicketsRequest, opts ...client.CallOption) (*ListTicketsResponse, error) {
+	req := c.c.NewRequest(c.name, "BookingService.ListTickets", in)
+	out := new(ListTicketsResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingService) GetTicket(ctx context.Context, in *GetTicketRequest, opts ...client.CallOption) (*GetTicketResponse, error) {
+	req := c.c.NewRequest(c.name, "BookingService.GetTicket", in)
+	out := new(GetTicketResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingService) GetTicketVerificationKey(ctx context.Context, in *GetTicketVerificationKeyRequest, opts ...client.CallOption) (*GetTicketVerificationKeyResponse, error) {
+	req := c.c.NewRequest(c.name, "BookingService.GetTicketVerificationKey", in)
+	out := new(GetTicketVerificationKeyResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingService) ValidateTicket(ctx context.Context, in *ValidateTicketRequest, opts ...client.CallOption) (*ValidateTicketResponse, error) {
+	req := c.c.NewRequest(c.name, "BookingService.ValidateTicket", in)
+	out := new(ValidateTicketResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingService) CheckInTicket(ctx context.Context, in *CheckInTicketRequest, opts ...client.CallOption) (*CheckInTicketResponse, error) {
+	req := c.c.NewRequest(c.name, "BookingService.CheckInTicket", in)
+	out := new(CheckInTicketResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingService) SyncCheckIns(ctx context.Context, in *SyncCheckInsRequest, opts ...client.CallOption) (*SyncCheckInsResponse, error) {
+	req := c.c.NewRequest(c.name, "BookingService.SyncCheckIns", in)
+	out := new(SyncCheckInsResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingService) HandlePaymentNotification(ctx context.Context, in *HandlePaymentNotificationRequest, opts ...client.CallOption) (*HandlePaymentNotificationResponse, error) {
+	req := c.c.NewRequest(c.name, "BookingService.HandlePaymentNotification", in)
+	out := new(HandlePaymentNotificationResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for BookingService service
 
 type BookingServiceHandler interface {
@@ -138,6 +314,28 @@ type BookingServiceHandler interface {
 	ListBookings(context.Context, *ListBookingsRequest, *ListBookingsResponse) error
 	// Process payment for a potential booking
 	ProcessPayment(context.Context, *ProcessPaymentRequest, *ProcessPaymentResponse) error
+	// Cancel an unpaid booking and release its seats
+	CancelBooking(context.Context, *CancelBookingRequest, *CancelBookingResponse) error
+	// Request the refund of a paid booking, fully or partially as the refund policy allows. The booking is
+	// refund pending until the payment provider returns the money; then it is refunded, its tickets voided
+	// and its seats released
+	RefundBooking(context.Context, *RefundBookingRequest, *RefundBookingResponse) error
+	// List the status changes of a booking, oldest first
+	GetBookingHistory(context.Context, *GetBookingHistoryRequest, *GetBookingHistoryResponse) error
+	// List the current user's tickets
+	ListTickets(context.Context, *ListTicketsRequest, *ListTicketsResponse) error
+	// Get one of the current user's tickets, including its QR payload
+	GetTicket(context.Context, *GetTicketRequest, *GetTicketResponse) error
+	// Public key gate devices use to verify ticket QR codes while offline
+	GetTicketVerificationKey(context.Context, *GetTicketVerificationKeyRequest, *GetTicketVerificationKeyResponse) error
+	// Check whether a scanned ticket would be admitted, without checking it in
+	ValidateTicket(context.Context, *ValidateTicketRequest, *ValidateTicketResponse) error
+	// Check a scanned ticket in at a gate
+	CheckInTicket(context.Context, *CheckInTicketRequest, *CheckInTicketResponse) error
+	// Upload the scans a gate made while it was offline
+	SyncCheckIns(context.Context, *SyncCheckInsRequest, *SyncCheckInsResponse) error
+	// Apply an asynchronous payment result reported by a provider (for the gateway payment webhook; service accounts only)
+	HandlePaymentNotification(context.Context, *HandlePaymentNotificationRequest, *HandlePaymentNotificationResponse) error
 }
 
 func RegisterBookingServiceHandler(s server.Server, hdlr BookingServiceHandler, opts ...server.HandlerOption) error {
@@ -146,6 +344,16 @@ func RegisterBookingServiceHandler(s server.Server, hdlr BookingServiceHandler,
 		GetBooking(ctx context.Context, in *GetBookingRequest, out *GetBookingResponse) error
 		ListBookings(ctx context.Context, in *ListBookingsRequest, out *ListBookingsResponse) error
 		ProcessPayment(ctx context.Context, in *ProcessPaymentRequest, out *ProcessPaymentResponse) error
+		CancelBooking(ctx context.Context, in *CancelBookingRequest, out *CancelBookingResponse) error
+		RefundBooking(ctx context.Context, in *RefundBookingRequest, out *RefundBookingResponse) error
+		GetBookingHistory(ctx context.Context, in *GetBookingHistoryRequest, out *GetBookingHistoryResponse) error
+		ListTickets(ctx context.Context, in *ListTicketsRequest, out *ListTicketsResponse) error
+		GetTicket(ctx context.Context, in *GetTicketRequest, out *GetTicketResponse) error
+		GetTicketVerificationKey(ctx context.Context, in *GetTicketVerificationKeyRequest, out *GetTicketVerificationKeyResponse) error
+		ValidateTicket(ctx context.Context, in *ValidateTicketRequest, out *ValidateTicketResponse) error
+		CheckInTicket(ctx context.Context, in *CheckInTicketRequest, out *CheckInTicketResponse) error
+		SyncCheckIns(ctx context.Context, in *SyncCheckInsRequest, out *SyncCheckInsResponse) error
+		HandlePaymentNotification(ctx context.Context, in *HandlePaymentNotificationRequest, out *HandlePaymentNotificationResponse) error
 	}
 	type BookingService struct {
 		bookingService
@@ -175,6 +383,60 @@ func RegisterBookingServiceHandler(s server.Server, hdlr BookingServiceHandler,
 		Method:  []string{"POST"},
 		Handler: "rpc",
 	}))
+	opts = append(opts, api.WithEndpoint(&api.Endpoint{
+		Name:    "BookingService.CancelBooking",
+		Path:    []string{"/api/v1/bookings/{booking_id}/cancel"},
+		Method:  []string{"POST"},
+		Handler: "rpc",
+	}))
+	opts = append(opts, api.WithEndpoint(&api.Endpoint{
+		Name:    "BookingService.RefundBooking",
+		Path:    []string{"/api/v1/bookings/{booking_id}/refund"},
+		Method:  []string{"POST"},
+		Handler: "rpc",
+	}))
+	opts = append(opts, api.WithEndpoint(&api.Endpoint{
+		Name:    "BookingService.GetBookingHistory",
+		Path:    []string{"/api/v1/bookings/{booking_id}/history"},
+		Method:  []string{"GET"},
+		Handler: "rpc",
+	}))
+	opts = append(opts, api.WithEndpoint(&api.Endpoint{
+		Name:    "BookingService.ListTickets",
+		Path:    []string{"/api/v1/tickets"},
+		Method:  []string{"GET"},
+		Handler: "rpc",
+	}))
+	opts = append(opts, api.WithEndpoint(&api.Endpoint{
+		Name:    "BookingService.GetTicket",
+		Path:    []string{"/api/v1/tickets/{ticket_id}"},
+		Method:  []string{"GET"},
+		Handler: "rpc",
+	}))
+	opts = append(opts, api.WithEndpoint(&api.Endpoint{
+		Name:    "BookingService.GetTicketVerificationKey",
+		Path:    []string{"/api/v1/check-ins/verification-key"},
+		Method:  []string{"GET"},
+		Handler: "rpc",
+	}))
+	opts = append(opts, api.WithEndpoint(&api.Endpoint{
+		Name:    "BookingService.ValidateTicket",
+		Path:    []string{"/api/v1/check-ins/validate"},
+		Method:  []string{"POST"},
+		Handler: "rpc",
+	}))
+	opts = append(opts, api.WithEndpoint(&api.Endpoint{
+		Name:    "BookingService.CheckInTicket",
+		Path:    []string{"/api/v1/check-ins"},
+		Method:  []string{"POST"},
+		Handler: "rpc",
+	}))
+	opts = append(opts, api.WithEndpoint(&api.Endpoint{
+		Name:    "BookingService.SyncCheckIns",
+		Path:    []string{"/api/v1/check-ins/sync"},
+		Method:  []string{"POST"},
+		Handler: "rpc",
+	}))
 	return s.Handle(s.NewHandler(&BookingService{h}, opts...))
 }
 
@@ -197,3 +459,43 @@ func (h *bookingServiceHandler) ListBookings(ctx context.Context, in *ListBookin
 func (h *bookingServiceHandler) ProcessPayment(ctx context.Context, in *ProcessPaymentRequest, out *ProcessPaymentResponse) error {
 	return h.BookingServiceHandler.ProcessPayment(ctx, in, out)
 }
+
+func (h *bookingServiceHandler) CancelBooking(ctx context.Context, in *CancelBookingRequest, out *CancelBookingResponse) error {
+	return h.BookingServiceHandler.CancelBooking(ctx, in, out)
+}
+
+func (h *bookingServiceHandler) RefundBooking(ctx context.Context, in *RefundBookingRequest, out *RefundBookingResponse) error {
+	return h.BookingServiceHandler.RefundBooking(ctx, in, out)
+}
+
+func (h *bookingServiceHandler) GetBookingHistory(ctx context.Context, in *GetBookingHistoryRequest, out *GetBookingHistoryResponse) error {
+	return h.BookingServiceHandler.GetBookingHistory(ctx, in, out)
+}
+
+func (h *bookingServiceHandler) ListTickets(ctx context.Context, in *ListTicketsRequest, out *ListTicketsResponse) error {
+	return h.BookingServiceHandler.ListTickets(ctx, in, out)
+}
+
+func (h *bookingServiceHandler) GetTicket(ctx context.Context, in *GetTicketRequest, out *GetTicketResponse) error {
+	return h.BookingServiceHandler.GetTicket(ctx, in, out)
+}
+
+func (h *bookingServiceHandler) GetTicketVerificationKey(ctx context.Context, in *GetTicketVerificationKeyRequest, out *GetTicketVerificationKeyResponse) error {
+	return h.BookingServiceHandler.GetTicketVerificationKey(ctx, in, out)
+}
+
+func (h *bookingServiceHandler) ValidateTicket(ctx context.Context, in *ValidateTicketRequest, out *ValidateTicketResponse) error {
+	return h.BookingServiceHandler.ValidateTicket(ctx, in, out)
+}
+
+func (h *bookingServiceHandler) CheckInTicket(ctx context.Context, in *CheckInTicketRequest, out *CheckInTicketResponse) error {
+	return h.BookingServiceHandler.CheckInTicket(ctx, in, out)
+}
+
+func (h *bookingServiceHandler) SyncCheckIns(ctx context.Context, in *SyncCheckInsRequest, out *SyncCheckInsResponse) error {
+	return h.BookingServiceHandler.SyncCheckIns(ctx, in, out)
+}
+
+func (h *bookingServiceHandler) HandlePaymentNotification(ctx context.Context, in *HandlePaymentNotificationRequest, out *HandlePaymentNotificationResponse) error {
+	return h.BookingServiceHandler.HandlePaymentNotification(ctx, in, out)
+}
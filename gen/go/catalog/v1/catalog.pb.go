@@ -192,6 +192,113 @@ func (SessionStatus) EnumDescriptor() ([]byte, []int) {
 	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{2}
 }
 
+type SeatStatus int32
+
+const (
+	SeatStatus_SEAT_STATUS_UNSPECIFIED SeatStatus = 0
+	SeatStatus_SEAT_STATUS_AVAILABLE   SeatStatus = 1
+	SeatStatus_SEAT_STATUS_RESERVED    SeatStatus = 2
+	SeatStatus_SEAT_STATUS_BLOCKED     SeatStatus = 3 // Not for sale
+	SeatStatus_SEAT_STATUS_HELD        SeatStatus = 4 // Temporarily held by another buyer
+)
+
+// Enum value maps for SeatStatus.
+var (
+	SeatStatus_name = map[int32]string{
+		0: "SEAT_STATUS_UNSPECIFIED",
+		1: "SEAT_STATUS_AVAILABLE",
+		2: "SEAT_STATUS_RESERVED",
+		3: "SEAT_STATUS_BLOCKED",
+		4: "SEAT_STATUS_HELD",
+	}
+	SeatStatus_value = map[string]int32{
+		"SEAT_STATUS_UNSPECIFIED": 0,
+		"SEAT_STATUS_AVAILABLE":   1,
+		"SEAT_STATUS_RESERVED":    2,
+		"SEAT_STATUS_BLOCKED":     3,
+		"SEAT_STATUS_HELD":        4,
+	}
+)
+
+func (x SeatStatus) Enum() *SeatStatus {
+	p := new(SeatStatus)
+	*p = x
+	return p
+}
+
+func (x SeatStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SeatStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_catalog_v1_catalog_proto_enumTypes[3].Descriptor()
+}
+
+func (SeatStatus) Type() protoreflect.EnumType {
+	return &file_catalog_v1_catalog_proto_enumTypes[3]
+}
+
+func (x SeatStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SeatStatus.Descriptor instead.
+func (SeatStatus) EnumDescriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{3}
+}
+
+type ReservationStatus int32
+
+const (
+	ReservationStatus_RESERVATION_STATUS_UNSPECIFIED ReservationStatus = 0
+	ReservationStatus_RESERVATION_STATUS_RESERVED    ReservationStatus = 1
+	ReservationStatus_RESERVATION_STATUS_RELEASED    ReservationStatus = 2
+	ReservationStatus_RESERVATION_STATUS_REJECTED    ReservationStatus = 3 // Not enough seats when the reservation was attempted
+)
+
+// Enum value maps for ReservationStatus.
+var (
+	ReservationStatus_name = map[int32]string{
+		0: "RESERVATION_STATUS_UNSPECIFIED",
+		1: "RESERVATION_STATUS_RESERVED",
+		2: "RESERVATION_STATUS_RELEASED",
+		3: "RESERVATION_STATUS_REJECTED",
+	}
+	ReservationStatus_value = map[string]int32{
+		"RESERVATION_STATUS_UNSPECIFIED": 0,
+		"RESERVATION_STATUS_RESERVED":    1,
+		"RESERVATION_STATUS_RELEASED":    2,
+		"RESERVATION_STATUS_REJECTED":    3,
+	}
+)
+
+func (x ReservationStatus) Enum() *ReservationStatus {
+	p := new(ReservationStatus)
+	*p = x
+	return p
+}
+
+func (x ReservationStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ReservationStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_catalog_v1_catalog_proto_enumTypes[4].Descriptor()
+}
+
+func (ReservationStatus) Type() protoreflect.EnumType {
+	return &file_catalog_v1_catalog_proto_enumTypes[4]
+}
+
+func (x ReservationStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ReservationStatus.Descriptor instead.
+func (ReservationStatus) EnumDescriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{4}
+}
+
 type Show struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ShowId        string                 `protobuf:"bytes,1,opt,name=show_id,json=showId,proto3" json:"show_id,omitempty"`
@@ -1976,29 +2083,35 @@ func (x *ListSeatAreasResponse) GetSeatAreas() []*SeatArea {
 	return nil
 }
 
-type CheckAvailabilityRequest struct {
+type Seat struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
-	SeatAreaId    string                 `protobuf:"bytes,2,opt,name=seat_area_id,json=seatAreaId,proto3" json:"seat_area_id,omitempty"`
-	Quantity      int32                  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	SeatId        string                 `protobuf:"bytes,1,opt,name=seat_id,json=seatId,proto3" json:"seat_id,omitempty"`
+	SessionId     string                 `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	SeatAreaId    string                 `protobuf:"bytes,3,opt,name=seat_area_id,json=seatAreaId,proto3" json:"seat_area_id,omitempty"`
+	Row           string                 `protobuf:"bytes,4,opt,name=row,proto3" json:"row,omitempty"`        // e.g. "F"
+	Number        int32                  `protobuf:"varint,5,opt,name=number,proto3" json:"number,omitempty"` // e.g. 12
+	X             float64                `protobuf:"fixed64,6,opt,name=x,proto3" json:"x,omitempty"`          // Position on the seat map
+	Y             float64                `protobuf:"fixed64,7,opt,name=y,proto3" json:"y,omitempty"`
+	Status        SeatStatus             `protobuf:"varint,8,opt,name=status,proto3,enum=catalog.v1.SeatStatus" json:"status,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CheckAvailabilityRequest) Reset() {
-	*x = CheckAvailabilityRequest{}
+func (x *Seat) Reset() {
+	*x = Seat{}
 	mi := &file_catalog_v1_catalog_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CheckAvailabilityRequest) String() string {
+func (x *Seat) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CheckAvailabilityRequest) ProtoMessage() {}
+func (*Seat) ProtoMessage() {}
 
-func (x *CheckAvailabilityRequest) ProtoReflect() protoreflect.Message {
+func (x *Seat) ProtoReflect() protoreflect.Message {
 	mi := &file_catalog_v1_catalog_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2010,55 +2123,100 @@ func (x *CheckAvailabilityRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CheckAvailabilityRequest.ProtoReflect.Descriptor instead.
-func (*CheckAvailabilityRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use Seat.ProtoReflect.Descriptor instead.
+func (*Seat) Descriptor() ([]byte, []int) {
 	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *CheckAvailabilityRequest) GetSessionId() string {
+func (x *Seat) GetSeatId() string {
+	if x != nil {
+		return x.SeatId
+	}
+	return ""
+}
+
+func (x *Seat) GetSessionId() string {
 	if x != nil {
 		return x.SessionId
 	}
 	return ""
 }
 
-func (x *CheckAvailabilityRequest) GetSeatAreaId() string {
+func (x *Seat) GetSeatAreaId() string {
 	if x != nil {
 		return x.SeatAreaId
 	}
 	return ""
 }
 
-func (x *CheckAvailabilityRequest) GetQuantity() int32 {
+func (x *Seat) GetRow() string {
 	if x != nil {
-		return x.Quantity
+		return x.Row
+	}
+	return ""
+}
+
+func (x *Seat) GetNumber() int32 {
+	if x != nil {
+		return x.Number
 	}
 	return 0
 }
 
-type CheckAvailabilityResponse struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Available      bool                   `protobuf:"varint,1,opt,name=available,proto3" json:"available,omitempty"`
-	AvailableSeats int32                  `protobuf:"varint,2,opt,name=available_seats,json=availableSeats,proto3" json:"available_seats,omitempty"`
-	Price          string                 `protobuf:"bytes,3,opt,name=price,proto3" json:"price,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+func (x *Seat) GetX() float64 {
+	if x != nil {
+		return x.X
+	}
+	return 0
 }
 
-func (x *CheckAvailabilityResponse) Reset() {
-	*x = CheckAvailabilityResponse{}
+func (x *Seat) GetY() float64 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+func (x *Seat) GetStatus() SeatStatus {
+	if x != nil {
+		return x.Status
+	}
+	return SeatStatus_SEAT_STATUS_UNSPECIFIED
+}
+
+func (x *Seat) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type SeatSpec struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SeatAreaId    string                 `protobuf:"bytes,1,opt,name=seat_area_id,json=seatAreaId,proto3" json:"seat_area_id,omitempty"`
+	Row           string                 `protobuf:"bytes,2,opt,name=row,proto3" json:"row,omitempty"`
+	Number        int32                  `protobuf:"varint,3,opt,name=number,proto3" json:"number,omitempty"`
+	X             float64                `protobuf:"fixed64,4,opt,name=x,proto3" json:"x,omitempty"`
+	Y             float64                `protobuf:"fixed64,5,opt,name=y,proto3" json:"y,omitempty"`
+	Blocked       bool                   `protobuf:"varint,6,opt,name=blocked,proto3" json:"blocked,omitempty"` // Seat exists on the map but is not for sale
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SeatSpec) Reset() {
+	*x = SeatSpec{}
 	mi := &file_catalog_v1_catalog_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CheckAvailabilityResponse) String() string {
+func (x *SeatSpec) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CheckAvailabilityResponse) ProtoMessage() {}
+func (*SeatSpec) ProtoMessage() {}
 
-func (x *CheckAvailabilityResponse) ProtoReflect() protoreflect.Message {
+func (x *SeatSpec) ProtoReflect() protoreflect.Message {
 	mi := &file_catalog_v1_catalog_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2070,56 +2228,75 @@ func (x *CheckAvailabilityResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CheckAvailabilityResponse.ProtoReflect.Descriptor instead.
-func (*CheckAvailabilityResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SeatSpec.ProtoReflect.Descriptor instead.
+func (*SeatSpec) Descriptor() ([]byte, []int) {
 	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *CheckAvailabilityResponse) GetAvailable() bool {
+func (x *SeatSpec) GetSeatAreaId() string {
 	if x != nil {
-		return x.Available
+		return x.SeatAreaId
 	}
-	return false
+	return ""
 }
 
-func (x *CheckAvailabilityResponse) GetAvailableSeats() int32 {
+func (x *SeatSpec) GetRow() string {
 	if x != nil {
-		return x.AvailableSeats
+		return x.Row
+	}
+	return ""
+}
+
+func (x *SeatSpec) GetNumber() int32 {
+	if x != nil {
+		return x.Number
 	}
 	return 0
 }
 
-func (x *CheckAvailabilityResponse) GetPrice() string {
+func (x *SeatSpec) GetX() float64 {
 	if x != nil {
-		return x.Price
+		return x.X
 	}
-	return ""
+	return 0
 }
 
-type ReserveSeatsRequest struct {
+func (x *SeatSpec) GetY() float64 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+func (x *SeatSpec) GetBlocked() bool {
+	if x != nil {
+		return x.Blocked
+	}
+	return false
+}
+
+type CreateSeatMapRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
-	SeatAreaId    string                 `protobuf:"bytes,2,opt,name=seat_area_id,json=seatAreaId,proto3" json:"seat_area_id,omitempty"`
-	Quantity      int32                  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
-	OrderId       string                 `protobuf:"bytes,4,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Seats         []*SeatSpec            `protobuf:"bytes,2,rep,name=seats,proto3" json:"seats,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ReserveSeatsRequest) Reset() {
-	*x = ReserveSeatsRequest{}
+func (x *CreateSeatMapRequest) Reset() {
+	*x = CreateSeatMapRequest{}
 	mi := &file_catalog_v1_catalog_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ReserveSeatsRequest) String() string {
+func (x *CreateSeatMapRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReserveSeatsRequest) ProtoMessage() {}
+func (*CreateSeatMapRequest) ProtoMessage() {}
 
-func (x *ReserveSeatsRequest) ProtoReflect() protoreflect.Message {
+func (x *CreateSeatMapRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_catalog_v1_catalog_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2131,61 +2308,46 @@ func (x *ReserveSeatsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReserveSeatsRequest.ProtoReflect.Descriptor instead.
-func (*ReserveSeatsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateSeatMapRequest.ProtoReflect.Descriptor instead.
+func (*CreateSeatMapRequest) Descriptor() ([]byte, []int) {
 	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *ReserveSeatsRequest) GetSessionId() string {
+func (x *CreateSeatMapRequest) GetSessionId() string {
 	if x != nil {
 		return x.SessionId
 	}
 	return ""
 }
 
-func (x *ReserveSeatsRequest) GetSeatAreaId() string {
-	if x != nil {
-		return x.SeatAreaId
-	}
-	return ""
-}
-
-func (x *ReserveSeatsRequest) GetQuantity() int32 {
-	if x != nil {
-		return x.Quantity
-	}
-	return 0
-}
-
-func (x *ReserveSeatsRequest) GetOrderId() string {
+func (x *CreateSeatMapRequest) GetSeats() []*SeatSpec {
 	if x != nil {
-		return x.OrderId
+		return x.Seats
 	}
-	return ""
+	return nil
 }
 
-type ReserveSeatsResponse struct {
+type CreateSeatMapResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Seats         []*Seat                `protobuf:"bytes,1,rep,name=seats,proto3" json:"seats,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ReserveSeatsResponse) Reset() {
-	*x = ReserveSeatsResponse{}
+func (x *CreateSeatMapResponse) Reset() {
+	*x = CreateSeatMapResponse{}
 	mi := &file_catalog_v1_catalog_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ReserveSeatsResponse) String() string {
+func (x *CreateSeatMapResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReserveSeatsResponse) ProtoMessage() {}
+func (*CreateSeatMapResponse) ProtoMessage() {}
 
-func (x *ReserveSeatsResponse) ProtoReflect() protoreflect.Message {
+func (x *CreateSeatMapResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_catalog_v1_catalog_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2197,49 +2359,39 @@ func (x *ReserveSeatsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReserveSeatsResponse.ProtoReflect.Descriptor instead.
-func (*ReserveSeatsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateSeatMapResponse.ProtoReflect.Descriptor instead.
+func (*CreateSeatMapResponse) Descriptor() ([]byte, []int) {
 	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *ReserveSeatsResponse) GetSuccess() bool {
-	if x != nil {
-		return x.Success
-	}
-	return false
-}
-
-func (x *ReserveSeatsResponse) GetMessage() string {
+func (x *CreateSeatMapResponse) GetSeats() []*Seat {
 	if x != nil {
-		return x.Message
+		return x.Seats
 	}
-	return ""
+	return nil
 }
 
-type ReleaseSeatsRequest struct {
+type ListSeatsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
-	SeatAreaId    string                 `protobuf:"bytes,2,opt,name=seat_area_id,json=seatAreaId,proto3" json:"seat_area_id,omitempty"`
-	Quantity      int32                  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
-	OrderId       string                 `protobuf:"bytes,4,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	SeatAreaId    string                 `protobuf:"bytes,1,opt,name=seat_area_id,json=seatAreaId,proto3" json:"seat_area_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ReleaseSeatsRequest) Reset() {
-	*x = ReleaseSeatsRequest{}
+func (x *ListSeatsRequest) Reset() {
+	*x = ListSeatsRequest{}
 	mi := &file_catalog_v1_catalog_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ReleaseSeatsRequest) String() string {
+func (x *ListSeatsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReleaseSeatsRequest) ProtoMessage() {}
+func (*ListSeatsRequest) ProtoMessage() {}
 
-func (x *ReleaseSeatsRequest) ProtoReflect() protoreflect.Message {
+func (x *ListSeatsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_catalog_v1_catalog_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2251,61 +2403,39 @@ func (x *ReleaseSeatsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReleaseSeatsRequest.ProtoReflect.Descriptor instead.
-func (*ReleaseSeatsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListSeatsRequest.ProtoReflect.Descriptor instead.
+func (*ListSeatsRequest) Descriptor() ([]byte, []int) {
 	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *ReleaseSeatsRequest) GetSessionId() string {
-	if x != nil {
-		return x.SessionId
-	}
-	return ""
-}
-
-func (x *ReleaseSeatsRequest) GetSeatAreaId() string {
+func (x *ListSeatsRequest) GetSeatAreaId() string {
 	if x != nil {
 		return x.SeatAreaId
 	}
 	return ""
 }
 
-func (x *ReleaseSeatsRequest) GetQuantity() int32 {
-	if x != nil {
-		return x.Quantity
-	}
-	return 0
-}
-
-func (x *ReleaseSeatsRequest) GetOrderId() string {
-	if x != nil {
-		return x.OrderId
-	}
-	return ""
-}
-
-type ReleaseSeatsResponse struct {
+type ListSeatsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Seats         []*Seat                `protobuf:"bytes,1,rep,name=seats,proto3" json:"seats,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ReleaseSeatsResponse) Reset() {
-	*x = ReleaseSeatsResponse{}
+func (x *ListSeatsResponse) Reset() {
+	*x = ListSeatsResponse{}
 	mi := &file_catalog_v1_catalog_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ReleaseSeatsResponse) String() string {
+func (x *ListSeatsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReleaseSeatsResponse) ProtoMessage() {}
+func (*ListSeatsResponse) ProtoMessage() {}
 
-func (x *ReleaseSeatsResponse) ProtoReflect() protoreflect.Message {
+func (x *ListSeatsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_catalog_v1_catalog_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2317,26 +2447,737 @@ func (x *ReleaseSeatsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReleaseSeatsResponse.ProtoReflect.Descriptor instead.
-func (*ReleaseSeatsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListSeatsResponse.ProtoReflect.Descriptor instead.
+func (*ListSeatsResponse) Descriptor() ([]byte, []int) {
 	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *ReleaseSeatsResponse) GetSuccess() bool {
+func (x *ListSeatsResponse) GetSeats() []*Seat {
 	if x != nil {
-		return x.Success
+		return x.Seats
 	}
-	return false
+	return nil
 }
 
-func (x *ReleaseSeatsResponse) GetMessage() string {
-	if x != nil {
-		return x.Message
-	}
-	return ""
+type CheckAvailabilityRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	SeatAreaId    string                 `protobuf:"bytes,2,opt,name=seat_area_id,json=seatAreaId,proto3" json:"seat_area_id,omitempty"`
+	Quantity      int32                  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	HoldToken     string                 `protobuf:"bytes,4,opt,name=hold_token,json=holdToken,proto3" json:"hold_token,omitempty"` // Seats under this hold count as available
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-var File_catalog_v1_catalog_proto protoreflect.FileDescriptor
+func (x *CheckAvailabilityRequest) Reset() {
+	*x = CheckAvailabilityRequest{}
+	mi := &file_catalog_v1_catalog_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckAvailabilityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckAvailabilityRequest) ProtoMessage() {}
+
+func (x *CheckAvailabilityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckAvailabilityRequest.ProtoReflect.Descriptor instead.
+func (*CheckAvailabilityRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *CheckAvailabilityRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *CheckAvailabilityRequest) GetSeatAreaId() string {
+	if x != nil {
+		return x.SeatAreaId
+	}
+	return ""
+}
+
+func (x *CheckAvailabilityRequest) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *CheckAvailabilityRequest) GetHoldToken() string {
+	if x != nil {
+		return x.HoldToken
+	}
+	return ""
+}
+
+type CheckAvailabilityResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Available      bool                   `protobuf:"varint,1,opt,name=available,proto3" json:"available,omitempty"`
+	AvailableSeats int32                  `protobuf:"varint,2,opt,name=available_seats,json=availableSeats,proto3" json:"available_seats,omitempty"`
+	Price          string                 `protobuf:"bytes,3,opt,name=price,proto3" json:"price,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CheckAvailabilityResponse) Reset() {
+	*x = CheckAvailabilityResponse{}
+	mi := &file_catalog_v1_catalog_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckAvailabilityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckAvailabilityResponse) ProtoMessage() {}
+
+func (x *CheckAvailabilityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckAvailabilityResponse.ProtoReflect.Descriptor instead.
+func (*CheckAvailabilityResponse) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *CheckAvailabilityResponse) GetAvailable() bool {
+	if x != nil {
+		return x.Available
+	}
+	return false
+}
+
+func (x *CheckAvailabilityResponse) GetAvailableSeats() int32 {
+	if x != nil {
+		return x.AvailableSeats
+	}
+	return 0
+}
+
+func (x *CheckAvailabilityResponse) GetPrice() string {
+	if x != nil {
+		return x.Price
+	}
+	return ""
+}
+
+type HoldSeatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	SeatAreaId    string                 `protobuf:"bytes,2,opt,name=seat_area_id,json=seatAreaId,proto3" json:"seat_area_id,omitempty"`
+	Quantity      int32                  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"` // May be 0 when seat_ids is set
+	SeatIds       []string               `protobuf:"bytes,4,rep,name=seat_ids,json=seatIds,proto3" json:"seat_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HoldSeatsRequest) Reset() {
+	*x = HoldSeatsRequest{}
+	mi := &file_catalog_v1_catalog_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HoldSeatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HoldSeatsRequest) ProtoMessage() {}
+
+func (x *HoldSeatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HoldSeatsRequest.ProtoReflect.Descriptor instead.
+func (*HoldSeatsRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *HoldSeatsRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *HoldSeatsRequest) GetSeatAreaId() string {
+	if x != nil {
+		return x.SeatAreaId
+	}
+	return ""
+}
+
+func (x *HoldSeatsRequest) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *HoldSeatsRequest) GetSeatIds() []string {
+	if x != nil {
+		return x.SeatIds
+	}
+	return nil
+}
+
+type HoldSeatsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HoldToken     string                 `protobuf:"bytes,1,opt,name=hold_token,json=holdToken,proto3" json:"hold_token,omitempty"`
+	Quantity      int32                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	SeatIds       []string               `protobuf:"bytes,3,rep,name=seat_ids,json=seatIds,proto3" json:"seat_ids,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HoldSeatsResponse) Reset() {
+	*x = HoldSeatsResponse{}
+	mi := &file_catalog_v1_catalog_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HoldSeatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HoldSeatsResponse) ProtoMessage() {}
+
+func (x *HoldSeatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HoldSeatsResponse.ProtoReflect.Descriptor instead.
+func (*HoldSeatsResponse) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *HoldSeatsResponse) GetHoldToken() string {
+	if x != nil {
+		return x.HoldToken
+	}
+	return ""
+}
+
+func (x *HoldSeatsResponse) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *HoldSeatsResponse) GetSeatIds() []string {
+	if x != nil {
+		return x.SeatIds
+	}
+	return nil
+}
+
+func (x *HoldSeatsResponse) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+type ReserveSeatsRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	SessionId  string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	SeatAreaId string                 `protobuf:"bytes,2,opt,name=seat_area_id,json=seatAreaId,proto3" json:"seat_area_id,omitempty"`
+	Quantity   int32                  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"` // May be 0 when seat_ids is set
+	OrderId    string                 `protobuf:"bytes,4,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	// Specific seats to reserve, all or nothing. Empty reserves any quantity seats in the area.
+	SeatIds []string `protobuf:"bytes,5,rep,name=seat_ids,json=seatIds,proto3" json:"seat_ids,omitempty"`
+	// Token from HoldSeats to convert into this reservation. quantity and seat_ids default to the hold's.
+	HoldToken     string `protobuf:"bytes,6,opt,name=hold_token,json=holdToken,proto3" json:"hold_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReserveSeatsRequest) Reset() {
+	*x = ReserveSeatsRequest{}
+	mi := &file_catalog_v1_catalog_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReserveSeatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReserveSeatsRequest) ProtoMessage() {}
+
+func (x *ReserveSeatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReserveSeatsRequest.ProtoReflect.Descriptor instead.
+func (*ReserveSeatsRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ReserveSeatsRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *ReserveSeatsRequest) GetSeatAreaId() string {
+	if x != nil {
+		return x.SeatAreaId
+	}
+	return ""
+}
+
+func (x *ReserveSeatsRequest) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *ReserveSeatsRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *ReserveSeatsRequest) GetSeatIds() []string {
+	if x != nil {
+		return x.SeatIds
+	}
+	return nil
+}
+
+func (x *ReserveSeatsRequest) GetHoldToken() string {
+	if x != nil {
+		return x.HoldToken
+	}
+	return ""
+}
+
+type ReserveSeatsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReserveSeatsResponse) Reset() {
+	*x = ReserveSeatsResponse{}
+	mi := &file_catalog_v1_catalog_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReserveSeatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReserveSeatsResponse) ProtoMessage() {}
+
+func (x *ReserveSeatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReserveSeatsResponse.ProtoReflect.Descriptor instead.
+func (*ReserveSeatsResponse) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ReserveSeatsResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ReserveSeatsResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ReleaseSeatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	SeatAreaId    string                 `protobuf:"bytes,2,opt,name=seat_area_id,json=seatAreaId,proto3" json:"seat_area_id,omitempty"`
+	Quantity      int32                  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	OrderId       string                 `protobuf:"bytes,4,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReleaseSeatsRequest) Reset() {
+	*x = ReleaseSeatsRequest{}
+	mi := &file_catalog_v1_catalog_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseSeatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseSeatsRequest) ProtoMessage() {}
+
+func (x *ReleaseSeatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseSeatsRequest.ProtoReflect.Descriptor instead.
+func (*ReleaseSeatsRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ReleaseSeatsRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *ReleaseSeatsRequest) GetSeatAreaId() string {
+	if x != nil {
+		return x.SeatAreaId
+	}
+	return ""
+}
+
+func (x *ReleaseSeatsRequest) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *ReleaseSeatsRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+type ReleaseSeatsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReleaseSeatsResponse) Reset() {
+	*x = ReleaseSeatsResponse{}
+	mi := &file_catalog_v1_catalog_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseSeatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseSeatsResponse) ProtoMessage() {}
+
+func (x *ReleaseSeatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseSeatsResponse.ProtoReflect.Descriptor instead.
+func (*ReleaseSeatsResponse) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ReleaseSeatsResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ReleaseSeatsResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type SeatReservation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReservationId string                 `protobuf:"bytes,1,opt,name=reservation_id,json=reservationId,proto3" json:"reservation_id,omitempty"`
+	OrderId       string                 `protobuf:"bytes,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	SessionId     string                 `protobuf:"bytes,3,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	SeatAreaId    string                 `protobuf:"bytes,4,opt,name=seat_area_id,json=seatAreaId,proto3" json:"seat_area_id,omitempty"`
+	Quantity      int32                  `protobuf:"varint,5,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Status        ReservationStatus      `protobuf:"varint,6,opt,name=status,proto3,enum=catalog.v1.ReservationStatus" json:"status,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	SeatIds       []string               `protobuf:"bytes,9,rep,name=seat_ids,json=seatIds,proto3" json:"seat_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SeatReservation) Reset() {
+	*x = SeatReservation{}
+	mi := &file_catalog_v1_catalog_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SeatReservation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SeatReservation) ProtoMessage() {}
+
+func (x *SeatReservation) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SeatReservation.ProtoReflect.Descriptor instead.
+func (*SeatReservation) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *SeatReservation) GetReservationId() string {
+	if x != nil {
+		return x.ReservationId
+	}
+	return ""
+}
+
+func (x *SeatReservation) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *SeatReservation) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *SeatReservation) GetSeatAreaId() string {
+	if x != nil {
+		return x.SeatAreaId
+	}
+	return ""
+}
+
+func (x *SeatReservation) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *SeatReservation) GetStatus() ReservationStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ReservationStatus_RESERVATION_STATUS_UNSPECIFIED
+}
+
+func (x *SeatReservation) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *SeatReservation) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *SeatReservation) GetSeatIds() []string {
+	if x != nil {
+		return x.SeatIds
+	}
+	return nil
+}
+
+type ListReservationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReservationsRequest) Reset() {
+	*x = ListReservationsRequest{}
+	mi := &file_catalog_v1_catalog_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReservationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReservationsRequest) ProtoMessage() {}
+
+func (x *ListReservationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReservationsRequest.ProtoReflect.Descriptor instead.
+func (*ListReservationsRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ListReservationsRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+type ListReservationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reservations  []*SeatReservation     `protobuf:"bytes,1,rep,name=reservations,proto3" json:"reservations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReservationsResponse) Reset() {
+	*x = ListReservationsResponse{}
+	mi := &file_catalog_v1_catalog_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReservationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReservationsResponse) ProtoMessage() {}
+
+func (x *ListReservationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReservationsResponse.ProtoReflect.Descriptor instead.
+func (*ListReservationsResponse) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ListReservationsResponse) GetReservations() []*SeatReservation {
+	if x != nil {
+		return x.Reservations
+	}
+	return nil
+}
+
+var File_catalog_v1_catalog_proto protoreflect.FileDescriptor
 
 const file_catalog_v1_catalog_proto_rawDesc = "" +
 	"\n" +
@@ -2494,24 +3335,76 @@ const file_catalog_v1_catalog_proto_rawDesc = "" +
 	"session_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\tsessionId\"L\n" +
 	"\x15ListSeatAreasResponse\x123\n" +
 	"\n" +
-	"seat_areas\x18\x01 \x03(\v2\x14.catalog.v1.SeatAreaR\tseatAreas\"\x94\x01\n" +
+	"seat_areas\x18\x01 \x03(\v2\x14.catalog.v1.SeatAreaR\tseatAreas\"\x91\x02\n" +
+	"\x04Seat\x12\x17\n" +
+	"\aseat_id\x18\x01 \x01(\tR\x06seatId\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x02 \x01(\tR\tsessionId\x12 \n" +
+	"\fseat_area_id\x18\x03 \x01(\tR\n" +
+	"seatAreaId\x12\x10\n" +
+	"\x03row\x18\x04 \x01(\tR\x03row\x12\x16\n" +
+	"\x06number\x18\x05 \x01(\x05R\x06number\x12\f\n" +
+	"\x01x\x18\x06 \x01(\x01R\x01x\x12\f\n" +
+	"\x01y\x18\a \x01(\x01R\x01y\x12.\n" +
+	"\x06status\x18\b \x01(\x0e2\x16.catalog.v1.SeatStatusR\x06status\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xaa\x01\n" +
+	"\bSeatSpec\x12*\n" +
+	"\fseat_area_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\n" +
+	"seatAreaId\x12\x1b\n" +
+	"\x03row\x18\x02 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x18\n" +
+	"R\x03row\x12\x1f\n" +
+	"\x06number\x18\x03 \x01(\x05B\a\xbaH\x04\x1a\x02 \x00R\x06number\x12\f\n" +
+	"\x01x\x18\x04 \x01(\x01R\x01x\x12\f\n" +
+	"\x01y\x18\x05 \x01(\x01R\x01y\x12\x18\n" +
+	"\ablocked\x18\x06 \x01(\bR\ablocked\"x\n" +
+	"\x14CreateSeatMapRequest\x12'\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\tsessionId\x127\n" +
+	"\x05seats\x18\x02 \x03(\v2\x14.catalog.v1.SeatSpecB\v\xbaH\b\x92\x01\x05\b\x01\x10\x90NR\x05seats\"?\n" +
+	"\x15CreateSeatMapResponse\x12&\n" +
+	"\x05seats\x18\x01 \x03(\v2\x10.catalog.v1.SeatR\x05seats\">\n" +
+	"\x10ListSeatsRequest\x12*\n" +
+	"\fseat_area_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\n" +
+	"seatAreaId\";\n" +
+	"\x11ListSeatsResponse\x12&\n" +
+	"\x05seats\x18\x01 \x03(\v2\x10.catalog.v1.SeatR\x05seats\"\xb3\x01\n" +
 	"\x18CheckAvailabilityRequest\x12'\n" +
 	"\n" +
 	"session_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\tsessionId\x12*\n" +
 	"\fseat_area_id\x18\x02 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\n" +
 	"seatAreaId\x12#\n" +
-	"\bquantity\x18\x03 \x01(\x05B\a\xbaH\x04\x1a\x02 \x00R\bquantity\"x\n" +
+	"\bquantity\x18\x03 \x01(\x05B\a\xbaH\x04\x1a\x02 \x00R\bquantity\x12\x1d\n" +
+	"\n" +
+	"hold_token\x18\x04 \x01(\tR\tholdToken\"x\n" +
 	"\x19CheckAvailabilityResponse\x12\x1c\n" +
 	"\tavailable\x18\x01 \x01(\bR\tavailable\x12'\n" +
 	"\x0favailable_seats\x18\x02 \x01(\x05R\x0eavailableSeats\x12\x14\n" +
-	"\x05price\x18\x03 \x01(\tR\x05price\"\xb4\x01\n" +
+	"\x05price\x18\x03 \x01(\tR\x05price\"\xba\x01\n" +
+	"\x10HoldSeatsRequest\x12'\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\tsessionId\x12*\n" +
+	"\fseat_area_id\x18\x02 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\n" +
+	"seatAreaId\x12#\n" +
+	"\bquantity\x18\x03 \x01(\x05B\a\xbaH\x04\x1a\x02(\x00R\bquantity\x12,\n" +
+	"\bseat_ids\x18\x04 \x03(\tB\x11\xbaH\x0e\x92\x01\v\x102\x18\x01\"\x05r\x03\xb0\x01\x01R\aseatIds\"\xa4\x01\n" +
+	"\x11HoldSeatsResponse\x12\x1d\n" +
+	"\n" +
+	"hold_token\x18\x01 \x01(\tR\tholdToken\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x05R\bquantity\x12\x19\n" +
+	"\bseat_ids\x18\x03 \x03(\tR\aseatIds\x129\n" +
+	"\n" +
+	"expires_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"\x81\x02\n" +
 	"\x13ReserveSeatsRequest\x12'\n" +
 	"\n" +
 	"session_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\tsessionId\x12*\n" +
 	"\fseat_area_id\x18\x02 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\n" +
 	"seatAreaId\x12#\n" +
-	"\bquantity\x18\x03 \x01(\x05B\a\xbaH\x04\x1a\x02 \x00R\bquantity\x12#\n" +
-	"\border_id\x18\x04 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\aorderId\"J\n" +
+	"\bquantity\x18\x03 \x01(\x05B\a\xbaH\x04\x1a\x02(\x00R\bquantity\x12#\n" +
+	"\border_id\x18\x04 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\aorderId\x12,\n" +
+	"\bseat_ids\x18\x05 \x03(\tB\x11\xbaH\x0e\x92\x01\v\x102\x18\x01\"\x05r\x03\xb0\x01\x01R\aseatIds\x12\x1d\n" +
+	"\n" +
+	"hold_token\x18\x06 \x01(\tR\tholdToken\"J\n" +
 	"\x14ReserveSeatsResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\"\xb4\x01\n" +
@@ -2524,7 +3417,25 @@ const file_catalog_v1_catalog_proto_rawDesc = "" +
 	"\border_id\x18\x04 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\aorderId\"J\n" +
 	"\x14ReleaseSeatsResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage*v\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\xf8\x02\n" +
+	"\x0fSeatReservation\x12%\n" +
+	"\x0ereservation_id\x18\x01 \x01(\tR\rreservationId\x12\x19\n" +
+	"\border_id\x18\x02 \x01(\tR\aorderId\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x03 \x01(\tR\tsessionId\x12 \n" +
+	"\fseat_area_id\x18\x04 \x01(\tR\n" +
+	"seatAreaId\x12\x1a\n" +
+	"\bquantity\x18\x05 \x01(\x05R\bquantity\x125\n" +
+	"\x06status\x18\x06 \x01(\x0e2\x1d.catalog.v1.ReservationStatusR\x06status\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x19\n" +
+	"\bseat_ids\x18\t \x03(\tR\aseatIds\">\n" +
+	"\x17ListReservationsRequest\x12#\n" +
+	"\border_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\aorderId\"[\n" +
+	"\x18ListReservationsResponse\x12?\n" +
+	"\freservations\x18\x01 \x03(\v2\x1b.catalog.v1.SeatReservationR\freservations*v\n" +
 	"\n" +
 	"ShowStatus\x12\x1b\n" +
 	"\x17SHOW_STATUS_UNSPECIFIED\x10\x00\x12\x15\n" +
@@ -2543,7 +3454,19 @@ const file_catalog_v1_catalog_proto_rawDesc = "" +
 	"\x18SESSION_STATUS_SCHEDULED\x10\x01\x12\x1a\n" +
 	"\x16SESSION_STATUS_ON_SALE\x10\x02\x12\x1b\n" +
 	"\x17SESSION_STATUS_SOLD_OUT\x10\x03\x12\x1c\n" +
-	"\x18SESSION_STATUS_CANCELLED\x10\x042\xd4\x0e\n" +
+	"\x18SESSION_STATUS_CANCELLED\x10\x04*\x8d\x01\n" +
+	"\n" +
+	"SeatStatus\x12\x1b\n" +
+	"\x17SEAT_STATUS_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15SEAT_STATUS_AVAILABLE\x10\x01\x12\x18\n" +
+	"\x14SEAT_STATUS_RESERVED\x10\x02\x12\x17\n" +
+	"\x13SEAT_STATUS_BLOCKED\x10\x03\x12\x14\n" +
+	"\x10SEAT_STATUS_HELD\x10\x04*\x9a\x01\n" +
+	"\x11ReservationStatus\x12\"\n" +
+	"\x1eRESERVATION_STATUS_UNSPECIFIED\x10\x00\x12\x1f\n" +
+	"\x1bRESERVATION_STATUS_RESERVED\x10\x01\x12\x1f\n" +
+	"\x1bRESERVATION_STATUS_RELEASED\x10\x02\x12\x1f\n" +
+	"\x1bRESERVATION_STATUS_REJECTED\x10\x032\xd0\x12\n" +
 	"\x0eCatalogService\x12m\n" +
 	"\n" +
 	"CreateShow\x12\x1d.catalog.v1.CreateShowRequest\x1a\x1e.catalog.v1.CreateShowResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/api/v1/catalog/shows\x12k\n" +
@@ -2562,10 +3485,14 @@ const file_catalog_v1_catalog_proto_rawDesc = "" +
 	"GetSession\x12\x1d.catalog.v1.GetSessionRequest\x1a\x1e.catalog.v1.GetSessionResponse\"-\x82\xd3\xe4\x93\x02'\x12%/api/v1/catalog/sessions/{session_id}\x12\x83\x01\n" +
 	"\fListSessions\x12\x1f.catalog.v1.ListSessionsRequest\x1a .catalog.v1.ListSessionsResponse\"0\x82\xd3\xe4\x93\x02*\x12(/api/v1/catalog/shows/{show_id}/sessions\x12\x94\x01\n" +
 	"\x0eCreateSeatArea\x12!.catalog.v1.CreateSeatAreaRequest\x1a\".catalog.v1.CreateSeatAreaResponse\";\x82\xd3\xe4\x93\x025:\x01*\"0/api/v1/catalog/sessions/{session_id}/seat-areas\x12\x8e\x01\n" +
-	"\rListSeatAreas\x12 .catalog.v1.ListSeatAreasRequest\x1a!.catalog.v1.ListSeatAreasResponse\"8\x82\xd3\xe4\x93\x022\x120/api/v1/catalog/sessions/{session_id}/seat-areas\x12`\n" +
-	"\x11CheckAvailability\x12$.catalog.v1.CheckAvailabilityRequest\x1a%.catalog.v1.CheckAvailabilityResponse\x12Q\n" +
+	"\rListSeatAreas\x12 .catalog.v1.ListSeatAreasRequest\x1a!.catalog.v1.ListSeatAreasResponse\"8\x82\xd3\xe4\x93\x022\x120/api/v1/catalog/sessions/{session_id}/seat-areas\x12\x8f\x01\n" +
+	"\rCreateSeatMap\x12 .catalog.v1.CreateSeatMapRequest\x1a!.catalog.v1.CreateSeatMapResponse\"9\x82\xd3\xe4\x93\x023:\x01*\"./api/v1/catalog/sessions/{session_id}/seat-map\x12\x81\x01\n" +
+	"\tListSeats\x12\x1c.catalog.v1.ListSeatsRequest\x1a\x1d.catalog.v1.ListSeatsResponse\"7\x82\xd3\xe4\x93\x021\x12//api/v1/catalog/seat-areas/{seat_area_id}/seats\x12`\n" +
+	"\x11CheckAvailability\x12$.catalog.v1.CheckAvailabilityRequest\x1a%.catalog.v1.CheckAvailabilityResponse\x12\x84\x01\n" +
+	"\tHoldSeats\x12\x1c.catalog.v1.HoldSeatsRequest\x1a\x1d.catalog.v1.HoldSeatsResponse\":\x82\xd3\xe4\x93\x024:\x01*\"//api/v1/catalog/seat-areas/{seat_area_id}/holds\x12Q\n" +
 	"\fReserveSeats\x12\x1f.catalog.v1.ReserveSeatsRequest\x1a .catalog.v1.ReserveSeatsResponse\x12Q\n" +
-	"\fReleaseSeats\x12\x1f.catalog.v1.ReleaseSeatsRequest\x1a .catalog.v1.ReleaseSeatsResponseB\xad\x01\n" +
+	"\fReleaseSeats\x12\x1f.catalog.v1.ReleaseSeatsRequest\x1a .catalog.v1.ReleaseSeatsResponse\x12]\n" +
+	"\x10ListReservations\x12#.catalog.v1.ListReservationsRequest\x1a$.catalog.v1.ListReservationsResponseB\xad\x01\n" +
 	"\x0ecom.catalog.v1B\fCatalogProtoP\x01ZDgithub.com/wylu1037/go-micro-boilerplate/gen/go/catalog/v1;catalogv1\xa2\x02\x03CXX\xaa\x02\n" +
 	"Catalog.V1\xca\x02\n" +
 	"Catalog\\V1\xe2\x02\x16Catalog\\V1\\GPBMetadata\xea\x02\vCatalog::V1b\x06proto3"
@@ -2582,126 +3509,157 @@ func file_catalog_v1_catalog_proto_rawDescGZIP() []byte {
 	return file_catalog_v1_catalog_proto_rawDescData
 }
 
-var file_catalog_v1_catalog_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_catalog_v1_catalog_proto_msgTypes = make([]protoimpl.MessageInfo, 36)
+var file_catalog_v1_catalog_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
+var file_catalog_v1_catalog_proto_msgTypes = make([]protoimpl.MessageInfo, 47)
 var file_catalog_v1_catalog_proto_goTypes = []any{
 	(ShowStatus)(0),                   // 0: catalog.v1.ShowStatus
 	(ShowCategory)(0),                 // 1: catalog.v1.ShowCategory
 	(SessionStatus)(0),                // 2: catalog.v1.SessionStatus
-	(*Show)(nil),                      // 3: catalog.v1.Show
-	(*CreateShowRequest)(nil),         // 4: catalog.v1.CreateShowRequest
-	(*CreateShowResponse)(nil),        // 5: catalog.v1.CreateShowResponse
-	(*GetShowRequest)(nil),            // 6: catalog.v1.GetShowRequest
-	(*GetShowResponse)(nil),           // 7: catalog.v1.GetShowResponse
-	(*ListShowsRequest)(nil),          // 8: catalog.v1.ListShowsRequest
-	(*ListShowsResponse)(nil),         // 9: catalog.v1.ListShowsResponse
-	(*UpdateShowRequest)(nil),         // 10: catalog.v1.UpdateShowRequest
-	(*UpdateShowResponse)(nil),        // 11: catalog.v1.UpdateShowResponse
-	(*DeleteShowRequest)(nil),         // 12: catalog.v1.DeleteShowRequest
-	(*DeleteShowResponse)(nil),        // 13: catalog.v1.DeleteShowResponse
-	(*Venue)(nil),                     // 14: catalog.v1.Venue
-	(*CreateVenueRequest)(nil),        // 15: catalog.v1.CreateVenueRequest
-	(*CreateVenueResponse)(nil),       // 16: catalog.v1.CreateVenueResponse
-	(*GetVenueRequest)(nil),           // 17: catalog.v1.GetVenueRequest
-	(*GetVenueResponse)(nil),          // 18: catalog.v1.GetVenueResponse
-	(*ListVenuesRequest)(nil),         // 19: catalog.v1.ListVenuesRequest
-	(*ListVenuesResponse)(nil),        // 20: catalog.v1.ListVenuesResponse
-	(*Session)(nil),                   // 21: catalog.v1.Session
-	(*CreateSessionRequest)(nil),      // 22: catalog.v1.CreateSessionRequest
-	(*CreateSessionResponse)(nil),     // 23: catalog.v1.CreateSessionResponse
-	(*GetSessionRequest)(nil),         // 24: catalog.v1.GetSessionRequest
-	(*GetSessionResponse)(nil),        // 25: catalog.v1.GetSessionResponse
-	(*ListSessionsRequest)(nil),       // 26: catalog.v1.ListSessionsRequest
-	(*ListSessionsResponse)(nil),      // 27: catalog.v1.ListSessionsResponse
-	(*SeatArea)(nil),                  // 28: catalog.v1.SeatArea
-	(*CreateSeatAreaRequest)(nil),     // 29: catalog.v1.CreateSeatAreaRequest
-	(*CreateSeatAreaResponse)(nil),    // 30: catalog.v1.CreateSeatAreaResponse
-	(*ListSeatAreasRequest)(nil),      // 31: catalog.v1.ListSeatAreasRequest
-	(*ListSeatAreasResponse)(nil),     // 32: catalog.v1.ListSeatAreasResponse
-	(*CheckAvailabilityRequest)(nil),  // 33: catalog.v1.CheckAvailabilityRequest
-	(*CheckAvailabilityResponse)(nil), // 34: catalog.v1.CheckAvailabilityResponse
-	(*ReserveSeatsRequest)(nil),       // 35: catalog.v1.ReserveSeatsRequest
-	(*ReserveSeatsResponse)(nil),      // 36: catalog.v1.ReserveSeatsResponse
-	(*ReleaseSeatsRequest)(nil),       // 37: catalog.v1.ReleaseSeatsRequest
-	(*ReleaseSeatsResponse)(nil),      // 38: catalog.v1.ReleaseSeatsResponse
-	(*timestamppb.Timestamp)(nil),     // 39: google.protobuf.Timestamp
-	(*v1.PaginationResponse)(nil),     // 40: common.v1.PaginationResponse
+	(SeatStatus)(0),                   // 3: catalog.v1.SeatStatus
+	(ReservationStatus)(0),            // 4: catalog.v1.ReservationStatus
+	(*Show)(nil),                      // 5: catalog.v1.Show
+	(*CreateShowRequest)(nil),         // 6: catalog.v1.CreateShowRequest
+	(*CreateShowResponse)(nil),        // 7: catalog.v1.CreateShowResponse
+	(*GetShowRequest)(nil),            // 8: catalog.v1.GetShowRequest
+	(*GetShowResponse)(nil),           // 9: catalog.v1.GetShowResponse
+	(*ListShowsRequest)(nil),          // 10: catalog.v1.ListShowsRequest
+	(*ListShowsResponse)(nil),         // 11: catalog.v1.ListShowsResponse
+	(*UpdateShowRequest)(nil),         // 12: catalog.v1.UpdateShowRequest
+	(*UpdateShowResponse)(nil),        // 13: catalog.v1.UpdateShowResponse
+	(*DeleteShowRequest)(nil),         // 14: catalog.v1.DeleteShowRequest
+	(*DeleteShowResponse)(nil),        // 15: catalog.v1.DeleteShowResponse
+	(*Venue)(nil),                     // 16: catalog.v1.Venue
+	(*CreateVenueRequest)(nil),        // 17: catalog.v1.CreateVenueRequest
+	(*CreateVenueResponse)(nil),       // 18: catalog.v1.CreateVenueResponse
+	(*GetVenueRequest)(nil),           // 19: catalog.v1.GetVenueRequest
+	(*GetVenueResponse)(nil),          // 20: catalog.v1.GetVenueResponse
+	(*ListVenuesRequest)(nil),         // 21: catalog.v1.ListVenuesRequest
+	(*ListVenuesResponse)(nil),        // 22: catalog.v1.ListVenuesResponse
+	(*Session)(nil),                   // 23: catalog.v1.Session
+	(*CreateSessionRequest)(nil),      // 24: catalog.v1.CreateSessionRequest
+	(*CreateSessionResponse)(nil),     // 25: catalog.v1.CreateSessionResponse
+	(*GetSessionRequest)(nil),         // 26: catalog.v1.GetSessionRequest
+	(*GetSessionResponse)(nil),        // 27: catalog.v1.GetSessionResponse
+	(*ListSessionsRequest)(nil),       // 28: catalog.v1.ListSessionsRequest
+	(*ListSessionsResponse)(nil),      // 29: catalog.v1.ListSessionsResponse
+	(*SeatArea)(nil),                  // 30: catalog.v1.SeatArea
+	(*CreateSeatAreaRequest)(nil),     // 31: catalog.v1.CreateSeatAreaRequest
+	(*CreateSeatAreaResponse)(nil),    // 32: catalog.v1.CreateSeatAreaResponse
+	(*ListSeatAreasRequest)(nil),      // 33: catalog.v1.ListSeatAreasRequest
+	(*ListSeatAreasResponse)(nil),     // 34: catalog.v1.ListSeatAreasResponse
+	(*Seat)(nil),                      // 35: catalog.v1.Seat
+	(*SeatSpec)(nil),                  // 36: catalog.v1.SeatSpec
+	(*CreateSeatMapRequest)(nil),      // 37: catalog.v1.CreateSeatMapRequest
+	(*CreateSeatMapResponse)(nil),     // 38: catalog.v1.CreateSeatMapResponse
+	(*ListSeatsRequest)(nil),          // 39: catalog.v1.ListSeatsRequest
+	(*ListSeatsResponse)(nil),         // 40: catalog.v1.ListSeatsResponse
+	(*CheckAvailabilityRequest)(nil),  // 41: catalog.v1.CheckAvailabilityRequest
+	(*CheckAvailabilityResponse)(nil), // 42: catalog.v1.CheckAvailabilityResponse
+	(*HoldSeatsRequest)(nil),          // 43: catalog.v1.HoldSeatsRequest
+	(*HoldSeatsResponse)(nil),         // 44: catalog.v1.HoldSeatsResponse
+	(*ReserveSeatsRequest)(nil),       // 45: catalog.v1.ReserveSeatsRequest
+	(*ReserveSeatsResponse)(nil),      // 46: catalog.v1.ReserveSeatsResponse
+	(*ReleaseSeatsRequest)(nil),       // 47: catalog.v1.ReleaseSeatsRequest
+	(*ReleaseSeatsResponse)(nil),      // 48: catalog.v1.ReleaseSeatsResponse
+	(*SeatReservation)(nil),           // 49: catalog.v1.SeatReservation
+	(*ListReservationsRequest)(nil),   // 50: catalog.v1.ListReservationsRequest
+	(*ListReservationsResponse)(nil),  // 51: catalog.v1.ListReservationsResponse
+	(*timestamppb.Timestamp)(nil),     // 52: google.protobuf.Timestamp
+	(*v1.PaginationResponse)(nil),     // 53: common.v1.PaginationResponse
 }
 var file_catalog_v1_catalog_proto_depIdxs = []int32{
 	1,  // 0: catalog.v1.Show.category:type_name -> catalog.v1.ShowCategory
 	0,  // 1: catalog.v1.Show.status:type_name -> catalog.v1.ShowStatus
-	39, // 2: catalog.v1.Show.created_at:type_name -> google.protobuf.Timestamp
-	39, // 3: catalog.v1.Show.updated_at:type_name -> google.protobuf.Timestamp
+	52, // 2: catalog.v1.Show.created_at:type_name -> google.protobuf.Timestamp
+	52, // 3: catalog.v1.Show.updated_at:type_name -> google.protobuf.Timestamp
 	1,  // 4: catalog.v1.CreateShowRequest.category:type_name -> catalog.v1.ShowCategory
-	3,  // 5: catalog.v1.CreateShowResponse.show:type_name -> catalog.v1.Show
-	3,  // 6: catalog.v1.GetShowResponse.show:type_name -> catalog.v1.Show
+	5,  // 5: catalog.v1.CreateShowResponse.show:type_name -> catalog.v1.Show
+	5,  // 6: catalog.v1.GetShowResponse.show:type_name -> catalog.v1.Show
 	1,  // 7: catalog.v1.ListShowsRequest.category:type_name -> catalog.v1.ShowCategory
 	0,  // 8: catalog.v1.ListShowsRequest.status:type_name -> catalog.v1.ShowStatus
-	3,  // 9: catalog.v1.ListShowsResponse.shows:type_name -> catalog.v1.Show
-	40, // 10: catalog.v1.ListShowsResponse.pagination:type_name -> common.v1.PaginationResponse
+	5,  // 9: catalog.v1.ListShowsResponse.shows:type_name -> catalog.v1.Show
+	53, // 10: catalog.v1.ListShowsResponse.pagination:type_name -> common.v1.PaginationResponse
 	1,  // 11: catalog.v1.UpdateShowRequest.category:type_name -> catalog.v1.ShowCategory
 	0,  // 12: catalog.v1.UpdateShowRequest.status:type_name -> catalog.v1.ShowStatus
-	3,  // 13: catalog.v1.UpdateShowResponse.show:type_name -> catalog.v1.Show
-	39, // 14: catalog.v1.Venue.created_at:type_name -> google.protobuf.Timestamp
-	14, // 15: catalog.v1.CreateVenueResponse.venue:type_name -> catalog.v1.Venue
-	14, // 16: catalog.v1.GetVenueResponse.venue:type_name -> catalog.v1.Venue
-	14, // 17: catalog.v1.ListVenuesResponse.venues:type_name -> catalog.v1.Venue
-	40, // 18: catalog.v1.ListVenuesResponse.pagination:type_name -> common.v1.PaginationResponse
-	14, // 19: catalog.v1.Session.venue:type_name -> catalog.v1.Venue
-	39, // 20: catalog.v1.Session.start_time:type_name -> google.protobuf.Timestamp
-	39, // 21: catalog.v1.Session.end_time:type_name -> google.protobuf.Timestamp
-	39, // 22: catalog.v1.Session.sale_start_time:type_name -> google.protobuf.Timestamp
-	39, // 23: catalog.v1.Session.sale_end_time:type_name -> google.protobuf.Timestamp
+	5,  // 13: catalog.v1.UpdateShowResponse.show:type_name -> catalog.v1.Show
+	52, // 14: catalog.v1.Venue.created_at:type_name -> google.protobuf.Timestamp
+	16, // 15: catalog.v1.CreateVenueResponse.venue:type_name -> catalog.v1.Venue
+	16, // 16: catalog.v1.GetVenueResponse.venue:type_name -> catalog.v1.Venue
+	16, // 17: catalog.v1.ListVenuesResponse.venues:type_name -> catalog.v1.Venue
+	53, // 18: catalog.v1.ListVenuesResponse.pagination:type_name -> common.v1.PaginationResponse
+	16, // 19: catalog.v1.Session.venue:type_name -> catalog.v1.Venue
+	52, // 20: catalog.v1.Session.start_time:type_name -> google.protobuf.Timestamp
+	52, // 21: catalog.v1.Session.end_time:type_name -> google.protobuf.Timestamp
+	52, // 22: catalog.v1.Session.sale_start_time:type_name -> google.protobuf.Timestamp
+	52, // 23: catalog.v1.Session.sale_end_time:type_name -> google.protobuf.Timestamp
 	2,  // 24: catalog.v1.Session.status:type_name -> catalog.v1.SessionStatus
-	39, // 25: catalog.v1.Session.created_at:type_name -> google.protobuf.Timestamp
-	39, // 26: catalog.v1.CreateSessionRequest.start_time:type_name -> google.protobuf.Timestamp
-	39, // 27: catalog.v1.CreateSessionRequest.end_time:type_name -> google.protobuf.Timestamp
-	39, // 28: catalog.v1.CreateSessionRequest.sale_start_time:type_name -> google.protobuf.Timestamp
-	39, // 29: catalog.v1.CreateSessionRequest.sale_end_time:type_name -> google.protobuf.Timestamp
-	21, // 30: catalog.v1.CreateSessionResponse.session:type_name -> catalog.v1.Session
-	21, // 31: catalog.v1.GetSessionResponse.session:type_name -> catalog.v1.Session
-	28, // 32: catalog.v1.GetSessionResponse.seat_areas:type_name -> catalog.v1.SeatArea
-	21, // 33: catalog.v1.ListSessionsResponse.sessions:type_name -> catalog.v1.Session
-	39, // 34: catalog.v1.SeatArea.created_at:type_name -> google.protobuf.Timestamp
-	28, // 35: catalog.v1.CreateSeatAreaResponse.seat_area:type_name -> catalog.v1.SeatArea
-	28, // 36: catalog.v1.ListSeatAreasResponse.seat_areas:type_name -> catalog.v1.SeatArea
-	4,  // 37: catalog.v1.CatalogService.CreateShow:input_type -> catalog.v1.CreateShowRequest
-	6,  // 38: catalog.v1.CatalogService.GetShow:input_type -> catalog.v1.GetShowRequest
-	8,  // 39: catalog.v1.CatalogService.ListShows:input_type -> catalog.v1.ListShowsRequest
-	10, // 40: catalog.v1.CatalogService.UpdateShow:input_type -> catalog.v1.UpdateShowRequest
-	12, // 41: catalog.v1.CatalogService.DeleteShow:input_type -> catalog.v1.DeleteShowRequest
-	15, // 42: catalog.v1.CatalogService.CreateVenue:input_type -> catalog.v1.CreateVenueRequest
-	17, // 43: catalog.v1.CatalogService.GetVenue:input_type -> catalog.v1.GetVenueRequest
-	19, // 44: catalog.v1.CatalogService.ListVenues:input_type -> catalog.v1.ListVenuesRequest
-	22, // 45: catalog.v1.CatalogService.CreateSession:input_type -> catalog.v1.CreateSessionRequest
-	24, // 46: catalog.v1.CatalogService.GetSession:input_type -> catalog.v1.GetSessionRequest
-	26, // 47: catalog.v1.CatalogService.ListSessions:input_type -> catalog.v1.ListSessionsRequest
-	29, // 48: catalog.v1.CatalogService.CreateSeatArea:input_type -> catalog.v1.CreateSeatAreaRequest
-	31, // 49: catalog.v1.CatalogService.ListSeatAreas:input_type -> catalog.v1.ListSeatAreasRequest
-	33, // 50: catalog.v1.CatalogService.CheckAvailability:input_type -> catalog.v1.CheckAvailabilityRequest
-	35, // 51: catalog.v1.CatalogService.ReserveSeats:input_type -> catalog.v1.ReserveSeatsRequest
-	37, // 52: catalog.v1.CatalogService.ReleaseSeats:input_type -> catalog.v1.ReleaseSeatsRequest
-	5,  // 53: catalog.v1.CatalogService.CreateShow:output_type -> catalog.v1.CreateShowResponse
-	7,  // 54: catalog.v1.CatalogService.GetShow:output_type -> catalog.v1.GetShowResponse
-	9,  // 55: catalog.v1.CatalogService.ListShows:output_type -> catalog.v1.ListShowsResponse
-	11, // 56: catalog.v1.CatalogService.UpdateShow:output_type -> catalog.v1.UpdateShowResponse
-	13, // 57: catalog.v1.CatalogService.DeleteShow:output_type -> catalog.v1.DeleteShowResponse
-	16, // 58: catalog.v1.CatalogService.CreateVenue:output_type -> catalog.v1.CreateVenueResponse
-	18, // 59: catalog.v1.CatalogService.GetVenue:output_type -> catalog.v1.GetVenueResponse
-	20, // 60: catalog.v1.CatalogService.ListVenues:output_type -> catalog.v1.ListVenuesResponse
-	23, // 61: catalog.v1.CatalogService.CreateSession:output_type -> catalog.v1.CreateSessionResponse
-	25, // 62: catalog.v1.CatalogService.GetSession:output_type -> catalog.v1.GetSessionResponse
-	27, // 63: catalog.v1.CatalogService.ListSessions:output_type -> catalog.v1.ListSessionsResponse
-	30, // 64: catalog.v1.CatalogService.CreateSeatArea:output_type -> catalog.v1.CreateSeatAreaResponse
-	32, // 65: catalog.v1.CatalogService.ListSeatAreas:output_type -> catalog.v1.ListSeatAreasResponse
-	34, // 66: catalog.v1.CatalogService.CheckAvailability:output_type -> catalog.v1.CheckAvailabilityResponse
-	36, // 67: catalog.v1.CatalogService.ReserveSeats:output_type -> catalog.v1.ReserveSeatsResponse
-	38, // 68: catalog.v1.CatalogService.ReleaseSeats:output_type -> catalog.v1.ReleaseSeatsResponse
-	53, // [53:69] is the sub-list for method output_type
-	37, // [37:53] is the sub-list for method input_type
-	37, // [37:37] is the sub-list for extension type_name
-	37, // [37:37] is the sub-list for extension extendee
-	0,  // [0:37] is the sub-list for field type_name
+	52, // 25: catalog.v1.Session.created_at:type_name -> google.protobuf.Timestamp
+	52, // 26: catalog.v1.CreateSessionRequest.start_time:type_name -> google.protobuf.Timestamp
+	52, // 27: catalog.v1.CreateSessionRequest.end_time:type_name -> google.protobuf.Timestamp
+	52, // 28: catalog.v1.CreateSessionRequest.sale_start_time:type_name -> google.protobuf.Timestamp
+	52, // 29: catalog.v1.CreateSessionRequest.sale_end_time:type_name -> google.protobuf.Timestamp
+	23, // 30: catalog.v1.CreateSessionResponse.session:type_name -> catalog.v1.Session
+	23, // 31: catalog.v1.GetSessionResponse.session:type_name -> catalog.v1.Session
+	30, // 32: catalog.v1.GetSessionResponse.seat_areas:type_name -> catalog.v1.SeatArea
+	23, // 33: catalog.v1.ListSessionsResponse.sessions:type_name -> catalog.v1.Session
+	52, // 34: catalog.v1.SeatArea.created_at:type_name -> google.protobuf.Timestamp
+	30, // 35: catalog.v1.CreateSeatAreaResponse.seat_area:type_name -> catalog.v1.SeatArea
+	30, // 36: catalog.v1.ListSeatAreasResponse.seat_areas:type_name -> catalog.v1.SeatArea
+	3,  // 37: catalog.v1.Seat.status:type_name -> catalog.v1.SeatStatus
+	52, // 38: catalog.v1.Seat.created_at:type_name -> google.protobuf.Timestamp
+	36, // 39: catalog.v1.CreateSeatMapRequest.seats:type_name -> catalog.v1.SeatSpec
+	35, // 40: catalog.v1.CreateSeatMapResponse.seats:type_name -> catalog.v1.Seat
+	35, // 41: catalog.v1.ListSeatsResponse.seats:type_name -> catalog.v1.Seat
+	52, // 42: catalog.v1.HoldSeatsResponse.expires_at:type_name -> google.protobuf.Timestamp
+	4,  // 43: catalog.v1.SeatReservation.status:type_name -> catalog.v1.ReservationStatus
+	52, // 44: catalog.v1.SeatReservation.created_at:type_name -> google.protobuf.Timestamp
+	52, // 45: catalog.v1.SeatReservation.updated_at:type_name -> google.protobuf.Timestamp
+	49, // 46: catalog.v1.ListReservationsResponse.reservations:type_name -> catalog.v1.SeatReservation
+	6,  // 47: catalog.v1.CatalogService.CreateShow:input_type -> catalog.v1.CreateShowRequest
+	8,  // 48: catalog.v1.CatalogService.GetShow:input_type -> catalog.v1.GetShowRequest
+	10, // 49: catalog.v1.CatalogService.ListShows:input_type -> catalog.v1.ListShowsRequest
+	12, // 50: catalog.v1.CatalogService.UpdateShow:input_type -> catalog.v1.UpdateShowRequest
+	14, // 51: catalog.v1.CatalogService.DeleteShow:input_type -> catalog.v1.DeleteShowRequest
+	17, // 52: catalog.v1.CatalogService.CreateVenue:input_type -> catalog.v1.CreateVenueRequest
+	19, // 53: catalog.v1.CatalogService.GetVenue:input_type -> catalog.v1.GetVenueRequest
+	21, // 54: catalog.v1.CatalogService.ListVenues:input_type -> catalog.v1.ListVenuesRequest
+	24, // 55: catalog.v1.CatalogService.CreateSession:input_type -> catalog.v1.CreateSessionRequest
+	26, // 56: catalog.v1.CatalogService.GetSession:input_type -> catalog.v1.GetSessionRequest
+	28, // 57: catalog.v1.CatalogService.ListSessions:input_type -> catalog.v1.ListSessionsRequest
+	31, // 58: catalog.v1.CatalogService.CreateSeatArea:input_type -> catalog.v1.CreateSeatAreaRequest
+	33, // 59: catalog.v1.CatalogService.ListSeatAreas:input_type -> catalog.v1.ListSeatAreasRequest
+	37, // 60: catalog.v1.CatalogService.CreateSeatMap:input_type -> catalog.v1.CreateSeatMapRequest
+	39, // 61: catalog.v1.CatalogService.ListSeats:input_type -> catalog.v1.ListSeatsRequest
+	41, // 62: catalog.v1.CatalogService.CheckAvailability:input_type -> catalog.v1.CheckAvailabilityRequest
+	43, // 63: catalog.v1.CatalogService.HoldSeats:input_type -> catalog.v1.HoldSeatsRequest
+	45, // 64: catalog.v1.CatalogService.ReserveSeats:input_type -> catalog.v1.ReserveSeatsRequest
+	47, // 65: catalog.v1.CatalogService.ReleaseSeats:input_type -> catalog.v1.ReleaseSeatsRequest
+	50, // 66: catalog.v1.CatalogService.ListReservations:input_type -> catalog.v1.ListReservationsRequest
+	7,  // 67: catalog.v1.CatalogService.CreateShow:output_type -> catalog.v1.CreateShowResponse
+	9,  // 68: catalog.v1.CatalogService.GetShow:output_type -> catalog.v1.GetShowResponse
+	11, // 69: catalog.v1.CatalogService.ListShows:output_type -> catalog.v1.ListShowsResponse
+	13, // 70: catalog.v1.CatalogService.UpdateShow:output_type -> catalog.v1.UpdateShowResponse
+	15, // 71: catalog.v1.CatalogService.DeleteShow:output_type -> catalog.v1.DeleteShowResponse
+	18, // 72: catalog.v1.CatalogService.CreateVenue:output_type -> catalog.v1.CreateVenueResponse
+	20, // 73: catalog.v1.CatalogService.GetVenue:output_type -> catalog.v1.GetVenueResponse
+	22, // 74: catalog.v1.CatalogService.ListVenues:output_type -> catalog.v1.ListVenuesResponse
+	25, // 75: catalog.v1.CatalogService.CreateSession:output_type -> catalog.v1.CreateSessionResponse
+	27, // 76: catalog.v1.CatalogService.GetSession:output_type -> catalog.v1.GetSessionResponse
+	29, // 77: catalog.v1.CatalogService.ListSessions:output_type -> catalog.v1.ListSessionsResponse
+	32, // 78: catalog.v1.CatalogService.CreateSeatArea:output_type -> catalog.v1.CreateSeatAreaResponse
+	34, // 79: catalog.v1.CatalogService.ListSeatAreas:output_type -> catalog.v1.ListSeatAreasResponse
+	38, // 80: catalog.v1.CatalogService.CreateSeatMap:output_type -> catalog.v1.CreateSeatMapResponse
+	40, // 81: catalog.v1.CatalogService.ListSeats:output_type -> catalog.v1.ListSeatsResponse
+	42, // 82: catalog.v1.CatalogService.CheckAvailability:output_type -> catalog.v1.CheckAvailabilityResponse
+	44, // 83: catalog.v1.CatalogService.HoldSeats:output_type -> catalog.v1.HoldSeatsResponse
+	46, // 84: catalog.v1.CatalogService.ReserveSeats:output_type -> catalog.v1.ReserveSeatsResponse
+	48, // 85: catalog.v1.CatalogService.ReleaseSeats:output_type -> catalog.v1.ReleaseSeatsResponse
+	51, // 86: catalog.v1.CatalogService.ListReservations:output_type -> catalog.v1.ListReservationsResponse
+	67, // [67:87] is the sub-list for method output_type
+	47, // [47:67] is the sub-list for method input_type
+	47, // [47:47] is the sub-list for extension type_name
+	47, // [47:47] is the sub-list for extension extendee
+	0,  // [0:47] is the sub-list for field type_name
 }
 
 func init() { file_catalog_v1_catalog_proto_init() }
@@ -2717,8 +3675,8 @@ func file_catalog_v1_catalog_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_catalog_v1_catalog_proto_rawDesc), len(file_catalog_v1_catalog_proto_rawDesc)),
-			NumEnums:      3,
-			NumMessages:   36,
+			NumEnums:      5,
+			NumMessages:   47,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -113,6 +113,24 @@ func NewCatalogServiceEndpoints() []*api.Endpoint {
 			Method:  []string{"GET"},
 			Handler: "rpc",
 		},
+		{
+			Name:    "CatalogService.CreateSeatMap",
+			Path:    []string{"/api/v1/catalog/sessions/{session_id}/seat-map"},
+			Method:  []string{"POST"},
+			Handler: "rpc",
+		},
+		{
+			Name:    "CatalogService.ListSeats",
+			Path:    []string{"/api/v1/catalog/seat-areas/{seat_area_id}/seats"},
+			Method:  []string{"GET"},
+			Handler: "rpc",
+		},
+		{
+			Name:    "CatalogService.HoldSeats",
+			Path:    []string{"/api/v1/catalog/seat-areas/{seat_area_id}/holds"},
+			Method:  []string{"POST"},
+			Handler: "rpc",
+		},
 	}
 }
 
@@ -145,12 +163,20 @@ type CatalogService interface {
 	CreateSeatArea(ctx context.Context, in *CreateSeatAreaRequest, opts ...client.CallOption) (*CreateSeatAreaResponse, error)
 	// Get seat areas for a session
 	ListSeatAreas(ctx context.Context, in *ListSeatAreasRequest, opts ...client.CallOption) (*ListSeatAreasResponse, error)
+	// Create the seat map (individual seats) of a session
+	CreateSeatMap(ctx context.Context, in *CreateSeatMapRequest, opts ...client.CallOption) (*CreateSeatMapResponse, error)
+	// List seats and their status for a seat area
+	ListSeats(ctx context.Context, in *ListSeatsRequest, opts ...client.CallOption) (*ListSeatsResponse, error)
 	// Check seat availability (for booking service)
 	CheckAvailability(ctx context.Context, in *CheckAvailabilityRequest, opts ...client.CallOption) (*CheckAvailabilityResponse, error)
+	// Hold seats for a short time before an order is created; the hold counts against availability
+	HoldSeats(ctx context.Context, in *HoldSeatsRequest, opts ...client.CallOption) (*HoldSeatsResponse, error)
 	// Reserve seats (for booking service)
 	ReserveSeats(ctx context.Context, in *ReserveSeatsRequest, opts ...client.CallOption) (*ReserveSeatsResponse, error)
 	// Release seats (for booking service, when order cancelled/expired)
 	ReleaseSeats(ctx context.Context, in *ReleaseSeatsRequest, opts ...client.CallOption) (*ReleaseSeatsResponse, error)
+	// List the seat reservations recorded for an order (for booking service reconciliation)
+	ListReservations(ctx context.Context, in *ListReservationsRequest, opts ...client.CallOption) (*ListReservationsResponse, error)
 }
 
 type catalogService struct {
@@ -295,6 +321,26 @@ func (c *catalogService) ListSeatAreas(ctx context.Context, in *ListSeatAreasReq
 	return out, nil
 }
 
+func (c *catalogService) CreateSeatMap(ctx context.Context, in *CreateSeatMapRequest, opts ...client.CallOption) (*CreateSeatMapResponse, error) {
+	req := c.c.NewRequest(c.name, "CatalogService.CreateSeatMap", in)
+	out := new(CreateSeatMapResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogService) ListSeats(ctx context.Context, in *ListSeatsRequest, opts ...client.CallOption) (*ListSeatsResponse, error) {
+	req := c.c.NewRequest(c.name, "CatalogService.ListSeats", in)
+	out := new(ListSeatsResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *catalogService) CheckAvailability(ctx context.Context, in *CheckAvailabilityRequest, opts ...client.CallOption) (*CheckAvailabilityResponse, error) {
 	req := c.c.NewRequest(c.name, "CatalogService.CheckAvailability", in)
 	out := new(CheckAvailabilityResponse)
@@ -305,6 +351,16 @@ func (c *catalogService) CheckAvailability(ctx context.Context, in *CheckAvailab
 	return out, nil
 }
 
+func (c *catalogService) HoldSeats(ctx context.Context, in *HoldSeatsRequest, opts ...client.CallOption) (*HoldSeatsResponse, error) {
+	req := c.c.NewRequest(c.name, "CatalogService.HoldSeats", in)
+	out := new(HoldSeatsResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *catalogService) ReserveSeats(ctx context.Context, in *ReserveSeatsRequest, opts ...client.CallOption) (*ReserveSeatsResponse, error) {
 	req := c.c.NewRequest(c.name, "CatalogService.ReserveSeats", in)
 	out := new(ReserveSeatsResponse)
@@ -325,6 +381,16 @@ func (c *catalogService) ReleaseSeats(ctx context.Context, in *ReleaseSeatsReque
 	return out, nil
 }
 
+func (c *catalogService) ListReservations(ctx context.Context, in *ListReservationsRequest, opts ...client.CallOption) (*ListReservationsResponse, error) {
+	req := c.c.NewRequest(c.name, "CatalogService.ListReservations", in)
+	out := new(ListReservationsResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for CatalogService service
 
 type CatalogServiceHandler interface {
@@ -354,12 +420,20 @@ type CatalogServiceHandler interface {
 	CreateSeatArea(context.Context, *CreateSeatAreaRequest, *CreateSeatAreaResponse) error
 	// Get seat areas for a session
 	ListSeatAreas(context.Context, *ListSeatAreasRequest, *ListSeatAreasResponse) error
+	// Create the seat map (individual seats) of a session
+	CreateSeatMap(context.Context, *CreateSeatMapRequest, *CreateSeatMapResponse) error
+	// List seats and their status for a seat area
+	ListSeats(context.Context, *ListSeatsRequest, *ListSeatsResponse) error
 	// Check seat availability (for booking service)
 	CheckAvailability(context.Context, *CheckAvailabilityRequest, *CheckAvailabilityResponse) error
+	// Hold seats for a short time before an order is created; the hold counts against availability
+	HoldSeats(context.Context, *HoldSeatsRequest, *HoldSeatsResponse) error
 	// Reserve seats (for booking service)
 	ReserveSeats(context.Context, *ReserveSeatsRequest, *ReserveSeatsResponse) error
 	// Release seats (for booking service, when order cancelled/expired)
 	ReleaseSeats(context.Context, *ReleaseSeatsRequest, *ReleaseSeatsResponse) error
+	// List the seat reservations recorded for an order (for booking service reconciliation)
+	ListReservations(context.Context, *ListReservationsRequest, *ListReservationsResponse) error
 }
 
 func RegisterCatalogServiceHandler(s server.Server, hdlr CatalogServiceHandler, opts ...server.HandlerOption) error {
@@ -377,9 +451,13 @@ func RegisterCatalogServiceHandler(s server.Server, hdlr CatalogServiceHandler,
 		ListSessions(ctx context.Context, in *ListSessionsRequest, out *ListSessionsResponse) error
 		CreateSeatArea(ctx context.Context, in *CreateSeatAreaRequest, out *CreateSeatAreaResponse) error
 		ListSeatAreas(ctx context.Context, in *ListSeatAreasRequest, out *ListSeatAreasResponse) error
+		CreateSeatMap(ctx context.Context, in *CreateSeatMapRequest, out *CreateSeatMapResponse) error
+		ListSeats(ctx context.Context, in *ListSeatsRequest, out *ListSeatsResponse) error
 		CheckAvailability(ctx context.Context, in *CheckAvailabilityRequest, out *CheckAvailabilityResponse) error
+		HoldSeats(ctx context.Context, in *HoldSeatsRequest, out *HoldSeatsResponse) error
 		ReserveSeats(ctx context.Context, in *ReserveSeatsRequest, out *ReserveSeatsResponse) error
 		ReleaseSeats(ctx context.Context, in *ReleaseSeatsRequest, out *ReleaseSeatsResponse) error
+		ListReservations(ctx context.Context, in *ListReservationsRequest, out *ListReservationsResponse) error
 	}
 	type CatalogService struct {
 		catalogService
@@ -463,6 +541,24 @@ func RegisterCatalogServiceHandler(s server.Server, hdlr CatalogServiceHandler,
 		Method:  []string{"GET"},
 		Handler: "rpc",
 	}))
+	opts = append(opts, api.WithEndpoint(&api.Endpoint{
+		Name:    "CatalogService.CreateSeatMap",
+		Path:    []string{"/api/v1/catalog/sessions/{session_id}/seat-map"},
+		Method:  []string{"POST"},
+		Handler: "rpc",
+	}))
+	opts = append(opts, api.WithEndpoint(&api.Endpoint{
+		Name:    "CatalogService.ListSeats",
+		Path:    []string{"/api/v1/catalog/seat-areas/{seat_area_id}/seats"},
+		Method:  []string{"GET"},
+		Handler: "rpc",
+	}))
+	opts = append(opts, api.WithEndpoint(&api.Endpoint{
+		Name:    "CatalogService.HoldSeats",
+		Path:    []string{"/api/v1/catalog/seat-areas/{seat_area_id}/holds"},
+		Method:  []string{"POST"},
+		Handler: "rpc",
+	}))
 	return s.Handle(s.NewHandler(&CatalogService{h}, opts...))
 }
 
@@ -522,10 +618,22 @@ func (h *catalogServiceHandler) ListSeatAreas(ctx context.Context, in *ListSeatA
 	return h.CatalogServiceHandler.ListSeatAreas(ctx, in, out)
 }
 
+func (h *catalogServiceHandler) CreateSeatMap(ctx context.Context, in *CreateSeatMapRequest, out *CreateSeatMapResponse) error {
+	return h.CatalogServiceHandler.CreateSeatMap(ctx, in, out)
+}
+
+func (h *catalogServiceHandler) ListSeats(ctx context.Context, in *ListSeatsRequest, out *ListSeatsResponse) error {
+	return h.CatalogServiceHandler.ListSeats(ctx, in, out)
+}
+
 func (h *catalogServiceHandler) CheckAvailability(ctx context.Context, in *CheckAvailabilityRequest, out *CheckAvailabilityResponse) error {
 	return h.CatalogServiceHandler.CheckAvailability(ctx, in, out)
 }
 
+func (h *catalogServiceHandler) HoldSeats(ctx context.Context, in *HoldSeatsRequest, out *HoldSeatsResponse) error {
+	return h.CatalogServiceHandler.HoldSeats(ctx, in, out)
+}
+
 func (h *catalogServiceHandler) ReserveSeats(ctx context.Context, in *ReserveSeatsRequest, out *ReserveSeatsResponse) error {
 	return h.CatalogServiceHandler.ReserveSeats(ctx, in, out)
 }
@@ -533,3 +641,7 @@ func (h *catalogServiceHandler) ReserveSeats(ctx context.Context, in *ReserveSea
 func (h *catalogServiceHandler) ReleaseSeats(ctx context.Context, in *ReleaseSeatsRequest, out *ReleaseSeatsResponse) error {
 	return h.CatalogServiceHandler.ReleaseSeats(ctx, in, out)
 }
+
+func (h *catalogServiceHandler) ListReservations(ctx context.Context, in *ListReservationsRequest, out *ListReservationsResponse) error {
+	return h.CatalogServiceHandler.ListReservations(ctx, in, out)
+}
@@ -52,6 +52,12 @@ func NewIdentityServiceEndpoints() []*api.Endpoint {
 			Method:  []string{"POST"},
 			Handler: "rpc",
 		},
+		{
+			Name:    "IdentityService.Logout",
+			Path:    []string{"/api/v1/auth/logout"},
+			Method:  []string{"POST"},
+			Handler: "rpc",
+		},
 		{
 			Name:    "IdentityService.GetProfile",
 			Path:    []string{"/api/v1/users/{user_id}"},
@@ -76,6 +82,30 @@ func NewIdentityServiceEndpoints() []*api.Endpoint {
 			Method:  []string{"POST"},
 			Handler: "rpc",
 		},
+		{
+			Name:    "IdentityService.VerifyEmail",
+			Path:    []string{"/api/v1/auth/email/verify"},
+			Method:  []string{"POST"},
+			Handler: "rpc",
+		},
+		{
+			Name:    "IdentityService.ResendVerification",
+			Path:    []string{"/api/v1/auth/email/resend-verification"},
+			Method:  []string{"POST"},
+			Handler: "rpc",
+		},
+		{
+			Name:    "IdentityService.ListSessions",
+			Path:    []string{"/api/v1/auth/sessions"},
+			Method:  []string{"GET"},
+			Handler: "rpc",
+		},
+		{
+			Name:    "IdentityService.RevokeSession",
+			Path:    []string{"/api/v1/auth/sessions/{session_id}"},
+			Method:  []string{"DELETE"},
+			Handler: "rpc",
+		},
 		{
 			Name:    "IdentityService.ValidateToken",
 			Path:    []string{"/api/v1/auth/validate"},
@@ -94,6 +124,8 @@ type IdentityService interface {
 	Login(ctx context.Context, in *LoginRequest, opts ...client.CallOption) (*LoginResponse, error)
 	// Refresh access token using refresh token
 	RefreshToken(ctx context.Context, in *RefreshTokenRequest, opts ...client.CallOption) (*RefreshTokenResponse, error)
+	// Log out the session the caller's token belongs to; its access and refresh tokens stop working
+	Logout(ctx context.Context, in *LogoutRequest, opts ...client.CallOption) (*LogoutResponse, error)
 	// Get current user's profile
 	GetProfile(ctx context.Context, in *GetProfileRequest, opts ...client.CallOption) (*GetProfileResponse, error)
 	// Update user profile
@@ -102,6 +134,14 @@ type IdentityService interface {
 	RequestPasswordReset(ctx context.Context, in *RequestPasswordResetRequest, opts ...client.CallOption) (*RequestPasswordResetResponse, error)
 	// Reset password with token
 	ResetPassword(ctx context.Context, in *ResetPasswordRequest, opts ...client.CallOption) (*ResetPasswordResponse, error)
+	// Verify the email address with the token from a verification email
+	VerifyEmail(ctx context.Context, in *VerifyEmailRequest, opts ...client.CallOption) (*VerifyEmailResponse, error)
+	// Send the caller a new verification email; earlier links stop working
+	ResendVerification(ctx context.Context, in *ResendVerificationRequest, opts ...client.CallOption) (*ResendVerificationResponse, error)
+	// List the devices the caller is logged in on
+	ListSessions(ctx context.Context, in *ListSessionsRequest, opts ...client.CallOption) (*ListSessionsResponse, error)
+	// Log one of the caller's devices out; its refresh token stops working
+	RevokeSession(ctx context.Context, in *RevokeSessionRequest, opts ...client.CallOption) (*RevokeSessionResponse, error)
 	// Validate access token (for internal service use)
 	ValidateToken(ctx context.Context, in *ValidateTokenRequest, opts ...client.CallOption) (*ValidateTokenResponse, error)
 }
@@ -148,6 +188,16 @@ func (c *identityService) RefreshToken(ctx context.Context, in *RefreshTokenRequ
 	return out, nil
 }
 
+func (c *identityService) Logout(ctx context.Context, in *LogoutRequest, opts ...client.CallOption) (*LogoutResponse, error) {
+	req := c.c.NewRequest(c.name, "IdentityService.Logout", in)
+	out := new(LogoutResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *identityService) GetProfile(ctx context.Context, in *GetProfileRequest, opts ...client.CallOption) (*GetProfileResponse, error) {
 	req := c.c.NewRequest(c.name, "IdentityService.GetProfile", in)
 	out := new(GetProfileResponse)
@@ -188,6 +238,46 @@ func (c *identityService) ResetPassword(ctx context.Context, in *ResetPasswordRe
 	return out, nil
 }
 
+func (c *identityService) VerifyEmail(ctx context.Context, in *VerifyEmailRequest, opts ...client.CallOption) (*VerifyEmailResponse, error) {
+	req := c.c.NewRequest(c.name, "IdentityService.VerifyEmail", in)
+	out := new(VerifyEmailResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *identityService) ResendVerification(ctx context.Context, in *ResendVerificationRequest, opts ...client.CallOption) (*ResendVerificationResponse, error) {
+	req := c.c.NewRequest(c.name, "IdentityService.ResendVerification", in)
+	out := new(ResendVerificationResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *identityService) ListSessions(ctx context.Context, in *ListSessionsRequest, opts ...client.CallOption) (*ListSessionsResponse, error) {
+	req := c.c.NewRequest(c.name, "IdentityService.ListSessions", in)
+	out := new(ListSessionsResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *identityService) RevokeSession(ctx context.Context, in *RevokeSessionRequest, opts ...client.CallOption) (*RevokeSessionResponse, error) {
+	req := c.c.NewRequest(c.name, "IdentityService.RevokeSession", in)
+	out := new(RevokeSessionResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *identityService) ValidateToken(ctx context.Context, in *ValidateTokenRequest, opts ...client.CallOption) (*ValidateTokenResponse, error) {
 	req := c.c.NewRequest(c.name, "IdentityService.ValidateToken", in)
 	out := new(ValidateTokenResponse)
@@ -207,6 +297,8 @@ type IdentityServiceHandler interface {
 	Login(context.Context, *LoginRequest, *LoginResponse) error
 	// Refresh access token using refresh token
 	RefreshToken(context.Context, *RefreshTokenRequest, *RefreshTokenResponse) error
+	// Log out the session the caller's token belongs to; its access and refresh tokens stop working
+	Logout(context.Context, *LogoutRequest, *LogoutResponse) error
 	// Get current user's profile
 	GetProfile(context.Context, *GetProfileRequest, *GetProfileResponse) error
 	// Update user profile
@@ -215,6 +307,14 @@ type IdentityServiceHandler interface {
 	RequestPasswordReset(context.Context, *RequestPasswordResetRequest, *RequestPasswordResetResponse) error
 	// Reset password with token
 	ResetPassword(context.Context, *ResetPasswordRequest, *ResetPasswordResponse) error
+	// Verify the email address with the token from a verification email
+	VerifyEmail(context.Context, *VerifyEmailRequest, *VerifyEmailResponse) error
+	// Send the caller a new verification email; earlier links stop working
+	ResendVerification(context.Context, *ResendVerificationRequest, *ResendVerificationResponse) error
+	// List the devices the caller is logged in on
+	ListSessions(context.Context, *ListSessionsRequest, *ListSessionsResponse) error
+	// Log one of the caller's devices out; its refresh token stops working
+	RevokeSession(context.Context, *RevokeSessionRequest, *RevokeSessionResponse) error
 	// Validate access token (for internal service use)
 	ValidateToken(context.Context, *ValidateTokenRequest, *ValidateTokenResponse) error
 }
@@ -224,10 +324,15 @@ func RegisterIdentityServiceHandler(s server.Server, hdlr IdentityServiceHandler
 		Register(ctx context.Context, in *RegisterRequest, out *RegisterResponse) error
 		Login(ctx context.Context, in *LoginRequest, out *LoginResponse) error
 		RefreshToken(ctx context.Context, in *RefreshTokenRequest, out *RefreshTokenResponse) error
+		Logout(ctx context.Context, in *LogoutRequest, out *LogoutResponse) error
 		GetProfile(ctx context.Context, in *GetProfileRequest, out *GetProfileResponse) error
 		UpdateProfile(ctx context.Context, in *UpdateProfileRequest, out *UpdateProfileResponse) error
 		RequestPasswordReset(ctx context.Context, in *RequestPasswordResetRequest, out *RequestPasswordResetResponse) error
 		ResetPassword(ctx context.Context, in *ResetPasswordRequest, out *ResetPasswordResponse) error
+		VerifyEmail(ctx context.Context, in *VerifyEmailRequest, out *VerifyEmailResponse) error
+		ResendVerification(ctx context.Context, in *ResendVerificationRequest, out *ResendVerificationResponse) error
+		ListSessions(ctx context.Context, in *ListSessionsRequest, out *ListSessionsResponse) error
+		RevokeSession(ctx context.Context, in *RevokeSessionRequest, out *RevokeSessionResponse) error
 		ValidateToken(ctx context.Context, in *ValidateTokenRequest, out *ValidateTokenResponse) error
 	}
 	type IdentityService struct {
@@ -252,6 +357,12 @@ func RegisterIdentityServiceHandler(s server.Server, hdlr IdentityServiceHandler
 		Method:  []string{"POST"},
 		Handler: "rpc",
 	}))
+	opts = append(opts, api.WithEndpoint(&api.Endpoint{
+		Name:    "IdentityService.Logout",
+		Path:    []string{"/api/v1/auth/logout"},
+		Method:  []string{"POST"},
+		Handler: "rpc",
+	}))
 	opts = append(opts, api.WithEndpoint(&api.Endpoint{
 		Name:    "IdentityService.GetProfile",
 		Path:    []string{"/api/v1/users/{user_id}"},
@@ -276,6 +387,30 @@ func RegisterIdentityServiceHandler(s server.Server, hdlr IdentityServiceHandler
 		Method:  []string{"POST"},
 		Handler: "rpc",
 	}))
+	opts = append(opts, api.WithEndpoint(&api.Endpoint{
+		Name:    "IdentityService.VerifyEmail",
+		Path:    []string{"/api/v1/auth/email/verify"},
+		Method:  []string{"POST"},
+		Handler: "rpc",
+	}))
+	opts = append(opts, api.WithEndpoint(&api.Endpoint{
+		Name:    "IdentityService.ResendVerification",
+		Path:    []string{"/api/v1/auth/email/resend-verification"},
+		Method:  []string{"POST"},
+		Handler: "rpc",
+	}))
+	opts = append(opts, api.WithEndpoint(&api.Endpoint{
+		Name:    "IdentityService.ListSessions",
+		Path:    []string{"/api/v1/auth/sessions"},
+		Method:  []string{"GET"},
+		Handler: "rpc",
+	}))
+	opts = append(opts, api.WithEndpoint(&api.Endpoint{
+		Name:    "IdentityService.RevokeSession",
+		Path:    []string{"/api/v1/auth/sessions/{session_id}"},
+		Method:  []string{"DELETE"},
+		Handler: "rpc",
+	}))
 	opts = append(opts, api.WithEndpoint(&api.Endpoint{
 		Name:    "IdentityService.ValidateToken",
 		Path:    []string{"/api/v1/auth/validate"},
@@ -301,6 +436,10 @@ func (h *identityServiceHandler) RefreshToken(ctx context.Context, in *RefreshTo
 	return h.IdentityServiceHandler.RefreshToken(ctx, in, out)
 }
 
+func (h *identityServiceHandler) Logout(ctx context.Context, in *LogoutRequest, out *LogoutResponse) error {
+	return h.IdentityServiceHandler.Logout(ctx, in, out)
+}
+
 func (h *identityServiceHandler) GetProfile(ctx context.Context, in *GetProfileRequest, out *GetProfileResponse) error {
 	return h.IdentityServiceHandler.GetProfile(ctx, in, out)
 }
@@ -317,6 +456,22 @@ func (h *identityServiceHandler) ResetPassword(ctx context.Context, in *ResetPas
 	return h.IdentityServiceHandler.ResetPassword(ctx, in, out)
 }
 
+func (h *identityServiceHandler) VerifyEmail(ctx context.Context, in *VerifyEmailRequest, out *VerifyEmailResponse) error {
+	return h.IdentityServiceHandler.VerifyEmail(ctx, in, out)
+}
+
+func (h *identityServiceHandler) ResendVerification(ctx context.Context, in *ResendVerificationRequest, out *ResendVerificationResponse) error {
+	return h.IdentityServiceHandler.ResendVerification(ctx, in, out)
+}
+
+func (h *identityServiceHandler) ListSessions(ctx context.Context, in *ListSessionsRequest, out *ListSessionsResponse) error {
+	return h.IdentityServiceHandler.ListSessions(ctx, in, out)
+}
+
+func (h *identityServiceHandler) RevokeSession(ctx context.Context, in *RevokeSessionRequest, out *RevokeSessionResponse) error {
+	return h.IdentityServiceHandler.RevokeSession(ctx, in, out)
+}
+
 func (h *identityServiceHandler) ValidateToken(ctx context.Context, in *ValidateTokenRequest, out *ValidateTokenResponse) error {
 	return h.IdentityServiceHandler.ValidateToken(ctx, in, out)
 }
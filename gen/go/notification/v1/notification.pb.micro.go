@@ -6,6 +6,8 @@ package notificationv1
 import (
 	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
 	fmt "fmt"
+	_ "github.com/wylu1037/go-micro-boilerplate/gen/go/common/v1"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
 	proto "google.golang.org/protobuf/proto"
 	_ "google.golang.org/protobuf/types/known/timestamppb"
 	math "math"
@@ -32,16 +34,82 @@ var _ server.Option
 // Api Endpoints for NotificationService service
 
 func NewNotificationServiceEndpoints() []*api.Endpoint {
-	return []*api.Endpoint{}
+	return []*api.Endpoint{
+		{
+			Name:    "NotificationService.GetPreferences",
+			Path:    []string{"/api/v1/notifications/preferences"},
+			Method:  []string{"GET"},
+			Handler: "rpc",
+		},
+		{
+			Name:    "NotificationService.UpdatePreferences",
+			Path:    []string{"/api/v1/notifications/preferences"},
+			Method:  []string{"PUT"},
+			Handler: "rpc",
+		},
+		{
+			Name:    "NotificationService.ListInbox",
+			Path:    []string{"/api/v1/notifications/inbox"},
+			Method:  []string{"GET"},
+			Handler: "rpc",
+		},
+		{
+			Name:    "NotificationService.GetUnreadCount",
+			Path:    []string{"/api/v1/notifications/inbox/unread-count"},
+			Method:  []string{"GET"},
+			Handler: "rpc",
+		},
+		{
+			Name:    "NotificationService.MarkRead",
+			Path:    []string{"/api/v1/notifications/inbox/read"},
+			Method:  []string{"POST"},
+			Handler: "rpc",
+		},
+		{
+			Name:    "NotificationService.MarkAllRead",
+			Path:    []string{"/api/v1/notifications/inbox/read-all"},
+			Method:  []string{"POST"},
+			Handler: "rpc",
+		},
+	}
 }
 
 // Client API for NotificationService service
 
 type NotificationService interface {
-	// Send an email
+	// Send an email (service accounts only)
 	SendEmail(ctx context.Context, in *SendEmailRequest, opts ...client.CallOption) (*SendEmailResponse, error)
-	// Send an SMS
+	// Send an SMS (service accounts only)
 	SendSMS(ctx context.Context, in *SendSMSRequest, opts ...client.CallOption) (*SendSMSResponse, error)
+	// Look up recorded deliveries, e.g. for support staff (admin)
+	GetNotification(ctx context.Context, in *GetNotificationRequest, opts ...client.CallOption) (*GetNotificationResponse, error)
+	ListNotifications(ctx context.Context, in *ListNotificationsRequest, opts ...client.CallOption) (*ListNotificationsResponse, error)
+	// Send a failed or dead notification again right away with a fresh set of retries (admin)
+	RetryNotification(ctx context.Context, in *RetryNotificationRequest, opts ...client.CallOption) (*RetryNotificationResponse, error)
+	// Render a stored template with the given variables and send it to a user (service accounts only)
+	SendTemplated(ctx context.Context, in *SendTemplatedRequest, opts ...client.CallOption) (*SendTemplatedResponse, error)
+	// Manage message templates (admin)
+	CreateTemplate(ctx context.Context, in *CreateTemplateRequest, opts ...client.CallOption) (*CreateTemplateResponse, error)
+	GetTemplate(ctx context.Context, in *GetTemplateRequest, opts ...client.CallOption) (*GetTemplateResponse, error)
+	ListTemplates(ctx context.Context, in *ListTemplatesRequest, opts ...client.CallOption) (*ListTemplatesResponse, error)
+	UpdateTemplate(ctx context.Context, in *UpdateTemplateRequest, opts ...client.CallOption) (*UpdateTemplateResponse, error)
+	DeleteTemplate(ctx context.Context, in *DeleteTemplateRequest, opts ...client.CallOption) (*DeleteTemplateResponse, error)
+	// Render a stored or draft template without sending it (admin)
+	PreviewTemplate(ctx context.Context, in *PreviewTemplateRequest, opts ...client.CallOption) (*PreviewTemplateResponse, error)
+	// Get the caller's notification preferences
+	GetPreferences(ctx context.Context, in *GetPreferencesRequest, opts ...client.CallOption) (*GetPreferencesResponse, error)
+	// Replace the caller's notification preferences
+	UpdatePreferences(ctx context.Context, in *UpdatePreferencesRequest, opts ...client.CallOption) (*UpdatePreferencesResponse, error)
+	// List messages stored by the capture sink instead of being delivered; only for local and test setups (admin)
+	ListCapturedMessages(ctx context.Context, in *ListCapturedMessagesRequest, opts ...client.CallOption) (*ListCapturedMessagesResponse, error)
+	// List the caller's in-app messages
+	ListInbox(ctx context.Context, in *ListInboxRequest, opts ...client.CallOption) (*ListInboxResponse, error)
+	// Count the caller's unread in-app messages
+	GetUnreadCount(ctx context.Context, in *GetUnreadCountRequest, opts ...client.CallOption) (*GetUnreadCountResponse, error)
+	// Mark some of the caller's in-app messages as read
+	MarkRead(ctx context.Context, in *MarkReadRequest, opts ...client.CallOption) (*MarkReadResponse, error)
+	// Mark all of the caller's in-app messages as read
+	MarkAllRead(ctx context.Context, in *MarkAllReadRequest, opts ...client.CallOption) (*MarkAllReadResponse, error)
 }
 
 type notificationService struct {
@@ -76,24 +144,276 @@ func (c *notificationService) SendSMS(ctx context.Context, in *SendSMSRequest, o
 	return out, nil
 }
 
+func (c *notificationService) GetNotification(ctx context.Context, in *GetNotificationRequest, opts ...client.CallOption) (*GetNotificationResponse, error) {
+	req := c.c.NewRequest(c.name, "NotificationService.GetNotification", in)
+	out := new(GetNotificationResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationService) ListNotifications(ctx context.Context, in *ListNotificationsRequest, opts ...client.CallOption) (*ListNotificationsResponse, error) {
+	req := c.c.NewRequest(c.name, "NotificationService.ListNotifications", in)
+	out := new(ListNotificationsResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationService) RetryNotification(ctx context.Context, in *RetryNotificationRequest, opts ...client.CallOption) (*RetryNotificationResponse, error) {
+	req := c.c.NewRequest(c.name, "NotificationService.RetryNotification", in)
+	out := new(RetryNotificationResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationService) SendTemplated(ctx context.Context, in *SendTemplatedRequest, opts ...client.CallOption) (*SendTemplatedResponse, error) {
+	req := c.c.NewRequest(c.name, "NotificationService.SendTemplated", in)
+	out := new(SendTemplatedResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationService) CreateTemplate(ctx context.Context, in *CreateTemplateRequest, opts ...client.CallOption) (*CreateTemplateResponse, error) {
+	req := c.c.NewRequest(c.name, "NotificationService.CreateTemplate", in)
+	out := new(CreateTemplateResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationService) GetTemplate(ctx context.Context, in *GetTemplateRequest, opts ...client.CallOption) (*GetTemplateResponse, error) {
+	req := c.c.NewRequest(c.name, "NotificationService.GetTemplate", in)
+	out := new(GetTemplateResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationService) ListTemplates(ctx context.Context, in *ListTemplatesRequest, opts ...client.CallOption) (*ListTemplatesResponse, error) {
+	req := c.c.NewRequest(c.name, "NotificationService.ListTemplates", in)
+	out := new(ListTemplatesResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationService) UpdateTemplate(ctx context.Context, in *UpdateTemplateRequest, opts ...client.CallOption) (*UpdateTemplateResponse, error) {
+	req := c.c.NewRequest(c.name, "NotificationService.UpdateTemplate", in)
+	out := new(UpdateTemplateResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationService) DeleteTemplate(ctx context.Context, in *DeleteTemplateRequest, opts ...client.CallOption) (*DeleteTemplateResponse, error) {
+	req := c.c.NewRequest(c.name, "NotificationService.DeleteTemplate", in)
+	out := new(DeleteTemplateResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationService) PreviewTemplate(ctx context.Context, in *PreviewTemplateRequest, opts ...client.CallOption) (*PreviewTemplateResponse, error) {
+	req := c.c.NewRequest(c.name, "NotificationService.PreviewTemplate", in)
+	out := new(PreviewTemplateResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationService) GetPreferences(ctx context.Context, in *GetPreferencesRequest, opts ...client.CallOption) (*GetPreferencesResponse, error) {
+	req := c.c.NewRequest(c.name, "NotificationService.GetPreferences", in)
+	out := new(GetPreferencesResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationService) UpdatePreferences(ctx context.Context, in *UpdatePreferencesRequest, opts ...client.CallOption) (*UpdatePreferencesResponse, error) {
+	req := c.c.NewRequest(c.name, "NotificationService.UpdatePreferences", in)
+	out := new(UpdatePreferencesResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationService) ListCapturedMessages(ctx context.Context, in *ListCapturedMessagesRequest, opts ...client.CallOption) (*ListCapturedMessagesResponse, error) {
+	req := c.c.NewRequest(c.name, "NotificationService.ListCapturedMessages", in)
+	out := new(ListCapturedMessagesResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationService) ListInbox(ctx context.Context, in *ListInboxRequest, opts ...client.CallOption) (*ListInboxResponse, error) {
+	req := c.c.NewRequest(c.name, "NotificationService.ListInbox", in)
+	out := new(ListInboxResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationService) GetUnreadCount(ctx context.Context, in *GetUnreadCountRequest, opts ...client.CallOption) (*GetUnreadCountResponse, error) {
+	req := c.c.NewRequest(c.name, "NotificationService.GetUnreadCount", in)
+	out := new(GetUnreadCountResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationService) MarkRead(ctx context.Context, in *MarkReadRequest, opts ...client.CallOption) (*MarkReadResponse, error) {
+	req := c.c.NewRequest(c.name, "NotificationService.MarkRead", in)
+	out := new(MarkReadResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationService) MarkAllRead(ctx context.Context, in *MarkAllReadRequest, opts ...client.CallOption) (*MarkAllReadResponse, error) {
+	req := c.c.NewRequest(c.name, "NotificationService.MarkAllRead", in)
+	out := new(MarkAllReadResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for NotificationService service
 
 type NotificationServiceHandler interface {
-	// Send an email
+	// Send an email (service accounts only)
 	SendEmail(context.Context, *SendEmailRequest, *SendEmailResponse) error
-	// Send an SMS
+	// Send an SMS (service accounts only)
 	SendSMS(context.Context, *SendSMSRequest, *SendSMSResponse) error
+	// Look up recorded deliveries, e.g. for support staff (admin)
+	GetNotification(context.Context, *GetNotificationRequest, *GetNotificationResponse) error
+	ListNotifications(context.Context, *ListNotificationsRequest, *ListNotificationsResponse) error
+	// Send a failed or dead notification again right away with a fresh set of retries (admin)
+	RetryNotification(context.Context, *RetryNotificationRequest, *RetryNotificationResponse) error
+	// Render a stored template with the given variables and send it to a user (service accounts only)
+	SendTemplated(context.Context, *SendTemplatedRequest, *SendTemplatedResponse) error
+	// Manage message templates (admin)
+	CreateTemplate(context.Context, *CreateTemplateRequest, *CreateTemplateResponse) error
+	GetTemplate(context.Context, *GetTemplateRequest, *GetTemplateResponse) error
+	ListTemplates(context.Context, *ListTemplatesRequest, *ListTemplatesResponse) error
+	UpdateTemplate(context.Context, *UpdateTemplateRequest, *UpdateTemplateResponse) error
+	DeleteTemplate(context.Context, *DeleteTemplateRequest, *DeleteTemplateResponse) error
+	// Render a stored or draft template without sending it (admin)
+	PreviewTemplate(context.Context, *PreviewTemplateRequest, *PreviewTemplateResponse) error
+	// Get the caller's notification preferences
+	GetPreferences(context.Context, *GetPreferencesRequest, *GetPreferencesResponse) error
+	// Replace the caller's notification preferences
+	UpdatePreferences(context.Context, *UpdatePreferencesRequest, *UpdatePreferencesResponse) error
+	// List messages stored by the capture sink instead of being delivered; only for local and test setups (admin)
+	ListCapturedMessages(context.Context, *ListCapturedMessagesRequest, *ListCapturedMessagesResponse) error
+	// List the caller's in-app messages
+	ListInbox(context.Context, *ListInboxRequest, *ListInboxResponse) error
+	// Count the caller's unread in-app messages
+	GetUnreadCount(context.Context, *GetUnreadCountRequest, *GetUnreadCountResponse) error
+	// Mark some of the caller's in-app messages as read
+	MarkRead(context.Context, *MarkReadRequest, *MarkReadResponse) error
+	// Mark all of the caller's in-app messages as read
+	MarkAllRead(context.Context, *MarkAllReadRequest, *MarkAllReadResponse) error
 }
 
 func RegisterNotificationServiceHandler(s server.Server, hdlr NotificationServiceHandler, opts ...server.HandlerOption) error {
 	type notificationService interface {
 		SendEmail(ctx context.Context, in *SendEmailRequest, out *SendEmailResponse) error
 		SendSMS(ctx context.Context, in *SendSMSRequest, out *SendSMSResponse) error
+		GetNotification(ctx context.Context, in *GetNotificationRequest, out *GetNotificationResponse) error
+		ListNotifications(ctx context.Context, in *ListNotificationsRequest, out *ListNotificationsResponse) error
+		RetryNotification(ctx context.Context, in *RetryNotificationRequest, out *RetryNotificationResponse) error
+		SendTemplated(ctx context.Context, in *SendTemplatedRequest, out *SendTemplatedResponse) error
+		CreateTemplate(ctx context.Context, in *CreateTemplateRequest, out *CreateTemplateResponse) error
+		GetTemplate(ctx context.Context, in *GetTemplateRequest, out *GetTemplateResponse) error
+		ListTemplates(ctx context.Context, in *ListTemplatesRequest, out *ListTemplatesResponse) error
+		UpdateTemplate(ctx context.Context, in *UpdateTemplateRequest, out *UpdateTemplateResponse) error
+		DeleteTemplate(ctx context.Context, in *DeleteTemplateRequest, out *DeleteTemplateResponse) error
+		PreviewTemplate(ctx context.Context, in *PreviewTemplateRequest, out *PreviewTemplateResponse) error
+		GetPreferences(ctx context.Context, in *GetPreferencesRequest, out *GetPreferencesResponse) error
+		UpdatePreferences(ctx context.Context, in *UpdatePreferencesRequest, out *UpdatePreferencesResponse) error
+		ListCapturedMessages(ctx context.Context, in *ListCapturedMessagesRequest, out *ListCapturedMessagesResponse) error
+		ListInbox(ctx context.Context, in *ListInboxRequest, out *ListInboxResponse) error
+		GetUnreadCount(ctx context.Context, in *GetUnreadCountRequest, out *GetUnreadCountResponse) error
+		MarkRead(ctx context.Context, in *MarkReadRequest, out *MarkReadResponse) error
+		MarkAllRead(ctx context.Context, in *MarkAllReadRequest, out *MarkAllReadResponse) error
 	}
 	type NotificationService struct {
 		notificationService
 	}
 	h := &notificationServiceHandler{hdlr}
+	opts = append(opts, api.WithEndpoint(&api.Endpoint{
+		Name:    "NotificationService.GetPreferences",
+		Path:    []string{"/api/v1/notifications/preferences"},
+		Method:  []string{"GET"},
+		Handler: "rpc",
+	}))
+	opts = append(opts, api.WithEndpoint(&api.Endpoint{
+		Name:    "NotificationService.UpdatePreferences",
+		Path:    []string{"/api/v1/notifications/preferences"},
+		Method:  []string{"PUT"},
+		Handler: "rpc",
+	}))
+	opts = append(opts, api.WithEndpoint(&api.Endpoint{
+		Name:    "NotificationService.ListInbox",
+		Path:    []string{"/api/v1/notifications/inbox"},
+		Method:  []string{"GET"},
+		Handler: "rpc",
+	}))
+	opts = append(opts, api.WithEndpoint(&api.Endpoint{
+		Name:    "NotificationService.GetUnreadCount",
+		Path:    []string{"/api/v1/notifications/inbox/unread-count"},
+		Method:  []string{"GET"},
+		Handler: "rpc",
+	}))
+	opts = append(opts, api.WithEndpoint(&api.Endpoint{
+		Name:    "NotificationService.MarkRead",
+		Path:    []string{"/api/v1/notifications/inbox/read"},
+		Method:  []string{"POST"},
+		Handler: "rpc",
+	}))
+	opts = append(opts, api.WithEndpoint(&api.Endpoint{
+		Name:    "NotificationService.MarkAllRead",
+		Path:    []string{"/api/v1/notifications/inbox/read-all"},
+		Method:  []string{"POST"},
+		Handler: "rpc",
+	}))
 	return s.Handle(s.NewHandler(&NotificationService{h}, opts...))
 }
 
@@ -108,3 +428,71 @@ func (h *notificationServiceHandler) SendEmail(ctx context.Context, in *SendEmai
 func (h *notificationServiceHandler) SendSMS(ctx context.Context, in *SendSMSRequest, out *SendSMSResponse) error {
 	return h.NotificationServiceHandler.SendSMS(ctx, in, out)
 }
+
+func (h *notificationServiceHandler) GetNotification(ctx context.Context, in *GetNotificationRequest, out *GetNotificationResponse) error {
+	return h.NotificationServiceHandler.GetNotification(ctx, in, out)
+}
+
+func (h *notificationServiceHandler) ListNotifications(ctx context.Context, in *ListNotificationsRequest, out *ListNotificationsResponse) error {
+	return h.NotificationServiceHandler.ListNotifications(ctx, in, out)
+}
+
+func (h *notificationServiceHandler) RetryNotification(ctx context.Context, in *RetryNotificationRequest, out *RetryNotificationResponse) error {
+	return h.NotificationServiceHandler.RetryNotification(ctx, in, out)
+}
+
+func (h *notificationServiceHandler) SendTemplated(ctx context.Context, in *SendTemplatedRequest, out *SendTemplatedResponse) error {
+	return h.NotificationServiceHandler.SendTemplated(ctx, in, out)
+}
+
+func (h *notificationServiceHandler) CreateTemplate(ctx context.Context, in *CreateTemplateRequest, out *CreateTemplateResponse) error {
+	return h.NotificationServiceHandler.CreateTemplate(ctx, in, out)
+}
+
+func (h *notificationServiceHandler) GetTemplate(ctx context.Context, in *GetTemplateRequest, out *GetTemplateResponse) error {
+	return h.NotificationServiceHandler.GetTemplate(ctx, in, out)
+}
+
+func (h *notificationServiceHandler) ListTemplates(ctx context.Context, in *ListTemplatesRequest, out *ListTemplatesResponse) error {
+	return h.NotificationServiceHandler.ListTemplates(ctx, in, out)
+}
+
+func (h *notificationServiceHandler) UpdateTemplate(ctx context.Context, in *UpdateTemplateRequest, out *UpdateTemplateResponse) error {
+	return h.NotificationServiceHandler.UpdateTemplate(ctx, in, out)
+}
+
+func (h *notificationServiceHandler) DeleteTemplate(ctx context.Context, in *DeleteTemplateRequest, out *DeleteTemplateResponse) error {
+	return h.NotificationServiceHandler.DeleteTemplate(ctx, in, out)
+}
+
+func (h *notificationServiceHandler) PreviewTemplate(ctx context.Context, in *PreviewTemplateRequest, out *PreviewTemplateResponse) error {
+	return h.NotificationServiceHandler.PreviewTemplate(ctx, in, out)
+}
+
+func (h *notificationServiceHandler) GetPreferences(ctx context.Context, in *GetPreferencesRequest, out *GetPreferencesResponse) error {
+	return h.NotificationServiceHandler.GetPreferences(ctx, in, out)
+}
+
+func (h *notificationServiceHandler) UpdatePreferences(ctx context.Context, in *UpdatePreferencesRequest, out *UpdatePreferencesResponse) error {
+	return h.NotificationServiceHandler.UpdatePreferences(ctx, in, out)
+}
+
+func (h *notificationServiceHandler) ListCapturedMessages(ctx context.Context, in *ListCapturedMessagesRequest, out *ListCapturedMessagesResponse) error {
+	return h.NotificationServiceHandler.ListCapturedMessages(ctx, in, out)
+}
+
+func (h *notificationServiceHandler) ListInbox(ctx context.Context, in *ListInboxRequest, out *ListInboxResponse) error {
+	return h.NotificationServiceHandler.ListInbox(ctx, in, out)
+}
+
+func (h *notificationServiceHandler) GetUnreadCount(ctx context.Context, in *GetUnreadCountRequest, out *GetUnreadCountResponse) error {
+	return h.NotificationServiceHandler.GetUnreadCount(ctx, in, out)
+}
+
+func (h *notificationServiceHandler) MarkRead(ctx context.Context, in *MarkReadRequest, out *MarkReadResponse) error {
+	return h.NotificationServiceHandler.MarkRead(ctx, in, out)
+}
+
+func (h *notificationServiceHandler) MarkAllRead(ctx context.Context, in *MarkAllReadRequest, out *MarkAllReadResponse) error {
+	return h.NotificationServiceHandler.MarkAllRead(ctx, in, out)
+}
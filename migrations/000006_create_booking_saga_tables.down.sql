@@ -0,0 +1,4 @@
+-- Rollback booking saga tables
+
+DROP TABLE IF EXISTS booking.saga_steps;
+DROP TABLE IF EXISTS booking.sagas;
@@ -0,0 +1,44 @@
+-- Booking service: saga log for multi-step order workflows
+
+-- Saga 实例表
+CREATE TABLE IF NOT EXISTS booking.sagas (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    name VARCHAR(50) NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'running',
+    payload JSONB NOT NULL,
+    created_at TIMESTAMPTZ DEFAULT NOW(),
+    updated_at TIMESTAMPTZ DEFAULT NOW()
+);
+
+COMMENT ON TABLE booking.sagas IS 'Saga 实例表';
+COMMENT ON COLUMN booking.sagas.id IS 'Saga 唯一标识';
+COMMENT ON COLUMN booking.sagas.name IS 'Saga 类型 (create_booking)';
+COMMENT ON COLUMN booking.sagas.status IS '状态 (running/completed/compensating/compensated/failed)';
+COMMENT ON COLUMN booking.sagas.payload IS '步骤间共享的业务数据 (JSON)';
+COMMENT ON COLUMN booking.sagas.created_at IS '创建时间';
+COMMENT ON COLUMN booking.sagas.updated_at IS '更新时间';
+
+CREATE INDEX idx_sagas_unfinished ON booking.sagas(updated_at) WHERE status IN ('running', 'compensating');
+
+-- Saga 步骤表
+CREATE TABLE IF NOT EXISTS booking.saga_steps (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    saga_id UUID NOT NULL REFERENCES booking.sagas(id) ON DELETE CASCADE,
+    step_index INT NOT NULL,
+    step_name VARCHAR(50) NOT NULL,
+    status VARCHAR(20) NOT NULL,
+    error_message TEXT,
+    created_at TIMESTAMPTZ DEFAULT NOW(),
+    updated_at TIMESTAMPTZ DEFAULT NOW(),
+    UNIQUE (saga_id, step_index)
+);
+
+COMMENT ON TABLE booking.saga_steps IS 'Saga 步骤表';
+COMMENT ON COLUMN booking.saga_steps.id IS '步骤记录唯一标识';
+COMMENT ON COLUMN booking.saga_steps.saga_id IS '所属 Saga';
+COMMENT ON COLUMN booking.saga_steps.step_index IS '步骤序号 (从 0 开始)';
+COMMENT ON COLUMN booking.saga_steps.step_name IS '步骤名称 (check_availability/create_order/reserve_seats/notify)';
+COMMENT ON COLUMN booking.saga_steps.status IS '状态 (started/completed/failed/compensated/compensation_failed)';
+COMMENT ON COLUMN booking.saga_steps.error_message IS '失败原因';
+COMMENT ON COLUMN booking.saga_steps.created_at IS '创建时间';
+COMMENT ON COLUMN booking.saga_steps.updated_at IS '更新时间';
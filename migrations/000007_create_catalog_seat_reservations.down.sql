@@ -0,0 +1,3 @@
+-- Rollback catalog seat reservation ledger
+
+DROP TABLE IF EXISTS catalog.seat_reservations;
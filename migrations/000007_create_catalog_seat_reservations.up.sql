@@ -0,0 +1,26 @@
+-- Catalog service: per-order seat reservation ledger
+
+-- 座位预留流水表
+CREATE TABLE IF NOT EXISTS catalog.seat_reservations (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    order_id UUID NOT NULL,
+    session_id UUID NOT NULL REFERENCES catalog.sessions(id) ON DELETE CASCADE,
+    seat_area_id UUID NOT NULL REFERENCES catalog.seat_areas(id) ON DELETE CASCADE,
+    quantity INT NOT NULL,
+    status VARCHAR(20) NOT NULL,
+    created_at TIMESTAMPTZ DEFAULT NOW(),
+    updated_at TIMESTAMPTZ DEFAULT NOW(),
+    UNIQUE (order_id, seat_area_id)
+);
+
+COMMENT ON TABLE catalog.seat_reservations IS '座位预留流水表 (保证同一订单的预留/释放幂等)';
+COMMENT ON COLUMN catalog.seat_reservations.id IS '预留记录唯一标识';
+COMMENT ON COLUMN catalog.seat_reservations.order_id IS '订单ID (booking 服务)';
+COMMENT ON COLUMN catalog.seat_reservations.session_id IS '所属场次';
+COMMENT ON COLUMN catalog.seat_reservations.seat_area_id IS '座位区域';
+COMMENT ON COLUMN catalog.seat_reservations.quantity IS '预留座位数';
+COMMENT ON COLUMN catalog.seat_reservations.status IS '状态 (reserved/released/rejected)';
+COMMENT ON COLUMN catalog.seat_reservations.created_at IS '创建时间';
+COMMENT ON COLUMN catalog.seat_reservations.updated_at IS '更新时间';
+
+CREATE INDEX idx_seat_reservations_seat_area_id ON catalog.seat_reservations(seat_area_id);
@@ -0,0 +1,4 @@
+-- Rollback catalog seat-level inventory
+
+ALTER TABLE catalog.seat_reservations DROP COLUMN IF EXISTS seat_ids;
+DROP TABLE IF EXISTS catalog.seats;
@@ -0,0 +1,38 @@
+-- Catalog service: seat-level inventory (seat maps)
+
+-- 座位表
+CREATE TABLE IF NOT EXISTS catalog.seats (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    session_id UUID NOT NULL REFERENCES catalog.sessions(id) ON DELETE CASCADE,
+    seat_area_id UUID NOT NULL REFERENCES catalog.seat_areas(id) ON DELETE CASCADE,
+    row_label VARCHAR(10) NOT NULL,
+    seat_number INT NOT NULL,
+    pos_x DOUBLE PRECISION NOT NULL DEFAULT 0,
+    pos_y DOUBLE PRECISION NOT NULL DEFAULT 0,
+    status VARCHAR(20) NOT NULL DEFAULT 'available',
+    order_id UUID,
+    created_at TIMESTAMPTZ DEFAULT NOW(),
+    updated_at TIMESTAMPTZ DEFAULT NOW(),
+    UNIQUE (seat_area_id, row_label, seat_number)
+);
+
+COMMENT ON TABLE catalog.seats IS '座位表 (座位图)';
+COMMENT ON COLUMN catalog.seats.id IS '座位唯一标识';
+COMMENT ON COLUMN catalog.seats.session_id IS '所属场次';
+COMMENT ON COLUMN catalog.seats.seat_area_id IS '所属座位区域';
+COMMENT ON COLUMN catalog.seats.row_label IS '排号 (如 F)';
+COMMENT ON COLUMN catalog.seats.seat_number IS '座位号 (如 12)';
+COMMENT ON COLUMN catalog.seats.pos_x IS '座位图横坐标';
+COMMENT ON COLUMN catalog.seats.pos_y IS '座位图纵坐标';
+COMMENT ON COLUMN catalog.seats.status IS '状态 (available/reserved/blocked)';
+COMMENT ON COLUMN catalog.seats.order_id IS '占用该座位的订单ID';
+COMMENT ON COLUMN catalog.seats.created_at IS '创建时间';
+COMMENT ON COLUMN catalog.seats.updated_at IS '更新时间';
+
+CREATE INDEX idx_seats_seat_area_id_status ON catalog.seats(seat_area_id, status);
+CREATE INDEX idx_seats_order_id ON catalog.seats(order_id) WHERE order_id IS NOT NULL;
+
+-- 预留流水记录具体座位
+ALTER TABLE catalog.seat_reservations ADD COLUMN IF NOT EXISTS seat_ids UUID[] NOT NULL DEFAULT '{}';
+
+COMMENT ON COLUMN catalog.seat_reservations.seat_ids IS '预留的具体座位 (按区域数量预留时为空)';
@@ -0,0 +1,3 @@
+-- Rollback booking order seat selection
+
+ALTER TABLE booking.orders DROP COLUMN IF EXISTS seat_ids;
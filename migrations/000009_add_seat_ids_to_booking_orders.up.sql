@@ -0,0 +1,5 @@
+-- Booking service: orders may pick specific seats
+
+ALTER TABLE booking.orders ADD COLUMN IF NOT EXISTS seat_ids UUID[] NOT NULL DEFAULT '{}';
+
+COMMENT ON COLUMN booking.orders.seat_ids IS '选定的具体座位 (按数量购买时为空)';
@@ -0,0 +1,3 @@
+-- Rollback catalog seat holds
+
+DROP TABLE IF EXISTS catalog.seat_holds;
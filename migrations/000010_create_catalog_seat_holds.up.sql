@@ -0,0 +1,23 @@
+-- Catalog service: seat holds (Postgres fallback when Redis is not configured)
+
+-- 座位临时锁定表
+CREATE TABLE IF NOT EXISTS catalog.seat_holds (
+    token UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    session_id UUID NOT NULL REFERENCES catalog.sessions(id) ON DELETE CASCADE,
+    seat_area_id UUID NOT NULL REFERENCES catalog.seat_areas(id) ON DELETE CASCADE,
+    quantity INT NOT NULL,
+    seat_ids UUID[] NOT NULL DEFAULT '{}',
+    expires_at TIMESTAMPTZ NOT NULL,
+    created_at TIMESTAMPTZ DEFAULT NOW()
+);
+
+COMMENT ON TABLE catalog.seat_holds IS '座位临时锁定表 (未配置 Redis 时使用, 过期记录视为失效)';
+COMMENT ON COLUMN catalog.seat_holds.token IS '锁定凭证 (下单预留时携带)';
+COMMENT ON COLUMN catalog.seat_holds.session_id IS '所属场次';
+COMMENT ON COLUMN catalog.seat_holds.seat_area_id IS '座位区域';
+COMMENT ON COLUMN catalog.seat_holds.quantity IS '锁定座位数';
+COMMENT ON COLUMN catalog.seat_holds.seat_ids IS '锁定的具体座位 (按数量锁定时为空)';
+COMMENT ON COLUMN catalog.seat_holds.expires_at IS '过期时间';
+COMMENT ON COLUMN catalog.seat_holds.created_at IS '创建时间';
+
+CREATE INDEX idx_seat_holds_seat_area_id_expires_at ON catalog.seat_holds(seat_area_id, expires_at);
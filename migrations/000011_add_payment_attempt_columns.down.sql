@@ -0,0 +1,4 @@
+-- Rollback payment attempt columns
+
+ALTER TABLE booking.payments DROP COLUMN IF EXISTS updated_at;
+ALTER TABLE booking.payments DROP COLUMN IF EXISTS failure_reason;
@@ -0,0 +1,7 @@
+-- Booking service: record the outcome of every payment attempt
+
+ALTER TABLE booking.payments ADD COLUMN IF NOT EXISTS failure_reason TEXT;
+ALTER TABLE booking.payments ADD COLUMN IF NOT EXISTS updated_at TIMESTAMPTZ DEFAULT NOW();
+
+COMMENT ON COLUMN booking.payments.failure_reason IS '支付失败原因 (支付渠道返回)';
+COMMENT ON COLUMN booking.payments.updated_at IS '更新时间';
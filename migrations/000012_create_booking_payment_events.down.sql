@@ -0,0 +1,5 @@
+-- Rollback booking payment notification events
+
+DROP TABLE IF EXISTS booking.payment_events;
+
+COMMENT ON COLUMN booking.orders.status IS '状态 (pending_payment/paid/cancelled/refunded/completed)';
@@ -0,0 +1,26 @@
+-- Booking service: deduplicate asynchronous payment notifications
+
+-- 支付回调事件表
+CREATE TABLE IF NOT EXISTS booking.payment_events (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    provider VARCHAR(20) NOT NULL,
+    event_id VARCHAR(100) NOT NULL,
+    order_id UUID NOT NULL REFERENCES booking.orders(id) ON DELETE CASCADE,
+    payment_id UUID REFERENCES booking.payments(id) ON DELETE SET NULL,
+    status VARCHAR(20) NOT NULL,
+    created_at TIMESTAMPTZ DEFAULT NOW(),
+    UNIQUE (provider, event_id)
+);
+
+COMMENT ON TABLE booking.payment_events IS '支付回调事件表 (按渠道事件ID去重)';
+COMMENT ON COLUMN booking.payment_events.id IS '事件记录唯一标识';
+COMMENT ON COLUMN booking.payment_events.provider IS '支付渠道';
+COMMENT ON COLUMN booking.payment_events.event_id IS '渠道事件ID';
+COMMENT ON COLUMN booking.payment_events.order_id IS '关联订单';
+COMMENT ON COLUMN booking.payment_events.payment_id IS '关联支付记录';
+COMMENT ON COLUMN booking.payment_events.status IS '通知结果 (succeeded/failed)';
+COMMENT ON COLUMN booking.payment_events.created_at IS '接收时间';
+
+CREATE INDEX idx_payment_events_order_id ON booking.payment_events(order_id);
+
+COMMENT ON COLUMN booking.orders.status IS '状态 (pending_payment/paid/failed/cancelled/refunded/completed/expired)';
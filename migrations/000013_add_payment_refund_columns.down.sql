@@ -0,0 +1,4 @@
+-- Rollback payment refund columns
+
+ALTER TABLE booking.payments DROP COLUMN IF EXISTS refunded_at;
+ALTER TABLE booking.payments DROP COLUMN IF EXISTS refunded_amount;
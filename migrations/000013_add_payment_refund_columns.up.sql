@@ -0,0 +1,7 @@
+-- Booking service: record full and partial refunds on payments
+
+ALTER TABLE booking.payments ADD COLUMN IF NOT EXISTS refunded_amount DECIMAL(10, 2) NOT NULL DEFAULT 0;
+ALTER TABLE booking.payments ADD COLUMN IF NOT EXISTS refunded_at TIMESTAMPTZ;
+
+COMMENT ON COLUMN booking.payments.refunded_amount IS '已退款金额 (部分退款时小于支付金额)';
+COMMENT ON COLUMN booking.payments.refunded_at IS '退款时间';
@@ -0,0 +1,3 @@
+-- Rollback booking order status history
+
+DROP TABLE IF EXISTS booking.order_status_history;
@@ -0,0 +1,25 @@
+-- Booking service: audit trail of order status transitions
+
+-- 订单状态变更历史表
+CREATE TABLE IF NOT EXISTS booking.order_status_history (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    order_id UUID NOT NULL REFERENCES booking.orders(id) ON DELETE CASCADE,
+    from_status VARCHAR(20),
+    to_status VARCHAR(20) NOT NULL,
+    actor_type VARCHAR(20) NOT NULL,
+    actor_id VARCHAR(100) NOT NULL,
+    reason TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ DEFAULT NOW()
+);
+
+COMMENT ON TABLE booking.order_status_history IS '订单状态变更历史表';
+COMMENT ON COLUMN booking.order_status_history.id IS '记录唯一标识';
+COMMENT ON COLUMN booking.order_status_history.order_id IS '关联订单';
+COMMENT ON COLUMN booking.order_status_history.from_status IS '变更前状态 (创建订单时为空)';
+COMMENT ON COLUMN booking.order_status_history.to_status IS '变更后状态';
+COMMENT ON COLUMN booking.order_status_history.actor_type IS '操作方类型 (user/system/provider)';
+COMMENT ON COLUMN booking.order_status_history.actor_id IS '操作方 (用户ID/后台任务/支付渠道)';
+COMMENT ON COLUMN booking.order_status_history.reason IS '变更原因';
+COMMENT ON COLUMN booking.order_status_history.created_at IS '变更时间';
+
+CREATE INDEX idx_order_status_history_order_id ON booking.order_status_history(order_id, created_at);
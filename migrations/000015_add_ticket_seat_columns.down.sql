@@ -0,0 +1,9 @@
+-- Rollback ticket seat columns
+
+DROP INDEX IF EXISTS booking.idx_tickets_seat_id;
+
+ALTER TABLE booking.tickets DROP COLUMN IF EXISTS updated_at;
+ALTER TABLE booking.tickets DROP COLUMN IF EXISTS seat_id;
+ALTER TABLE booking.tickets DROP COLUMN IF EXISTS session_id;
+
+COMMENT ON COLUMN booking.tickets.qr_code IS '二维码内容';
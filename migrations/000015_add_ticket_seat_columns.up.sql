@@ -0,0 +1,12 @@
+-- Booking service: link electronic tickets to their session and seat
+
+ALTER TABLE booking.tickets ADD COLUMN IF NOT EXISTS session_id UUID;
+ALTER TABLE booking.tickets ADD COLUMN IF NOT EXISTS seat_id UUID;
+ALTER TABLE booking.tickets ADD COLUMN IF NOT EXISTS updated_at TIMESTAMPTZ DEFAULT NOW();
+
+COMMENT ON COLUMN booking.tickets.session_id IS '场次 (检票时校验)';
+COMMENT ON COLUMN booking.tickets.seat_id IS '座位 (按数量购票且无座位图时为空)';
+COMMENT ON COLUMN booking.tickets.qr_code IS '二维码内容 (Ed25519 签名, 可离线验签)';
+COMMENT ON COLUMN booking.tickets.updated_at IS '更新时间';
+
+CREATE UNIQUE INDEX idx_tickets_seat_id ON booking.tickets(seat_id, session_id) WHERE status <> 'refunded';
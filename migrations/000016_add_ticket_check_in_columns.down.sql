@@ -0,0 +1,6 @@
+-- Rollback ticket check-in columns
+
+ALTER TABLE booking.tickets DROP COLUMN IF EXISTS checked_in_by;
+ALTER TABLE booking.tickets DROP COLUMN IF EXISTS gate_id;
+
+COMMENT ON COLUMN booking.tickets.used_at IS '使用时间';
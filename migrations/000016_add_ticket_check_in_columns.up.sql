@@ -0,0 +1,8 @@
+-- Booking service: record where and by whom a ticket was checked in
+
+ALTER TABLE booking.tickets ADD COLUMN IF NOT EXISTS gate_id VARCHAR(64);
+ALTER TABLE booking.tickets ADD COLUMN IF NOT EXISTS checked_in_by VARCHAR(100);
+
+COMMENT ON COLUMN booking.tickets.used_at IS '检票时间 (离线同步时为闸机扫码时间)';
+COMMENT ON COLUMN booking.tickets.gate_id IS '检票闸机';
+COMMENT ON COLUMN booking.tickets.checked_in_by IS '检票操作人';
@@ -0,0 +1,3 @@
+-- Rollback booking outbox
+
+DROP TABLE IF EXISTS booking.outbox;
@@ -0,0 +1,30 @@
+-- Booking service: transactional outbox for order domain events
+
+-- 领域事件发件箱表
+CREATE TABLE IF NOT EXISTS booking.outbox (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    aggregate_id UUID NOT NULL,
+    event_type VARCHAR(100) NOT NULL,
+    payload JSONB NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending',
+    attempts INT NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    last_error TEXT,
+    created_at TIMESTAMPTZ DEFAULT NOW(),
+    published_at TIMESTAMPTZ
+);
+
+COMMENT ON TABLE booking.outbox IS '领域事件发件箱表 (与订单变更同事务写入, 由中继投递到消息代理)';
+COMMENT ON COLUMN booking.outbox.id IS '事件唯一标识 (消费方据此去重)';
+COMMENT ON COLUMN booking.outbox.aggregate_id IS '聚合根ID (订单ID)';
+COMMENT ON COLUMN booking.outbox.event_type IS '事件类型 (即消息主题)';
+COMMENT ON COLUMN booking.outbox.payload IS '事件内容 (JSON)';
+COMMENT ON COLUMN booking.outbox.status IS '状态 (pending/published/dead)';
+COMMENT ON COLUMN booking.outbox.attempts IS '已投递次数';
+COMMENT ON COLUMN booking.outbox.next_attempt_at IS '下次投递时间 (指数退避)';
+COMMENT ON COLUMN booking.outbox.last_error IS '最近一次投递失败原因';
+COMMENT ON COLUMN booking.outbox.created_at IS '创建时间';
+COMMENT ON COLUMN booking.outbox.published_at IS '投递成功时间';
+
+CREATE INDEX idx_outbox_pending ON booking.outbox(next_attempt_at) WHERE status = 'pending';
+CREATE INDEX idx_outbox_aggregate_id ON booking.outbox(aggregate_id);
@@ -0,0 +1,6 @@
+-- Rollback notification event deliveries
+
+DELETE FROM notification.templates WHERE name = 'order_refunded';
+
+DROP INDEX IF EXISTS notification.idx_logs_event_template;
+ALTER TABLE notification.logs DROP COLUMN IF EXISTS event_id;
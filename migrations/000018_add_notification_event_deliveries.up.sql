@@ -0,0 +1,11 @@
+-- Notification service: deliveries triggered by booking events
+
+ALTER TABLE notification.logs ADD COLUMN IF NOT EXISTS event_id VARCHAR(64);
+
+COMMENT ON COLUMN notification.logs.event_id IS '触发发送的领域事件ID (同一事件的同一模板只发送一次)';
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_logs_event_template ON notification.logs(event_id, template_id) WHERE event_id IS NOT NULL;
+
+INSERT INTO notification.templates (name, type, subject, content) VALUES
+('order_refunded', 'email', '退款成功 - {{.OrderNo}}', '尊敬的 {{.UserName}}，您的订单 {{.OrderNo}} 已退款，款项将原路退回。')
+ON CONFLICT (name) DO NOTHING;
@@ -0,0 +1,11 @@
+-- Rollback per-locale templates (keeps only the default locale of each template)
+
+DELETE FROM notification.templates WHERE locale <> 'zh-CN';
+
+DROP INDEX IF EXISTS notification.idx_templates_name_locale;
+ALTER TABLE notification.templates ADD CONSTRAINT templates_name_key UNIQUE (name);
+
+COMMENT ON COLUMN notification.templates.name IS '模板名称';
+
+ALTER TABLE notification.templates DROP COLUMN IF EXISTS html;
+ALTER TABLE notification.templates DROP COLUMN IF EXISTS locale;
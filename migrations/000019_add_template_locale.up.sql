@@ -0,0 +1,11 @@
+-- Notification service: per-locale template variants and HTML templates
+
+ALTER TABLE notification.templates ADD COLUMN IF NOT EXISTS locale VARCHAR(10) NOT NULL DEFAULT 'zh-CN';
+ALTER TABLE notification.templates ADD COLUMN IF NOT EXISTS html BOOLEAN NOT NULL DEFAULT FALSE;
+
+COMMENT ON COLUMN notification.templates.name IS '模板名称 (同一名称可有多个语言版本)';
+COMMENT ON COLUMN notification.templates.locale IS '语言 (如 zh-CN, en-US)';
+COMMENT ON COLUMN notification.templates.html IS '是否为 HTML 内容 (渲染时转义变量)';
+
+ALTER TABLE notification.templates DROP CONSTRAINT IF EXISTS templates_name_key;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_templates_name_locale ON notification.templates(name, locale);
@@ -0,0 +1,8 @@
+-- Rollback direct send logging (drops logs of direct sends without a user)
+
+ALTER TABLE notification.logs DROP COLUMN IF EXISTS subject;
+
+DELETE FROM notification.logs WHERE user_id IS NULL;
+ALTER TABLE notification.logs ALTER COLUMN user_id SET NOT NULL;
+
+COMMENT ON COLUMN notification.logs.user_id IS '接收用户';
@@ -0,0 +1,8 @@
+-- Notification service: record direct sends in notification.logs
+
+-- 直接发送 (SendEmail/SendSMS) 不一定关联用户
+ALTER TABLE notification.logs ALTER COLUMN user_id DROP NOT NULL;
+ALTER TABLE notification.logs ADD COLUMN IF NOT EXISTS subject VARCHAR(255);
+
+COMMENT ON COLUMN notification.logs.user_id IS '接收用户 (直接发送时可为空)';
+COMMENT ON COLUMN notification.logs.subject IS '实际发送的邮件主题';
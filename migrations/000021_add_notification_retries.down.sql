@@ -0,0 +1,11 @@
+-- Rollback notification retries (dead letters become plain failures)
+
+DROP INDEX IF EXISTS notification.idx_logs_next_attempt;
+
+UPDATE notification.logs SET status = 'failed' WHERE status = 'dead';
+
+COMMENT ON COLUMN notification.logs.status IS '状态 (pending/sent/failed)';
+
+ALTER TABLE notification.logs DROP COLUMN IF EXISTS next_attempt_at;
+ALTER TABLE notification.logs DROP COLUMN IF EXISTS attempts;
+ALTER TABLE notification.logs DROP COLUMN IF EXISTS html;
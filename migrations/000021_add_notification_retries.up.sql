@@ -0,0 +1,13 @@
+-- Notification service: retry failed deliveries with backoff, dead-letter after max attempts
+
+ALTER TABLE notification.logs ADD COLUMN IF NOT EXISTS html BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE notification.logs ADD COLUMN IF NOT EXISTS attempts INT NOT NULL DEFAULT 0;
+ALTER TABLE notification.logs ADD COLUMN IF NOT EXISTS next_attempt_at TIMESTAMPTZ;
+
+COMMENT ON COLUMN notification.logs.status IS '状态 (pending/sent/failed/dead)';
+COMMENT ON COLUMN notification.logs.html IS '内容是否为 HTML (重试时按原格式发送)';
+COMMENT ON COLUMN notification.logs.attempts IS '已尝试发送次数';
+COMMENT ON COLUMN notification.logs.next_attempt_at IS '下次重试时间; 发送中的记录为租约到期时间';
+
+-- 重试 worker 扫描到期的待发送/失败记录
+CREATE INDEX IF NOT EXISTS idx_logs_next_attempt ON notification.logs(next_attempt_at) WHERE status IN ('pending', 'failed');
@@ -0,0 +1,11 @@
+-- Rollback notification preferences (suppressed notifications are dropped)
+
+DELETE FROM notification.logs WHERE status = 'suppressed';
+
+COMMENT ON COLUMN notification.logs.status IS '状态 (pending/sent/failed/dead)';
+COMMENT ON COLUMN notification.logs.next_attempt_at IS '下次重试时间; 发送中的记录为租约到期时间';
+
+ALTER TABLE notification.logs DROP COLUMN IF EXISTS category;
+ALTER TABLE notification.templates DROP COLUMN IF EXISTS category;
+
+DROP TABLE IF EXISTS notification.preferences;
@@ -0,0 +1,36 @@
+-- Notification service: per-user preferences, quiet hours and message categories
+
+-- 通知偏好表 (无记录时使用默认值: 全部开启, 无免打扰)
+CREATE TABLE IF NOT EXISTS notification.preferences (
+    user_id UUID PRIMARY KEY,
+    email_enabled BOOLEAN NOT NULL DEFAULT TRUE,
+    sms_enabled BOOLEAN NOT NULL DEFAULT TRUE,
+    marketing_enabled BOOLEAN NOT NULL DEFAULT TRUE,
+    quiet_hours_enabled BOOLEAN NOT NULL DEFAULT FALSE,
+    quiet_hours_start VARCHAR(5) NOT NULL DEFAULT '22:00',
+    quiet_hours_end VARCHAR(5) NOT NULL DEFAULT '08:00',
+    timezone VARCHAR(64) NOT NULL DEFAULT 'Asia/Shanghai',
+    created_at TIMESTAMPTZ DEFAULT NOW(),
+    updated_at TIMESTAMPTZ DEFAULT NOW()
+);
+
+COMMENT ON TABLE notification.preferences IS '用户通知偏好表';
+COMMENT ON COLUMN notification.preferences.user_id IS '用户ID';
+COMMENT ON COLUMN notification.preferences.email_enabled IS '是否接收非交易类邮件';
+COMMENT ON COLUMN notification.preferences.sms_enabled IS '是否接收非交易类短信';
+COMMENT ON COLUMN notification.preferences.marketing_enabled IS '是否接收营销消息';
+COMMENT ON COLUMN notification.preferences.quiet_hours_enabled IS '是否开启免打扰';
+COMMENT ON COLUMN notification.preferences.quiet_hours_start IS '免打扰开始时间 (HH:MM, 用户时区)';
+COMMENT ON COLUMN notification.preferences.quiet_hours_end IS '免打扰结束时间 (HH:MM, 用户时区, 早于开始时间表示跨天)';
+COMMENT ON COLUMN notification.preferences.timezone IS '用户时区 (IANA, 如 Asia/Shanghai)';
+COMMENT ON COLUMN notification.preferences.created_at IS '创建时间';
+COMMENT ON COLUMN notification.preferences.updated_at IS '更新时间';
+
+-- 消息类别: 交易类消息不受偏好和免打扰限制
+ALTER TABLE notification.templates ADD COLUMN IF NOT EXISTS category VARCHAR(20) NOT NULL DEFAULT 'transactional';
+ALTER TABLE notification.logs ADD COLUMN IF NOT EXISTS category VARCHAR(20) NOT NULL DEFAULT 'transactional';
+
+COMMENT ON COLUMN notification.templates.category IS '类别 (transactional/marketing)';
+COMMENT ON COLUMN notification.logs.category IS '类别 (transactional/marketing)';
+COMMENT ON COLUMN notification.logs.status IS '状态 (pending/sent/failed/dead/suppressed)';
+COMMENT ON COLUMN notification.logs.next_attempt_at IS '下次重试时间; 发送中的记录为租约到期时间, 免打扰延迟的记录为免打扰结束时间';
@@ -0,0 +1,8 @@
+-- Rollback in-app inbox
+
+DELETE FROM notification.templates WHERE name IN ('payment_success_inbox', 'order_cancelled_inbox', 'show_changed', 'show_changed_inbox');
+
+COMMENT ON COLUMN notification.templates.type IS '类型 (email/sms)';
+COMMENT ON COLUMN notification.templates.subject IS '邮件主题';
+
+DROP TABLE IF EXISTS notification.inbox;
@@ -0,0 +1,38 @@
+-- Notification service: in-app inbox
+
+-- 站内信表
+CREATE TABLE IF NOT EXISTS notification.inbox (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL,
+    template_id UUID REFERENCES notification.templates(id) ON DELETE SET NULL,
+    title VARCHAR(255) NOT NULL,
+    body TEXT NOT NULL,
+    event_id VARCHAR(64),
+    read_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ DEFAULT NOW()
+);
+
+COMMENT ON TABLE notification.inbox IS '站内信表';
+COMMENT ON COLUMN notification.inbox.id IS '站内信唯一标识';
+COMMENT ON COLUMN notification.inbox.user_id IS '接收用户';
+COMMENT ON COLUMN notification.inbox.template_id IS '使用的模板';
+COMMENT ON COLUMN notification.inbox.title IS '标题';
+COMMENT ON COLUMN notification.inbox.body IS '内容';
+COMMENT ON COLUMN notification.inbox.event_id IS '触发的领域事件ID (同一事件只生成一条站内信)';
+COMMENT ON COLUMN notification.inbox.read_at IS '已读时间 (为空表示未读)';
+COMMENT ON COLUMN notification.inbox.created_at IS '创建时间';
+
+CREATE INDEX idx_inbox_user_created ON notification.inbox(user_id, created_at DESC);
+CREATE INDEX idx_inbox_user_unread ON notification.inbox(user_id) WHERE read_at IS NULL;
+CREATE UNIQUE INDEX idx_inbox_user_event ON notification.inbox(user_id, event_id) WHERE event_id IS NOT NULL;
+
+COMMENT ON COLUMN notification.templates.type IS '类型 (email/sms/in_app)';
+COMMENT ON COLUMN notification.templates.subject IS '邮件主题 / 站内信标题';
+
+-- 站内信模板及演出变更邮件模板
+INSERT INTO notification.templates (name, type, subject, content) VALUES
+('payment_success_inbox', 'in_app', '支付成功', '您的订单 {{.OrderNo}} 已支付成功，共 {{.Quantity}} 张票，电子票已发送至您的邮箱。'),
+('order_cancelled_inbox', 'in_app', '订单已取消', '您的订单 {{.OrderNo}} 已取消。'),
+('show_changed', 'email', '演出信息变更 - {{.OrderNo}}', '尊敬的 {{.UserName}}，您订单 {{.OrderNo}} 所购演出的信息有变更：{{.Reason}}。请登录查看最新信息。'),
+('show_changed_inbox', 'in_app', '演出信息变更', '您订单 {{.OrderNo}} 所购演出的信息有变更：{{.Reason}}。')
+ON CONFLICT (name, locale) DO NOTHING;
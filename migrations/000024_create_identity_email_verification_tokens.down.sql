@@ -0,0 +1,5 @@
+-- Rollback email verification
+
+DELETE FROM notification.templates WHERE name = 'email_verification';
+
+DROP TABLE IF EXISTS identity.email_verification_tokens;
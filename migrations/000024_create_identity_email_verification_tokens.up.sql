@@ -0,0 +1,26 @@
+-- Identity service: email verification
+
+-- 邮箱验证令牌表
+CREATE TABLE IF NOT EXISTS identity.email_verification_tokens (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL REFERENCES identity.users(id) ON DELETE CASCADE,
+    token_hash VARCHAR(255) NOT NULL UNIQUE,
+    expires_at TIMESTAMPTZ NOT NULL,
+    used BOOLEAN DEFAULT FALSE,
+    created_at TIMESTAMPTZ DEFAULT NOW()
+);
+
+COMMENT ON TABLE identity.email_verification_tokens IS '邮箱验证令牌表';
+COMMENT ON COLUMN identity.email_verification_tokens.id IS '令牌唯一标识';
+COMMENT ON COLUMN identity.email_verification_tokens.user_id IS '关联的用户ID';
+COMMENT ON COLUMN identity.email_verification_tokens.token_hash IS '令牌哈希值';
+COMMENT ON COLUMN identity.email_verification_tokens.expires_at IS '过期时间';
+COMMENT ON COLUMN identity.email_verification_tokens.used IS '是否已使用 (重新发送时旧令牌也标记为已使用)';
+COMMENT ON COLUMN identity.email_verification_tokens.created_at IS '创建时间 (即验证邮件发送时间)';
+
+CREATE INDEX idx_email_verification_tokens_user_id ON identity.email_verification_tokens(user_id, created_at DESC);
+
+-- 邮箱验证邮件模板
+INSERT INTO notification.templates (name, type, subject, content) VALUES
+('email_verification', 'email', '请验证您的邮箱', '尊敬的 {{.UserName}}，感谢您的注册！请在 {{.ExpiresIn}} 内打开以下链接完成邮箱验证：{{.VerifyURL}}')
+ON CONFLICT (name, locale) DO NOTHING;
@@ -0,0 +1,5 @@
+-- Rollback password reset delivery
+
+DROP INDEX IF EXISTS identity.idx_password_reset_tokens_user_created;
+
+DELETE FROM notification.templates WHERE name = 'password_reset';
@@ -0,0 +1,8 @@
+-- Identity service: password reset delivery
+
+-- 密码重置邮件模板
+INSERT INTO notification.templates (name, type, subject, content) VALUES
+('password_reset', 'email', '重置您的密码', '尊敬的 {{.UserName}}，我们收到了重置您账户密码的请求。请在 {{.ExpiresIn}} 内打开以下链接设置新密码：{{.ResetURL}}。如非本人操作，请忽略此邮件。')
+ON CONFLICT (name, locale) DO NOTHING;
+
+CREATE INDEX IF NOT EXISTS idx_password_reset_tokens_user_created ON identity.password_reset_tokens(user_id, created_at DESC);
@@ -0,0 +1,14 @@
+-- Rollback refresh token families
+
+DROP INDEX IF EXISTS identity.idx_refresh_tokens_user_active;
+DROP INDEX IF EXISTS identity.idx_refresh_tokens_family_id;
+
+-- 轮换出的旧令牌在旧模型中已被删除
+DELETE FROM identity.refresh_tokens WHERE rotated_at IS NOT NULL;
+
+ALTER TABLE identity.refresh_tokens
+    DROP COLUMN IF EXISTS session_started_at,
+    DROP COLUMN IF EXISTS ip_address,
+    DROP COLUMN IF EXISTS user_agent,
+    DROP COLUMN IF EXISTS rotated_at,
+    DROP COLUMN IF EXISTS family_id;
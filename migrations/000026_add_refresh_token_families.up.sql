@@ -0,0 +1,27 @@
+-- Identity service: refresh token families
+
+-- 每次登录产生一个令牌族 (即一个会话), 轮换出的新令牌沿用同一 family_id
+-- 已轮换的旧令牌保留并记录 rotated_at, 再次出现即视为被盗用, 整个令牌族随之吊销
+ALTER TABLE identity.refresh_tokens
+    ADD COLUMN IF NOT EXISTS family_id UUID,
+    ADD COLUMN IF NOT EXISTS rotated_at TIMESTAMPTZ,
+    ADD COLUMN IF NOT EXISTS user_agent VARCHAR(512) NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS ip_address VARCHAR(64) NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS session_started_at TIMESTAMPTZ;
+
+-- 已有令牌各自成为独立的会话
+UPDATE identity.refresh_tokens SET family_id = id, session_started_at = created_at WHERE family_id IS NULL;
+
+ALTER TABLE identity.refresh_tokens
+    ALTER COLUMN family_id SET NOT NULL,
+    ALTER COLUMN session_started_at SET NOT NULL,
+    ALTER COLUMN session_started_at SET DEFAULT NOW();
+
+COMMENT ON COLUMN identity.refresh_tokens.family_id IS '令牌族ID (会话ID), 同一次登录轮换出的令牌共享';
+COMMENT ON COLUMN identity.refresh_tokens.rotated_at IS '轮换时间, 非空表示该令牌已被换新, 不可再次使用';
+COMMENT ON COLUMN identity.refresh_tokens.user_agent IS '登录或刷新时客户端的 User-Agent';
+COMMENT ON COLUMN identity.refresh_tokens.ip_address IS '登录或刷新时客户端的 IP 地址';
+COMMENT ON COLUMN identity.refresh_tokens.session_started_at IS '会话开始时间 (即令牌族首次登录时间)';
+
+CREATE INDEX idx_refresh_tokens_family_id ON identity.refresh_tokens(family_id);
+CREATE INDEX idx_refresh_tokens_user_active ON identity.refresh_tokens(user_id, created_at DESC) WHERE rotated_at IS NULL;
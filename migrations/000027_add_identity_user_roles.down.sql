@@ -0,0 +1,3 @@
+-- Rollback user roles
+
+ALTER TABLE identity.users DROP COLUMN IF EXISTS role;
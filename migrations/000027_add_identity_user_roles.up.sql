@@ -0,0 +1,8 @@
+-- Identity service: user roles
+
+-- 用户角色: customer 普通购票用户, organizer 演出主办方, admin 平台管理员
+ALTER TABLE identity.users
+    ADD COLUMN IF NOT EXISTS role VARCHAR(20) NOT NULL DEFAULT 'customer'
+        CONSTRAINT chk_users_role CHECK (role IN ('customer', 'organizer', 'admin'));
+
+COMMENT ON COLUMN identity.users.role IS '用户角色 (customer/organizer/admin), 登录时写入 Token, 变更在下次登录或刷新 Token 后生效';
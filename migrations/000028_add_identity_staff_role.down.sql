@@ -0,0 +1,10 @@
+-- Rollback gate staff role
+
+-- 检票员降级为普通用户
+UPDATE identity.users SET role = 'customer' WHERE role = 'staff';
+
+ALTER TABLE identity.users DROP CONSTRAINT IF EXISTS chk_users_role;
+ALTER TABLE identity.users
+    ADD CONSTRAINT chk_users_role CHECK (role IN ('customer', 'organizer', 'admin'));
+
+COMMENT ON COLUMN identity.users.role IS '用户角色 (customer/organizer/admin), 登录时写入 Token, 变更在下次登录或刷新 Token 后生效';
@@ -0,0 +1,7 @@
+-- Identity service: gate staff role
+
+ALTER TABLE identity.users DROP CONSTRAINT IF EXISTS chk_users_role;
+ALTER TABLE identity.users
+    ADD CONSTRAINT chk_users_role CHECK (role IN ('customer', 'staff', 'organizer', 'admin'));
+
+COMMENT ON COLUMN identity.users.role IS '用户角色 (customer/staff/organizer/admin, staff 为检票员), 登录时写入 Token, 变更在下次登录或刷新 Token 后生效';
@@ -0,0 +1,4 @@
+-- Rollback retrying failed saga compensations
+
+DROP INDEX IF EXISTS booking.idx_sagas_unfinished;
+CREATE INDEX idx_sagas_unfinished ON booking.sagas(updated_at) WHERE status IN ('running', 'compensating');
@@ -0,0 +1,5 @@
+-- Booking service: retry failed saga compensations
+
+-- 补偿失败 (failed) 的 Saga 也由恢复任务定期重试
+DROP INDEX IF EXISTS booking.idx_sagas_unfinished;
+CREATE INDEX idx_sagas_unfinished ON booking.sagas(updated_at) WHERE status IN ('running', 'compensating', 'failed');
@@ -0,0 +1,9 @@
+-- Rollback refund_pending status: pending refunds go back to paid and their provider calls are dropped
+
+DELETE FROM booking.outbox WHERE event_type = 'ticketing.booking.task.refund_payment' AND status = 'pending';
+UPDATE booking.orders SET status = 'paid', updated_at = NOW() WHERE status = 'refund_pending';
+UPDATE booking.payments SET status = 'success', refunded_amount = 0, updated_at = NOW() WHERE status = 'refund_pending';
+
+COMMENT ON COLUMN booking.orders.status IS '状态 (pending_payment/paid/failed/cancelled/refunded/completed/expired)';
+COMMENT ON COLUMN booking.payments.status IS '状态 (pending/success/failed/refunded)';
+COMMENT ON COLUMN booking.payments.refunded_amount IS '已退款金额 (部分退款时小于支付金额)';
@@ -0,0 +1,5 @@
+-- Booking service: refunds wait in refund_pending until the payment provider confirms them
+
+COMMENT ON COLUMN booking.orders.status IS '状态 (pending_payment/paid/failed/cancelled/refunded/completed/expired/refund_pending)';
+COMMENT ON COLUMN booking.payments.status IS '状态 (pending/success/failed/refund_pending/refunded)';
+COMMENT ON COLUMN booking.payments.refunded_amount IS '已退款金额 (部分退款时小于支付金额; refund_pending 时为申请退款金额)';
@@ -0,0 +1,3 @@
+-- Rollback catalog outbox
+
+DROP TABLE IF EXISTS catalog.outbox;
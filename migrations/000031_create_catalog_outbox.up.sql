@@ -0,0 +1,30 @@
+-- Catalog service: transactional outbox for show events
+
+-- 目录领域事件发件箱表
+CREATE TABLE IF NOT EXISTS catalog.outbox (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    aggregate_id UUID NOT NULL,
+    event_type VARCHAR(100) NOT NULL,
+    payload JSONB NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending',
+    attempts INT NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    last_error TEXT,
+    created_at TIMESTAMPTZ DEFAULT NOW(),
+    published_at TIMESTAMPTZ
+);
+
+COMMENT ON TABLE catalog.outbox IS '目录领域事件发件箱表 (与演出变更同事务写入, 由中继投递到消息代理)';
+COMMENT ON COLUMN catalog.outbox.id IS '事件唯一标识 (消费方据此去重)';
+COMMENT ON COLUMN catalog.outbox.aggregate_id IS '聚合根ID (演出ID)';
+COMMENT ON COLUMN catalog.outbox.event_type IS '事件类型 (即消息主题)';
+COMMENT ON COLUMN catalog.outbox.payload IS '事件内容 (JSON)';
+COMMENT ON COLUMN catalog.outbox.status IS '状态 (pending/published/dead)';
+COMMENT ON COLUMN catalog.outbox.attempts IS '已投递次数';
+COMMENT ON COLUMN catalog.outbox.next_attempt_at IS '下次投递时间 (指数退避)';
+COMMENT ON COLUMN catalog.outbox.last_error IS '最近一次投递失败原因';
+COMMENT ON COLUMN catalog.outbox.created_at IS '创建时间';
+COMMENT ON COLUMN catalog.outbox.published_at IS '投递成功时间';
+
+CREATE INDEX idx_catalog_outbox_pending ON catalog.outbox(next_attempt_at) WHERE status = 'pending';
+CREATE INDEX idx_catalog_outbox_aggregate_id ON catalog.outbox(aggregate_id);
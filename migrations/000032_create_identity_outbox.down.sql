@@ -0,0 +1,3 @@
+-- Rollback identity outbox
+
+DROP TABLE IF EXISTS identity.outbox;
@@ -0,0 +1,30 @@
+-- Identity service: transactional outbox for follow-up tasks such as revoking access tokens
+
+-- 身份任务发件箱表
+CREATE TABLE IF NOT EXISTS identity.outbox (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    aggregate_id UUID NOT NULL,
+    event_type VARCHAR(100) NOT NULL,
+    payload JSONB NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending',
+    attempts INT NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    last_error TEXT,
+    created_at TIMESTAMPTZ DEFAULT NOW(),
+    published_at TIMESTAMPTZ
+);
+
+COMMENT ON TABLE identity.outbox IS '身份任务发件箱表 (与用户变更同事务写入, 由中继在进程内执行并重试直至成功)';
+COMMENT ON COLUMN identity.outbox.id IS '任务唯一标识';
+COMMENT ON COLUMN identity.outbox.aggregate_id IS '聚合根ID (用户ID)';
+COMMENT ON COLUMN identity.outbox.event_type IS '任务类型';
+COMMENT ON COLUMN identity.outbox.payload IS '任务参数 (JSON)';
+COMMENT ON COLUMN identity.outbox.status IS '状态 (pending/published)';
+COMMENT ON COLUMN identity.outbox.attempts IS '已执行次数';
+COMMENT ON COLUMN identity.outbox.next_attempt_at IS '下次执行时间 (指数退避)';
+COMMENT ON COLUMN identity.outbox.last_error IS '最近一次执行失败原因';
+COMMENT ON COLUMN identity.outbox.created_at IS '创建时间';
+COMMENT ON COLUMN identity.outbox.published_at IS '执行成功时间';
+
+CREATE INDEX idx_identity_outbox_pending ON identity.outbox(next_attempt_at) WHERE status = 'pending';
+CREATE INDEX idx_identity_outbox_aggregate_id ON identity.outbox(aggregate_id);
@@ -0,0 +1,3 @@
+-- Rollback password reset throttle column
+
+ALTER TABLE identity.users DROP COLUMN IF EXISTS last_password_reset_at;
@@ -0,0 +1,14 @@
+-- Identity service: throttle password reset requests with one conditional update per user
+
+ALTER TABLE identity.users ADD COLUMN IF NOT EXISTS last_password_reset_at TIMESTAMPTZ;
+
+COMMENT ON COLUMN identity.users.last_password_reset_at IS '最近一次受理重置密码请求的时间 (用于限制重置邮件频率)';
+
+UPDATE identity.users u
+SET last_password_reset_at = t.last_at
+FROM (
+    SELECT user_id, MAX(created_at) AS last_at
+    FROM identity.password_reset_tokens
+    GROUP BY user_id
+) t
+WHERE u.id = t.user_id;
@@ -9,13 +9,18 @@ import (
 )
 
 type Config struct {
-	Service   ServiceConfig   `mapstructure:"service"`
-	Database  DatabaseConfig  `mapstructure:"database"`
-	Redis     RedisConfig     `mapstructure:"redis"`
-	Etcd      EtcdConfig      `mapstructure:"etcd"`
-	JWT       JWTConfig       `mapstructure:"jwt"`
-	Log       LogConfig       `mapstructure:"log"`
-	Telemetry TelemetryConfig `mapstructure:"telemetry"`
+	Service      ServiceConfig      `mapstructure:"service"`
+	Database     DatabaseConfig     `mapstructure:"database"`
+	Redis        RedisConfig        `mapstructure:"redis"`
+	Etcd         EtcdConfig         `mapstructure:"etcd"`
+	Broker       BrokerConfig       `mapstructure:"broker"`
+	JWT          JWTConfig          `mapstructure:"jwt"`
+	Log          LogConfig          `mapstructure:"log"`
+	Telemetry    TelemetryConfig    `mapstructure:"telemetry"`
+	Identity     IdentityConfig     `mapstructure:"identity"`
+	Booking      BookingConfig      `mapstructure:"booking"`
+	Catalog      CatalogConfig      `mapstructure:"catalog"`
+	Notification NotificationConfig `mapstructure:"notification"`
 }
 
 type TelemetryConfig struct {
@@ -64,7 +69,87 @@ type LogConfig struct {
 }
 
 type RedisConfig struct {
-	URL string `mapstructure:"url"`
+	URL                string `mapstructure:"url"`
+	RevocationFailOpen bool   `mapstructure:"revocation_fail_open"` // Accept tokens when revocations cannot be looked up instead of refusing them
+}
+
+// IdentityConfig holds settings used only by the identity service.
+type IdentityConfig struct {
+	VerificationURL            string        `mapstructure:"verification_url"`             // Page verification links point to; the token is added as ?token=
+	VerificationTokenTTL       time.Duration `mapstructure:"verification_token_ttl"`       // How long a verification link stays valid
+	VerificationResendInterval time.Duration `mapstructure:"verification_resend_interval"` // Minimum time between verification emails to a user
+	PasswordResetURL           string        `mapstructure:"password_reset_url"`           // Page reset links point to; the token is added as ?token=
+	PasswordResetTokenTTL      time.Duration `mapstructure:"password_reset_token_ttl"`     // How long a reset link stays valid
+	PasswordResetInterval      time.Duration `mapstructure:"password_reset_interval"`      // Minimum time between reset emails to an address
+}
+
+// BookingConfig holds settings used only by the booking service.
+type BookingConfig struct {
+	ExpiryInterval       time.Duration `mapstructure:"expiry_interval"`        // How often the expiry worker scans for overdue orders
+	ExpiryBatchSize      int           `mapstructure:"expiry_batch_size"`      // Max orders expired per scan
+	SagaStaleAfter       time.Duration `mapstructure:"saga_stale_after"`       // Unfinished sagas untouched for this long are recovered
+	RefundPolicy         []RefundRule  `mapstructure:"refund_policy"`          // Refund tiers by notice before the session starts
+	TicketKey            string        `mapstructure:"ticket_key"`             // Base64 Ed25519 seed that signs ticket QR codes
+	RequireVerifiedEmail bool          `mapstructure:"require_verified_email"` // Reject bookings from users who have not verified their email
+}
+
+// RefundRule allows refunding Percent of the paid amount when the session starts at least MinNotice from now.
+type RefundRule struct {
+	MinNotice time.Duration `mapstructure:"min_notice"`
+	Percent   int           `mapstructure:"percent"`
+}
+
+// CatalogConfig holds settings used only by the catalog service.
+type CatalogConfig struct {
+	HoldTTL time.Duration `mapstructure:"hold_ttl"` // How long a seat hold lasts before it lapses
+}
+
+// BrokerConfig selects the message broker services publish and subscribe to events with.
+type BrokerConfig struct {
+	Type    string `mapstructure:"type"`    // http (default, delivers across processes via the registry, but from memory so not durable) or memory (single process only)
+	Address string `mapstructure:"address"` // Listen address of the http broker; empty picks a random port
+}
+
+// NotificationConfig holds settings used only by the notification service.
+type NotificationConfig struct {
+	EmailProvider string        `mapstructure:"email_provider"` // smtp, capture or log (default)
+	SMSProvider   string        `mapstructure:"sms_provider"`   // http, capture or log (default)
+	SMTP          SMTPConfig    `mapstructure:"smtp"`
+	SMS           SMSConfig     `mapstructure:"sms"`
+	Capture       CaptureConfig `mapstructure:"capture"`
+	Retry         RetryConfig   `mapstructure:"retry"`
+}
+
+type SMTPConfig struct {
+	Host     string `mapstructure:"host"`
+	Port     int    `mapstructure:"port"`
+	Username string `mapstructure:"username"` // Empty skips authentication, e.g. for a local SMTP stand-in
+	Password string `mapstructure:"password"`
+	From     string `mapstructure:"from"`
+}
+
+// SMSConfig configures the HTTP SMS gateway: messages are POSTed as JSON to URL.
+type SMSConfig struct {
+	URL     string        `mapstructure:"url"`
+	APIKey  string        `mapstructure:"api_key"`
+	Sender  string        `mapstructure:"sender"` // Sender ID or number shown to the recipient
+	Timeout time.Duration `mapstructure:"timeout"`
+}
+
+// CaptureConfig configures the capture sink, which stores messages instead of delivering them.
+type CaptureConfig struct {
+	Path  string `mapstructure:"path"`  // File messages are appended to as JSON lines; empty keeps them in memory only
+	Limit int    `mapstructure:"limit"` // Max messages kept in memory for ListCapturedMessages
+}
+
+// RetryConfig controls how failed notifications are retried before they are dead-lettered.
+type RetryConfig struct {
+	MaxAttempts int           `mapstructure:"max_attempts"` // Attempts, including the first send, before a notification is dead
+	BaseDelay   time.Duration `mapstructure:"base_delay"`   // Delay before the first retry; doubles with each attempt
+	MaxDelay    time.Duration `mapstructure:"max_delay"`    // Upper bound of the delay between attempts
+	Interval    time.Duration `mapstructure:"interval"`     // How often the retry worker scans for due notifications
+	BatchSize   int           `mapstructure:"batch_size"`   // Max notifications claimed per scan
+	Concurrency int           `mapstructure:"concurrency"`  // Notifications sent in parallel by the retry worker
 }
 
 type EtcdConfig struct {
@@ -0,0 +1,26 @@
+package infra
+
+import (
+	"fmt"
+
+	"go-micro.dev/v4/broker"
+
+	"github.com/wylu1037/go-micro-boilerplate/pkg/config"
+)
+
+// NewBroker creates the message broker selected by broker.type. The memory broker only reaches
+// subscribers in the same process, so it suits local runs and tests where everything is wired together.
+func NewBroker(cfg *config.Config) (broker.Broker, error) {
+	switch cfg.Broker.Type {
+	case "", "http":
+		var opts []broker.Option
+		if cfg.Broker.Address != "" {
+			opts = append(opts, broker.Addrs(cfg.Broker.Address))
+		}
+		return broker.NewBroker(opts...), nil
+	case "memory":
+		return broker.NewMemoryBroker(), nil
+	default:
+		return nil, fmt.Errorf("unsupported broker type %q", cfg.Broker.Type)
+	}
+}
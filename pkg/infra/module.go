@@ -8,7 +8,7 @@ import (
 	"go.uber.org/fx"
 )
 
-// Module provides common infrastructure dependencies (logger, database, cache, auth)
+// Module provides common infrastructure dependencies (logger, database, cache, auth, token revocations, broker)
 // Note: Config must be provided separately by each service using NewConfig(serviceName, schema)
 var Module = fx.Options(
 	fx.Provide(
@@ -17,7 +17,9 @@ var Module = fx.Options(
 		NewMicroAuth,
 		NewRedis,
 		NewEtcd,
+		NewBroker,
 		NewDistributedLocker,
+		NewRevocationStore,
 		telemetry.NewLoggerProvider,
 		telemetry.NewTracerProvider,
 		telemetry.NewMeterProvider,
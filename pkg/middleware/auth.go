@@ -2,6 +2,7 @@ package middleware
 
 import (
 	"context"
+	"fmt"
 	"slices"
 	"strings"
 
@@ -11,7 +12,25 @@ import (
 	"go-micro.dev/v4/server"
 )
 
-func AuthWrapper(a auth.Auth, publicEndpoints []string) server.HandlerWrapper {
+type authOptions struct {
+	revocations RevocationStore
+}
+
+type AuthOption func(*authOptions)
+
+// WithRevocationStore makes AuthWrapper reject tokens the store has revoked, even before they expire.
+func WithRevocationStore(store RevocationStore) AuthOption {
+	return func(o *authOptions) {
+		o.revocations = store
+	}
+}
+
+func AuthWrapper(a auth.Auth, publicEndpoints []string, opts ...AuthOption) server.HandlerWrapper {
+	options := &authOptions{}
+	for _, o := range opts {
+		o(options)
+	}
+
 	return func(fn server.HandlerFunc) server.HandlerFunc {
 		return func(ctx context.Context, req server.Request, rsp interface{}) error {
 			// 1. Check if the endpoint is in the whitelist
@@ -41,6 +60,17 @@ func AuthWrapper(a auth.Auth, publicEndpoints []string) server.HandlerWrapper {
 				return errors.Unauthorized(req.Service(), "invalid token: %v", err)
 			}
 
+			// Logout, session revocation and password reset end tokens before they expire
+			if options.revocations != nil {
+				revoked, revokedErr := options.revocations.IsRevoked(ctx, account)
+				if revokedErr != nil {
+					return errors.InternalServerError(req.Service(), "failed to check token revocation")
+				}
+				if revoked {
+					return errors.Unauthorized(req.Service(), "token has been revoked")
+				}
+			}
+
 			// 4. Inject Account information into Context (optional)
 			// Note: go-micro's auth plugin usually automatically handles context injection,
 			// but we may need to handle it ourselves when manually inspecting
@@ -48,8 +78,20 @@ func AuthWrapper(a auth.Auth, publicEndpoints []string) server.HandlerWrapper {
 
 			// Can be extended here, for example, put UserID into connection context
 			ctx = context.WithValue(ctx, "userId", account.ID)
+			ctx = auth.ContextWithAccount(ctx, account)
 
 			return fn(ctx, req, rsp)
 		}
 	}
 }
+
+// ServiceContext returns a context carrying a freshly minted token for the given service,
+// so that background jobs (which have no incoming user request) can call other services
+// protected by AuthWrapper. The auth instance must be configured with a private key.
+func ServiceContext(ctx context.Context, a auth.Auth, serviceName string) (context.Context, error) {
+	account, err := a.Generate(serviceName, auth.WithType("service"))
+	if err != nil {
+		return ctx, fmt.Errorf("failed to generate service account: %w", err)
+	}
+	return metadata.Set(ctx, "Authorization", "Bearer "+account.Secret), nil
+}
@@ -0,0 +1,64 @@
+package middleware
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"go-micro.dev/v4/auth"
+	"go-micro.dev/v4/errors"
+	"go-micro.dev/v4/server"
+)
+
+// RoleKey is the account metadata key carrying the user's role.
+const RoleKey = "role"
+
+// User roles, stored in identity.users and embedded in tokens at login.
+const (
+	RoleCustomer  = "customer"
+	RoleStaff     = "staff" // Venue gate staff scanning tickets
+	RoleOrganizer = "organizer"
+	RoleAdmin     = "admin"
+)
+
+// Permissions maps an endpoint ("Service.Method") to the roles allowed to call it. Endpoints missing
+// from the map are open to every authenticated caller; endpoints mapped to no roles are reserved for
+// service accounts.
+type Permissions map[string][]string
+
+// PermissionWrapper enforces permissions on the account AuthWrapper put in the context, so it must be
+// wrapped after AuthWrapper. Service accounts (internal calls) are not subject to roles.
+func PermissionWrapper(permissions Permissions) server.HandlerWrapper {
+	return func(fn server.HandlerFunc) server.HandlerFunc {
+		return func(ctx context.Context, req server.Request, rsp any) error {
+			allowed, restricted := permissions[req.Method()]
+			if !restricted {
+				return fn(ctx, req, rsp)
+			}
+
+			account, ok := auth.AccountFromContext(ctx)
+			if !ok {
+				return errors.Unauthorized(req.Service(), "no auth token provided")
+			}
+			if account.Type == "service" {
+				return fn(ctx, req, rsp)
+			}
+
+			role := AccountRole(account)
+			if !slices.Contains(allowed, role) {
+				return errors.Forbidden(req.Service(), "role %q is not allowed to call %s, requires one of: %s",
+					role, req.Method(), strings.Join(allowed, ", "))
+			}
+
+			return fn(ctx, req, rsp)
+		}
+	}
+}
+
+// AccountRole returns the role embedded in the account; tokens issued before roles existed are customers.
+func AccountRole(account *auth.Account) string {
+	if role := account.Metadata[RoleKey]; role != "" {
+		return role
+	}
+	return RoleCustomer
+}
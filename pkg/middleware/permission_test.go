@@ -0,0 +1,71 @@
+package middleware
+
+import (
+	"context"
+	"testing"
+
+	"go-micro.dev/v4/auth"
+	"go-micro.dev/v4/errors"
+	"go-micro.dev/v4/server"
+)
+
+// fakeRequest is a server.Request that only knows its endpoint; other methods are not called by the wrappers.
+type fakeRequest struct {
+	server.Request
+	service, method string
+}
+
+func (r *fakeRequest) Service() string { return r.service }
+func (r *fakeRequest) Method() string  { return r.method }
+
+func TestPermissionWrapper(t *testing.T) {
+	permissions := Permissions{
+		"CatalogService.CreateShow":   {RoleOrganizer, RoleAdmin},
+		"CatalogService.ReserveSeats": {},
+	}
+
+	tests := []struct {
+		name     string
+		method   string
+		account  *auth.Account
+		wantCode int32 // 0 when the handler is called
+	}{
+		{name: "unlisted endpoint is open", method: "CatalogService.GetShow", account: nil},
+		{name: "allowed role", method: "CatalogService.CreateShow", account: &auth.Account{ID: "u1", Type: "user", Metadata: map[string]string{RoleKey: RoleOrganizer}}},
+		{name: "other role", method: "CatalogService.CreateShow", account: &auth.Account{ID: "u1", Type: "user", Metadata: map[string]string{RoleKey: RoleStaff}}, wantCode: 403},
+		{name: "token without role is a customer", method: "CatalogService.CreateShow", account: &auth.Account{ID: "u1", Type: "user"}, wantCode: 403},
+		{name: "no account", method: "CatalogService.CreateShow", account: nil, wantCode: 401},
+		{name: "service account bypasses roles", method: "CatalogService.CreateShow", account: &auth.Account{ID: "ticketing.booking", Type: "service"}},
+		{name: "service-only endpoint refuses admins", method: "CatalogService.ReserveSeats", account: &auth.Account{ID: "u1", Type: "user", Metadata: map[string]string{RoleKey: RoleAdmin}}, wantCode: 403},
+		{name: "service-only endpoint accepts services", method: "CatalogService.ReserveSeats", account: &auth.Account{ID: "ticketing.booking", Type: "service"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			called := false
+			handler := PermissionWrapper(permissions)(func(_ context.Context, _ server.Request, _ any) error {
+				called = true
+				return nil
+			})
+
+			ctx := context.Background()
+			if tt.account != nil {
+				ctx = auth.ContextWithAccount(ctx, tt.account)
+			}
+			err := handler(ctx, &fakeRequest{service: "ticketing.catalog", method: tt.method}, nil)
+
+			if tt.wantCode == 0 {
+				if err != nil || !called {
+					t.Fatalf("want handler called, got called=%v err=%v", called, err)
+				}
+				return
+			}
+			if called {
+				t.Fatal("handler must not be called")
+			}
+			if code := errors.FromError(err).Code; code != tt.wantCode {
+				t.Fatalf("want code %d, got %d (%v)", tt.wantCode, code, err)
+			}
+		})
+	}
+}
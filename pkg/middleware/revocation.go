@@ -0,0 +1,245 @@
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"go-micro.dev/v4/auth"
+	"go.uber.org/zap"
+)
+
+// Account metadata keys the identity service sets on user tokens and RevocationStore checks.
+const (
+	SessionIDKey = "session_id" // Shared by every token issued for one login
+	IssuedAtKey  = "issued_at"  // Unix milliseconds the token pair was issued at
+)
+
+const (
+	revokedSessionKeyPrefix = "auth:revoked:session:"
+	userNotBeforeKeyPrefix  = "auth:revoked:user:"
+
+	// revocationCacheTTL bounds how long a service keeps answering from its local copy after another
+	// instance revoked a token.
+	revocationCacheTTL = 5 * time.Second
+	// revocationCacheSweepSize is the cache size at which expired lookups are pruned. If the cache is still
+	// that large afterwards, it is emptied.
+	revocationCacheSweepSize = 10000
+)
+
+// RevocationStore records access tokens that must be rejected before they expire. Entries only need to
+// outlive the access token TTL; afterwards the tokens they match are expired anyway.
+type RevocationStore interface {
+	// RevokeSession rejects every token issued for the session.
+	RevokeSession(ctx context.Context, sessionID string, ttl time.Duration) error
+	// RevokeUser rejects the user's tokens issued before notBefore.
+	RevokeUser(ctx context.Context, userID string, notBefore time.Time, ttl time.Duration) error
+	// IsRevoked reports whether the account's token was revoked by either rule.
+	IsRevoked(ctx context.Context, account *auth.Account) (bool, error)
+}
+
+// NewRevocationStore shares revocations between instances through Redis. If client is nil, it returns a
+// process-local store (useful for simple local dev without Redis). When Redis cannot be reached, IsRevoked
+// fails, so tokens are refused, unless failOpen is set: then tokens are accepted unless this instance
+// revoked them itself, trading revocations made elsewhere for availability.
+func NewRevocationStore(client *redis.Client, failOpen bool, logger *zap.Logger) RevocationStore {
+	local := newMemoryRevocationStore()
+	if client == nil {
+		return local
+	}
+	return &redisRevocationStore{
+		client:   client,
+		local:    local,
+		failOpen: failOpen,
+		cache:    make(map[string]revocationLookup),
+		logger:   logger,
+	}
+}
+
+// revoked applies both rules to the account; notBefore is zero if the user has none. Issue times are
+// compared in milliseconds, so a token issued earlier in the same second as notBefore is still rejected.
+func revoked(account *auth.Account, sessionRevoked bool, notBefore time.Time) bool {
+	if sessionRevoked {
+		return true
+	}
+	if notBefore.IsZero() {
+		return false
+	}
+	issuedAt, err := strconv.ParseInt(account.Metadata[IssuedAtKey], 10, 64)
+	if err != nil {
+		// Tokens without an issue time predate the rule and cannot prove they are newer
+		return true
+	}
+	return issuedAt < notBefore.UnixMilli()
+}
+
+type memoryRevocationStore struct {
+	mu        sync.Mutex
+	sessions  map[string]time.Time // session ID -> entry expiry
+	notBefore map[string]userNotBefore
+}
+
+type userNotBefore struct {
+	at        time.Time
+	expiresAt time.Time
+}
+
+func newMemoryRevocationStore() *memoryRevocationStore {
+	return &memoryRevocationStore{
+		sessions:  make(map[string]time.Time),
+		notBefore: make(map[string]userNotBefore),
+	}
+}
+
+func (s *memoryRevocationStore) RevokeSession(_ context.Context, sessionID string, ttl time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.sweep()
+	s.sessions[sessionID] = time.Now().Add(ttl)
+	return nil
+}
+
+func (s *memoryRevocationStore) RevokeUser(_ context.Context, userID string, notBefore time.Time, ttl time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.sweep()
+	s.notBefore[userID] = userNotBefore{at: notBefore, expiresAt: time.Now().Add(ttl)}
+	return nil
+}
+
+func (s *memoryRevocationStore) IsRevoked(_ context.Context, account *auth.Account) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	sessionID := account.Metadata[SessionIDKey]
+	expiresAt, found := s.sessions[sessionID]
+	sessionRevoked := sessionID != "" && found && now.Before(expiresAt)
+
+	var notBefore time.Time
+	if entry, ok := s.notBefore[account.ID]; ok && now.Before(entry.expiresAt) {
+		notBefore = entry.at
+	}
+
+	return revoked(account, sessionRevoked, notBefore), nil
+}
+
+// sweep drops expired entries; callers hold mu.
+func (s *memoryRevocationStore) sweep() {
+	now := time.Now()
+	for id, expiresAt := range s.sessions {
+		if now.After(expiresAt) {
+			delete(s.sessions, id)
+		}
+	}
+	for id, entry := range s.notBefore {
+		if now.After(entry.expiresAt) {
+			delete(s.notBefore, id)
+		}
+	}
+}
+
+type redisRevocationStore struct {
+	client *redis.Client
+	// local mirrors the revocations this instance wrote, so they hold even when Redis cannot be reached
+	local    *memoryRevocationStore
+	failOpen bool
+	logger   *zap.Logger
+
+	mu    sync.Mutex
+	cache map[string]revocationLookup
+}
+
+type revocationLookup struct {
+	revoked   bool
+	expiresAt time.Time
+}
+
+func (s *redisRevocationStore) RevokeSession(ctx context.Context, sessionID string, ttl time.Duration) error {
+	_ = s.local.RevokeSession(ctx, sessionID, ttl)
+	s.invalidate()
+	return s.client.Set(ctx, revokedSessionKeyPrefix+sessionID, "1", ttl).Err()
+}
+
+func (s *redisRevocationStore) RevokeUser(ctx context.Context, userID string, notBefore time.Time, ttl time.Duration) error {
+	_ = s.local.RevokeUser(ctx, userID, notBefore, ttl)
+	s.invalidate()
+	return s.client.Set(ctx, userNotBeforeKeyPrefix+userID, notBefore.UnixMilli(), ttl).Err()
+}
+
+func (s *redisRevocationStore) IsRevoked(ctx context.Context, account *auth.Account) (bool, error) {
+	sessionID := account.Metadata[SessionIDKey]
+	cacheKey := account.ID + "|" + sessionID + "|" + account.Metadata[IssuedAtKey]
+
+	if result, ok := s.cached(cacheKey); ok {
+		return result, nil
+	}
+
+	values, err := s.client.MGet(ctx, revokedSessionKeyPrefix+sessionID, userNotBeforeKeyPrefix+account.ID).Result()
+	if err != nil {
+		// The local store only knows this instance's revocations: it can confirm a revocation, not rule one out
+		if localRevoked, _ := s.local.IsRevoked(ctx, account); localRevoked {
+			return true, nil
+		}
+		if s.failOpen {
+			s.logger.Warn("revocation lookup failed, accepting token", zap.String("user_id", account.ID), zap.Error(err))
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to look up token revocation: %w", err)
+	}
+
+	sessionRevoked := sessionID != "" && values[0] != nil
+	var notBefore time.Time
+	if raw, ok := values[1].(string); ok {
+		if millis, parseErr := strconv.ParseInt(raw, 10, 64); parseErr == nil {
+			notBefore = time.UnixMilli(millis)
+		}
+	}
+
+	result := revoked(account, sessionRevoked, notBefore)
+	s.store(cacheKey, result)
+	return result, nil
+}
+
+func (s *redisRevocationStore) cached(key string) (bool, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	lookup, ok := s.cache[key]
+	if !ok || time.Now().After(lookup.expiresAt) {
+		return false, false
+	}
+	return lookup.revoked, true
+}
+
+func (s *redisRevocationStore) store(key string, result bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	if len(s.cache) >= revocationCacheSweepSize {
+		for k, lookup := range s.cache {
+			if now.After(lookup.expiresAt) {
+				delete(s.cache, k)
+			}
+		}
+		// Lookups live a few seconds, so a cache still full of live ones only costs a few Redis round trips
+		if len(s.cache) >= revocationCacheSweepSize {
+			clear(s.cache)
+		}
+	}
+	s.cache[key] = revocationLookup{revoked: result, expiresAt: now.Add(revocationCacheTTL)}
+}
+
+// invalidate drops cached lookups so this instance sees its own revocations immediately.
+func (s *redisRevocationStore) invalidate() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	clear(s.cache)
+}
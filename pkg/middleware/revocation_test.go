@@ -0,0 +1,166 @@
+package middleware
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"go-micro.dev/v4/auth"
+	"go-micro.dev/v4/errors"
+	"go-micro.dev/v4/metadata"
+	"go-micro.dev/v4/server"
+	"go.uber.org/zap"
+)
+
+func userAccount(userID, sessionID string, issuedAt time.Time) *auth.Account {
+	return &auth.Account{
+		ID:   userID,
+		Type: "user",
+		Metadata: map[string]string{
+			SessionIDKey: sessionID,
+			IssuedAtKey:  strconv.FormatInt(issuedAt.UnixMilli(), 10),
+		},
+	}
+}
+
+// unreachableRedis returns a client whose every command fails right away.
+func unreachableRedis(t *testing.T) *redis.Client {
+	t.Helper()
+	client := redis.NewClient(&redis.Options{Addr: "127.0.0.1:1", MaxRetries: -1, DialTimeout: 100 * time.Millisecond})
+	t.Cleanup(func() { _ = client.Close() })
+	return client
+}
+
+func TestMemoryRevocationStore(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+
+	t.Run("session revocation rejects only that session", func(t *testing.T) {
+		store := NewRevocationStore(nil, false, zap.NewNop())
+		if err := store.RevokeSession(ctx, "s1", time.Minute); err != nil {
+			t.Fatal(err)
+		}
+
+		assertRevoked(t, store, userAccount("u1", "s1", now), true)
+		assertRevoked(t, store, userAccount("u1", "s2", now), false)
+	})
+
+	t.Run("user revocation rejects tokens issued before not-before", func(t *testing.T) {
+		store := NewRevocationStore(nil, false, zap.NewNop())
+		notBefore := time.UnixMilli(now.UnixMilli())
+		if err := store.RevokeUser(ctx, "u1", notBefore, time.Minute); err != nil {
+			t.Fatal(err)
+		}
+
+		assertRevoked(t, store, userAccount("u1", "s1", notBefore.Add(-time.Millisecond)), true)
+		assertRevoked(t, store, userAccount("u1", "s1", notBefore), false)
+		assertRevoked(t, store, userAccount("u1", "s1", notBefore.Add(time.Second)), false)
+		assertRevoked(t, store, userAccount("u2", "s1", notBefore.Add(-time.Hour)), false)
+
+		legacy := &auth.Account{ID: "u1", Type: "user", Metadata: map[string]string{SessionIDKey: "s1"}}
+		assertRevoked(t, store, legacy, true)
+	})
+
+	t.Run("entries lapse after their ttl", func(t *testing.T) {
+		store := NewRevocationStore(nil, false, zap.NewNop())
+		if err := store.RevokeSession(ctx, "s1", time.Millisecond); err != nil {
+			t.Fatal(err)
+		}
+		time.Sleep(5 * time.Millisecond)
+
+		assertRevoked(t, store, userAccount("u1", "s1", now), false)
+	})
+}
+
+func TestRedisRevocationStoreUnreachable(t *testing.T) {
+	ctx := context.Background()
+	account := userAccount("u1", "s1", time.Now())
+
+	t.Run("fails closed by default", func(t *testing.T) {
+		store := NewRevocationStore(unreachableRedis(t), false, zap.NewNop())
+		if _, err := store.IsRevoked(ctx, account); err == nil {
+			t.Fatal("want an error when revocations cannot be looked up")
+		}
+	})
+
+	t.Run("fails open when configured", func(t *testing.T) {
+		store := NewRevocationStore(unreachableRedis(t), true, zap.NewNop())
+		assertRevoked(t, store, account, false)
+	})
+
+	t.Run("local revocations hold either way", func(t *testing.T) {
+		for _, failOpen := range []bool{false, true} {
+			store := NewRevocationStore(unreachableRedis(t), failOpen, zap.NewNop())
+			// Writing to Redis fails, but the revocation is kept locally
+			_ = store.RevokeSession(ctx, "s1", time.Minute)
+			assertRevoked(t, store, account, true)
+		}
+	})
+}
+
+func TestRedisRevocationStoreCacheIsBounded(t *testing.T) {
+	store := &redisRevocationStore{cache: make(map[string]revocationLookup)}
+	for i := range revocationCacheSweepSize + 10 {
+		store.store(strconv.Itoa(i), false)
+	}
+
+	if size := len(store.cache); size >= revocationCacheSweepSize {
+		t.Fatalf("cache holds %d lookups, want fewer than %d", size, revocationCacheSweepSize)
+	}
+}
+
+// fakeAuth accepts any token as the account it holds.
+type fakeAuth struct {
+	auth.Auth
+	account *auth.Account
+}
+
+func (a *fakeAuth) Inspect(string) (*auth.Account, error) { return a.account, nil }
+
+func TestAuthWrapperRevocations(t *testing.T) {
+	account := userAccount("u1", "s1", time.Now())
+	ctx := metadata.Set(context.Background(), "Authorization", "Bearer token")
+	req := &fakeRequest{service: "ticketing.booking", method: "BookingService.GetBooking"}
+	handler := func(context.Context, server.Request, any) error { return nil }
+
+	t.Run("revoked token", func(t *testing.T) {
+		store := NewRevocationStore(nil, false, zap.NewNop())
+		_ = store.RevokeSession(ctx, "s1", time.Minute)
+
+		err := AuthWrapper(&fakeAuth{account: account}, nil, WithRevocationStore(store))(handler)(ctx, req, nil)
+		if code := errors.FromError(err).Code; code != 401 {
+			t.Fatalf("want 401, got %v", err)
+		}
+	})
+
+	t.Run("lookup failure", func(t *testing.T) {
+		store := NewRevocationStore(unreachableRedis(t), false, zap.NewNop())
+
+		err := AuthWrapper(&fakeAuth{account: account}, nil, WithRevocationStore(store))(handler)(ctx, req, nil)
+		if code := errors.FromError(err).Code; code != 500 {
+			t.Fatalf("want 500, got %v", err)
+		}
+	})
+
+	t.Run("valid token", func(t *testing.T) {
+		store := NewRevocationStore(nil, false, zap.NewNop())
+
+		if err := AuthWrapper(&fakeAuth{account: account}, nil, WithRevocationStore(store))(handler)(ctx, req, nil); err != nil {
+			t.Fatalf("want the call to pass, got %v", err)
+		}
+	})
+}
+
+func assertRevoked(t *testing.T, store RevocationStore, account *auth.Account, want bool) {
+	t.Helper()
+	got, err := store.IsRevoked(context.Background(), account)
+	if err != nil {
+		t.Fatalf("IsRevoked: %v", err)
+	}
+	if got != want {
+		t.Fatalf("IsRevoked(%s, session %s, issued %s) = %v, want %v",
+			account.ID, account.Metadata[SessionIDKey], account.Metadata[IssuedAtKey], got, want)
+	}
+}
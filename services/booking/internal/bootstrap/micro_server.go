@@ -6,6 +6,7 @@ import (
 	"github.com/go-micro/plugins/v4/wrapper/trace/opentelemetry"
 	"go-micro.dev/v4"
 	"go-micro.dev/v4/auth"
+	"go-micro.dev/v4/broker"
 	"go.uber.org/fx"
 	"go.uber.org/zap"
 
@@ -14,21 +15,34 @@ import (
 	"github.com/wylu1037/go-micro-boilerplate/services/booking/internal/router"
 )
 
+// permissions keeps ticket check-in to gate staff; a customer checking in someone else's ticket would
+// lock the holder out at the gate. Payment notifications come only from the gateway's webhook with its
+// service token, otherwise a customer could mark their own order paid.
+var permissions = middleware.Permissions{
+	"BookingService.CheckInTicket":             {middleware.RoleStaff, middleware.RoleOrganizer, middleware.RoleAdmin},
+	"BookingService.SyncCheckIns":              {middleware.RoleStaff, middleware.RoleOrganizer, middleware.RoleAdmin},
+	"BookingService.HandlePaymentNotification": {},
+}
+
 func NewMicroService(
 	cfg *config.Config,
 	logger *zap.Logger,
 	microAuth auth.Auth,
+	revocations middleware.RevocationStore,
+	eventBroker broker.Broker,
 ) micro.Service {
 	service := micro.NewService(
 		micro.Name(cfg.Service.Name),
 		micro.Version(cfg.Service.Version),
 		micro.Address(cfg.Service.Address),
 		micro.Auth(microAuth),
+		micro.Broker(eventBroker),
 		micro.WrapHandler(
 			opentelemetry.NewHandlerWrapper(), // Add Tracing
 			middleware.NewMetricsMiddleware(), // Add Metrics
 			middleware.NewRecoveryMiddleware(logger),
-			middleware.AuthWrapper(microAuth, []string{}), // All booking routes currently protected or as per logic
+			middleware.AuthWrapper(microAuth, []string{}, middleware.WithRevocationStore(revocations)), // All booking routes currently protected or as per logic
+			middleware.PermissionWrapper(permissions),
 			middleware.NewLoggingMiddleware(logger),
 			middleware.NewValidatorMiddleware(logger),
 		),
@@ -2,8 +2,10 @@ package handler
 
 import (
 	"context"
+	stderrors "errors"
 
 	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
 	bookingv1 "github.com/wylu1037/go-micro-boilerplate/gen/go/booking/v1"
 	commonv1 "github.com/wylu1037/go-micro-boilerplate/gen/go/common/v1"
 	"github.com/wylu1037/go-micro-boilerplate/services/booking/internal/model"
@@ -14,12 +16,14 @@ import (
 
 func NewBookingGrpcHandler(
 	svc service.BookingService,
+	tickets service.TicketService,
 ) bookingv1.BookingServiceHandler {
-	return &microBookingGrpcHandler{svc: svc}
+	return &microBookingGrpcHandler{svc: svc, tickets: tickets}
 }
 
 type microBookingGrpcHandler struct {
-	svc service.BookingService
+	svc     service.BookingService
+	tickets service.TicketService
 }
 
 func (h *microBookingGrpcHandler) CreateBooking(ctx context.Context, req *bookingv1.CreateBookingRequest, resp *bookingv1.CreateBookingResponse) error {
@@ -28,7 +32,10 @@ func (h *microBookingGrpcHandler) CreateBooking(ctx context.Context, req *bookin
 		return errors.Unauthorized("ticketing.booking", "user unauthorized")
 	}
 
-	booking, err := h.svc.CreateBooking(ctx, userID, req.SessionId, req.SeatAreaId, req.Quantity)
+	booking, err := h.svc.CreateBooking(ctx, userID, req.SessionId, req.SeatAreaId, req.Quantity, req.SeatIds, req.HoldToken)
+	if stderrors.Is(err, service.ErrEmailNotVerified) {
+		return errors.Forbidden("ticketing.booking", "%s", err.Error())
+	}
 	if err != nil {
 		return err
 	}
@@ -106,37 +113,296 @@ func (h *microBookingGrpcHandler) ProcessPayment(ctx context.Context, req *booki
 	return nil
 }
 
+func (h *microBookingGrpcHandler) CancelBooking(ctx context.Context, req *bookingv1.CancelBookingRequest, resp *bookingv1.CancelBookingResponse) error {
+	userID, ok := ctx.Value("userId").(string)
+	if !ok || userID == "" {
+		return errors.Unauthorized("ticketing.booking", "user unauthorized")
+	}
+
+	booking, err := h.svc.CancelBooking(ctx, req.BookingId, userID)
+	if err != nil {
+		return err
+	}
+
+	resp.Booking = toProtoBooking(booking)
+	return nil
+}
+
+func (h *microBookingGrpcHandler) RefundBooking(ctx context.Context, req *bookingv1.RefundBookingRequest, resp *bookingv1.RefundBookingResponse) error {
+	userID, ok := ctx.Value("userId").(string)
+	if !ok || userID == "" {
+		return errors.Unauthorized("ticketing.booking", "user unauthorized")
+	}
+
+	var amount *decimal.Decimal
+	if req.Amount != "" {
+		parsed, err := decimal.NewFromString(req.Amount)
+		if err != nil {
+			return errors.BadRequest("ticketing.booking", "invalid amount: %s", req.Amount)
+		}
+		amount = &parsed
+	}
+
+	booking, payment, err := h.svc.RefundBooking(ctx, req.BookingId, userID, amount)
+	if err != nil {
+		return err
+	}
+
+	resp.Booking = toProtoBooking(booking)
+	resp.RefundedAmount = payment.RefundedAmount.String()
+	resp.TransactionId = lo.FromPtr(payment.TransactionID)
+	return nil
+}
+
+func (h *microBookingGrpcHandler) GetBookingHistory(ctx context.Context, req *bookingv1.GetBookingHistoryRequest, resp *bookingv1.GetBookingHistoryResponse) error {
+	userID, ok := ctx.Value("userId").(string)
+	if !ok || userID == "" {
+		return errors.Unauthorized("ticketing.booking", "user unauthorized")
+	}
+
+	history, err := h.svc.GetBookingHistory(ctx, req.BookingId, userID)
+	if err != nil {
+		return err
+	}
+
+	resp.Changes = make([]*bookingv1.BookingStatusChange, len(history))
+	for i, change := range history {
+		from := bookingv1.BookingStatus_BOOKING_STATUS_UNSPECIFIED
+		if change.FromStatus != nil {
+			from = toProtoStatus(*change.FromStatus)
+		}
+		resp.Changes[i] = &bookingv1.BookingStatusChange{
+			FromStatus: from,
+			ToStatus:   toProtoStatus(change.ToStatus),
+			ActorType:  string(change.ActorType),
+			ActorId:    change.ActorID,
+			Reason:     change.Reason,
+			ChangedAt:  timestamppb.New(change.CreatedAt),
+		}
+	}
+	return nil
+}
+
+func (h *microBookingGrpcHandler) ListTickets(ctx context.Context, req *bookingv1.ListTicketsRequest, resp *bookingv1.ListTicketsResponse) error {
+	userID, ok := ctx.Value("userId").(string)
+	if !ok || userID == "" {
+		return errors.Unauthorized("ticketing.booking", "user unauthorized")
+	}
+
+	page := lo.Ternary(req.Page < 1, 1, int(req.Page))
+	pageSize := lo.Ternary(req.PageSize < 1, 10, int(req.PageSize))
+
+	tickets, total, err := h.tickets.ListTickets(ctx, userID, page, pageSize)
+	if err != nil {
+		return err
+	}
+
+	resp.Tickets = lo.Map(tickets, func(t *model.Ticket, _ int) *bookingv1.Ticket {
+		return toProtoTicket(t)
+	})
+	resp.Pagination = &commonv1.PaginationResponse{
+		TotalCount: total,
+		Page:       int32(page),
+		PageSize:   int32(pageSize),
+		TotalPages: int32((total + int64(pageSize) - 1) / int64(pageSize)),
+	}
+	return nil
+}
+
+func (h *microBookingGrpcHandler) GetTicket(ctx context.Context, req *bookingv1.GetTicketRequest, resp *bookingv1.GetTicketResponse) error {
+	userID, ok := ctx.Value("userId").(string)
+	if !ok || userID == "" {
+		return errors.Unauthorized("ticketing.booking", "user unauthorized")
+	}
+
+	ticket, err := h.tickets.GetTicket(ctx, req.TicketId, userID)
+	if err != nil {
+		return err
+	}
+
+	resp.Ticket = toProtoTicket(ticket)
+	return nil
+}
+
+func (h *microBookingGrpcHandler) GetTicketVerificationKey(_ context.Context, _ *bookingv1.GetTicketVerificationKeyRequest, resp *bookingv1.GetTicketVerificationKeyResponse) error {
+	resp.Algorithm = "Ed25519"
+	resp.PublicKey = h.tickets.VerificationKey()
+	return nil
+}
+
+func (h *microBookingGrpcHandler) ValidateTicket(ctx context.Context, req *bookingv1.ValidateTicketRequest, resp *bookingv1.ValidateTicketResponse) error {
+	outcome, err := h.tickets.ValidateTicket(ctx, req.QrPayload, req.SessionId)
+	if err != nil {
+		return err
+	}
+
+	resp.Outcome = toProtoCheckInOutcome(outcome)
+	return nil
+}
+
+func (h *microBookingGrpcHandler) CheckInTicket(ctx context.Context, req *bookingv1.CheckInTicketRequest, resp *bookingv1.CheckInTicketResponse) error {
+	staffID, ok := ctx.Value("userId").(string)
+	if !ok || staffID == "" {
+		return errors.Unauthorized("ticketing.booking", "user unauthorized")
+	}
+
+	outcome, err := h.tickets.CheckInTicket(ctx, req.SessionId, req.GateId, staffID, model.CheckInScan{Payload: req.QrPayload})
+	if err != nil {
+		return err
+	}
+
+	resp.Outcome = toProtoCheckInOutcome(outcome)
+	return nil
+}
+
+func (h *microBookingGrpcHandler) SyncCheckIns(ctx context.Context, req *bookingv1.SyncCheckInsRequest, resp *bookingv1.SyncCheckInsResponse) error {
+	staffID, ok := ctx.Value("userId").(string)
+	if !ok || staffID == "" {
+		return errors.Unauthorized("ticketing.booking", "user unauthorized")
+	}
+
+	scans := lo.Map(req.Scans, func(scan *bookingv1.CheckInScan, _ int) model.CheckInScan {
+		return model.CheckInScan{Payload: scan.QrPayload, ScannedAt: scan.ScannedAt.AsTime()}
+	})
+
+	outcomes, err := h.tickets.SyncCheckIns(ctx, req.SessionId, req.GateId, staffID, scans)
+	if err != nil {
+		return err
+	}
+
+	resp.Outcomes = lo.Map(outcomes, func(outcome *model.CheckInOutcome, _ int) *bookingv1.CheckInOutcome {
+		return toProtoCheckInOutcome(outcome)
+	})
+	return nil
+}
+
+// HandlePaymentNotification is called by the gateway payment webhook after it has verified the provider's
+// signature; it is not routed through the public API.
+func (h *microBookingGrpcHandler) HandlePaymentNotification(ctx context.Context, req *bookingv1.HandlePaymentNotificationRequest, resp *bookingv1.HandlePaymentNotificationResponse) error {
+	amount, err := decimal.NewFromString(req.Amount)
+	if err != nil {
+		return errors.BadRequest("ticketing.booking", "invalid amount: %s", req.Amount)
+	}
+
+	status := model.PaymentEventSucceeded
+	if req.Status == bookingv1.PaymentNotificationStatus_PAYMENT_NOTIFICATION_STATUS_FAILED {
+		status = model.PaymentEventFailed
+	}
+
+	result, err := h.svc.HandlePaymentNotification(ctx, &model.PaymentNotification{
+		Provider:      req.Provider,
+		EventID:       req.EventId,
+		OrderID:       req.BookingId,
+		PaymentID:     req.PaymentId,
+		TransactionID: req.TransactionId,
+		Status:        status,
+		Amount:        amount,
+		FailureReason: req.FailureReason,
+	})
+	if stderrors.Is(err, service.ErrBookingNotFound) {
+		return errors.NotFound("ticketing.booking", "%s", err.Error())
+	}
+	if stderrors.Is(err, service.ErrPaymentMismatch) {
+		return errors.BadRequest("ticketing.booking", "%s", err.Error())
+	}
+	if err != nil {
+		return err
+	}
+
+	resp.Duplicate = result.Duplicate
+	resp.Status = toProtoStatus(result.Booking.Status)
+	return nil
+}
+
 func toProtoBooking(b *model.Booking) *bookingv1.Booking {
 	if b == nil {
 		return nil
 	}
 
+	return &bookingv1.Booking{
+		BookingId:  b.ID,
+		UserId:     b.UserID,
+		ShowId:     "", // ShowID removed from model, set empty for backward compatibility
+		SessionId:  b.SessionID,
+		SeatAreaId: b.SeatAreaID,
+		Quantity:   b.Quantity,
+		TotalPrice: b.TotalAmount.String(),
+		Status:     toProtoStatus(b.Status),
+		CreatedAt:  timestamppb.New(b.CreatedAt),
+		UpdatedAt:  timestamppb.New(b.UpdatedAt),
+		SeatIds:    b.SeatIDs,
+	}
+}
+
+func toProtoStatus(s model.BookingStatus) bookingv1.BookingStatus {
 	status := bookingv1.BookingStatus_BOOKING_STATUS_UNSPECIFIED
-	switch b.Status {
+	switch s {
 	case model.BookingStatusPendingPayment:
 		status = bookingv1.BookingStatus_BOOKING_STATUS_PENDING
 	case model.BookingStatusPaid:
 		status = bookingv1.BookingStatus_BOOKING_STATUS_PAID
+	case model.BookingStatusFailed:
+		status = bookingv1.BookingStatus_BOOKING_STATUS_FAILED
 	case model.BookingStatusCancelled:
 		status = bookingv1.BookingStatus_BOOKING_STATUS_CANCELLED
+	case model.BookingStatusExpired:
+		status = bookingv1.BookingStatus_BOOKING_STATUS_EXPIRED
+	case model.BookingStatusRefundPending:
+		status = bookingv1.BookingStatus_BOOKING_STATUS_REFUND_PENDING
 	case model.BookingStatusRefunded:
-		// Map refunded to cancelled for now, or extend proto enum
-		status = bookingv1.BookingStatus_BOOKING_STATUS_CANCELLED
+		status = bookingv1.BookingStatus_BOOKING_STATUS_REFUNDED
 	case model.BookingStatusCompleted:
-		// Map completed to paid for now, or extend proto enum
-		status = bookingv1.BookingStatus_BOOKING_STATUS_PAID
+		status = bookingv1.BookingStatus_BOOKING_STATUS_COMPLETED
 	}
+	return status
+}
 
-	return &bookingv1.Booking{
-		BookingId:  b.ID,
-		UserId:     b.UserID,
-		ShowId:     "", // ShowID removed from model, set empty for backward compatibility
-		SessionId:  b.SessionID,
-		SeatAreaId: b.SeatAreaID,
-		Quantity:   b.Quantity,
-		TotalPrice: b.TotalAmount.String(),
-		Status:     status,
-		CreatedAt:  timestamppb.New(b.CreatedAt),
-		UpdatedAt:  timestamppb.New(b.UpdatedAt),
+func toProtoTicket(t *model.Ticket) *bookingv1.Ticket {
+	status := bookingv1.TicketStatus_TICKET_STATUS_UNSPECIFIED
+	switch t.Status {
+	case model.TicketStatusValid:
+		status = bookingv1.TicketStatus_TICKET_STATUS_VALID
+	case model.TicketStatusUsed:
+		status = bookingv1.TicketStatus_TICKET_STATUS_USED
+	case model.TicketStatusRefunded:
+		status = bookingv1.TicketStatus_TICKET_STATUS_REFUNDED
+	}
+
+	pb := &bookingv1.Ticket{
+		TicketId:  t.ID,
+		TicketNo:  t.TicketNo,
+		BookingId: t.OrderID,
+		SessionId: t.SessionID,
+		SeatId:    lo.FromPtr(t.SeatID),
+		QrCode:    t.QRCode,
+		Status:    status,
+		CreatedAt: timestamppb.New(t.CreatedAt),
+		GateId:    lo.FromPtr(t.GateID),
+	}
+	if t.UsedAt != nil {
+		pb.UsedAt = timestamppb.New(*t.UsedAt)
+	}
+	return pb
+}
+
+func toProtoCheckInOutcome(o *model.CheckInOutcome) *bookingv1.CheckInOutcome {
+	result := bookingv1.CheckInResult_CHECK_IN_RESULT_UNSPECIFIED
+	switch o.Result {
+	case model.CheckInAccepted:
+		result = bookingv1.CheckInResult_CHECK_IN_RESULT_ACCEPTED
+	case model.CheckInDuplicate:
+		result = bookingv1.CheckInResult_CHECK_IN_RESULT_DUPLICATE
+	case model.CheckInInvalid:
+		result = bookingv1.CheckInResult_CHECK_IN_RESULT_INVALID
+	case model.CheckInWrongSession:
+		result = bookingv1.CheckInResult_CHECK_IN_RESULT_WRONG_SESSION
+	case model.CheckInRevoked:
+		result = bookingv1.CheckInResult_CHECK_IN_RESULT_REVOKED
+	}
+
+	pb := &bookingv1.CheckInOutcome{Result: result}
+	if o.Ticket != nil {
+		pb.Ticket = toProtoTicket(o.Ticket)
 	}
+	return pb
 }
@@ -1,6 +1,8 @@
 package model
 
 import (
+	"errors"
+	"slices"
 	"time"
 
 	"github.com/shopspring/decimal"
@@ -11,11 +13,39 @@ type BookingStatus string
 const (
 	BookingStatusPendingPayment BookingStatus = "pending_payment"
 	BookingStatusPaid           BookingStatus = "paid"
+	BookingStatusFailed         BookingStatus = "failed"
 	BookingStatusCancelled      BookingStatus = "cancelled"
+	BookingStatusRefundPending  BookingStatus = "refund_pending" // Refund requested, waiting for the payment provider
 	BookingStatusRefunded       BookingStatus = "refunded"
 	BookingStatusCompleted      BookingStatus = "completed"
+	BookingStatusExpired        BookingStatus = "expired"
 )
 
+var ErrInvalidTransition = errors.New("invalid booking status transition")
+
+// bookingTransitions is the booking state machine: the statuses each status may move to.
+// Statuses without an entry are final.
+var bookingTransitions = map[BookingStatus][]BookingStatus{
+	BookingStatusPendingPayment: {BookingStatusPaid, BookingStatusFailed, BookingStatusCancelled, BookingStatusExpired},
+	BookingStatusPaid:           {BookingStatusCompleted, BookingStatusRefundPending},
+	BookingStatusRefundPending:  {BookingStatusRefunded, BookingStatusPaid}, // Back to paid if the provider refuses
+}
+
+// CanTransitionTo reports whether the state machine allows moving from s to next.
+func (s BookingStatus) CanTransitionTo(next BookingStatus) bool {
+	return slices.Contains(bookingTransitions[s], next)
+}
+
+// ReleasesSeats reports whether an order entering s gives its seats back to the catalog.
+func (s BookingStatus) ReleasesSeats() bool {
+	switch s {
+	case BookingStatusCancelled, BookingStatusExpired, BookingStatusFailed, BookingStatusRefunded:
+		return true
+	default:
+		return false
+	}
+}
+
 type Booking struct {
 	ID          string          `gorm:"primaryKey;type:uuid;default:gen_random_uuid()"`
 	OrderNo     string          `gorm:"type:varchar(32);not null;uniqueIndex"`
@@ -23,6 +53,7 @@ type Booking struct {
 	SessionID   string          `gorm:"type:uuid;not null;index"`
 	SeatAreaID  string          `gorm:"type:uuid;not null"`
 	Quantity    int32           `gorm:"not null"`
+	SeatIDs     []string        `gorm:"type:uuid[];not null;default:'{}'"` // Specific seats; empty when booked by quantity
 	UnitPrice   decimal.Decimal `gorm:"type:decimal(10,2);not null"`
 	TotalAmount decimal.Decimal `gorm:"type:decimal(10,2);not null"`
 	Status      BookingStatus   `gorm:"type:varchar(20);not null;default:'pending_payment';index"`
@@ -36,3 +67,34 @@ type Booking struct {
 func (Booking) TableName() string {
 	return "booking.orders"
 }
+
+type StatusActorType string
+
+const (
+	StatusActorUser     StatusActorType = "user"
+	StatusActorSystem   StatusActorType = "system"
+	StatusActorProvider StatusActorType = "provider"
+)
+
+// StatusChange describes who or what changes a booking's status and why.
+type StatusChange struct {
+	ActorType StatusActorType
+	ActorID   string // User ID, background job name or payment provider
+	Reason    string
+}
+
+// BookingStatusHistory is one recorded status change; FromStatus is nil for the creation of the order.
+type BookingStatusHistory struct {
+	ID         string          `gorm:"primaryKey;type:uuid;default:gen_random_uuid()"`
+	OrderID    string          `gorm:"type:uuid;not null;index"`
+	FromStatus *BookingStatus  `gorm:"type:varchar(20)"`
+	ToStatus   BookingStatus   `gorm:"type:varchar(20);not null"`
+	ActorType  StatusActorType `gorm:"type:varchar(20);not null"`
+	ActorID    string          `gorm:"type:varchar(100);not null"`
+	Reason     string          `gorm:"type:text"`
+	CreatedAt  time.Time       `gorm:"type:timestamptz;default:now()"`
+}
+
+func (BookingStatusHistory) TableName() string {
+	return "booking.order_status_history"
+}
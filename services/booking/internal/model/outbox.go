@@ -0,0 +1,78 @@
+package model
+
+import "time"
+
+// Order event types, also used as broker topics. See bookingv1.OrderEvent for the payload.
+const (
+	OrderEventCreated   = "ticketing.booking.order.created"
+	OrderEventPaid      = "ticketing.booking.order.paid"
+	OrderEventCancelled = "ticketing.booking.order.cancelled"
+	OrderEventRefunded  = "ticketing.booking.order.refunded"
+	// OrderEventShowChanged is published for each active order of a show whose details changed.
+	OrderEventShowChanged = "ticketing.booking.order.show_changed"
+)
+
+// Order tasks share the outbox with order events so they commit with the order change, but the relay runs
+// them in-process instead of publishing them, retrying until they succeed.
+const (
+	// OrderTaskReleaseSeats returns the seats of an order that was closed or refunded to the catalog.
+	OrderTaskReleaseSeats = "ticketing.booking.task.release_seats"
+	// OrderTaskRefundPayment asks the payment provider for a requested refund. Its payload is a RefundTask
+	// and its ID is the refund's idempotency key, so a retried task never pays out twice.
+	OrderTaskRefundPayment = "ticketing.booking.task.refund_payment"
+)
+
+// IsOrderTask reports whether an outbox entry is an order task rather than an event to publish.
+func IsOrderTask(eventType string) bool {
+	return eventType == OrderTaskReleaseSeats || eventType == OrderTaskRefundPayment
+}
+
+// RefundTask is the payload of an OrderTaskRefundPayment task. The amount is the payment's RefundedAmount.
+type RefundTask struct {
+	PaymentID string `json:"paymentId"`
+}
+
+// OrderEventType returns the event published when an order enters status, or "" if none is.
+func OrderEventType(status BookingStatus) string {
+	switch status {
+	case BookingStatusPendingPayment:
+		return OrderEventCreated
+	case BookingStatusPaid:
+		return OrderEventPaid
+	case BookingStatusCancelled, BookingStatusExpired, BookingStatusFailed:
+		return OrderEventCancelled
+	case BookingStatusRefunded:
+		return OrderEventRefunded
+	default:
+		return ""
+	}
+}
+
+// ShowEventChanged is the catalog topic for catalogv1.ShowEvent, published when a show is updated.
+const ShowEventChanged = "ticketing.catalog.show.changed"
+
+type OutboxStatus string
+
+const (
+	OutboxStatusPending   OutboxStatus = "pending"
+	OutboxStatusPublished OutboxStatus = "published"
+	OutboxStatusDead      OutboxStatus = "dead" // Gave up after too many failed attempts, needs manual attention
+)
+
+// OutboxEvent is a domain event written in the transaction that caused it and published by the relay.
+type OutboxEvent struct {
+	ID            string       `gorm:"primaryKey;type:uuid;default:gen_random_uuid()"`
+	AggregateID   string       `gorm:"type:uuid;not null;index"`
+	EventType     string       `gorm:"type:varchar(100);not null"`
+	Payload       []byte       `gorm:"type:jsonb;not null"`
+	Status        OutboxStatus `gorm:"type:varchar(20);not null;default:'pending'"`
+	Attempts      int          `gorm:"not null;default:0"`
+	NextAttemptAt time.Time    `gorm:"type:timestamptz;not null;default:now()"`
+	LastError     *string      `gorm:"type:text"`
+	CreatedAt     time.Time    `gorm:"type:timestamptz;default:now()"`
+	PublishedAt   *time.Time   `gorm:"type:timestamptz"`
+}
+
+func (OutboxEvent) TableName() string {
+	return "booking.outbox"
+}
@@ -0,0 +1,68 @@
+package model
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+type PaymentStatus string
+
+const (
+	PaymentStatusPending  PaymentStatus = "pending"
+	PaymentStatusSuccess  PaymentStatus = "success"
+	PaymentStatusFailed   PaymentStatus = "failed"
+	PaymentStatusRefunded PaymentStatus = "refunded"
+	// PaymentStatusRefundPending marks a payment whose refund of RefundedAmount was requested but not yet
+	// confirmed by the provider.
+	PaymentStatusRefundPending PaymentStatus = "refund_pending"
+)
+
+// Payment is a single payment attempt for an order; an order may have several failed attempts
+// but at most one successful one.
+type Payment struct {
+	ID             string          `gorm:"primaryKey;type:uuid;default:gen_random_uuid()"`
+	OrderID        string          `gorm:"type:uuid;not null;index"`
+	PaymentMethod  string          `gorm:"type:varchar(20);not null"`
+	TransactionID  *string         `gorm:"type:varchar(100);uniqueIndex"`
+	Amount         decimal.Decimal `gorm:"type:decimal(10,2);not null"`
+	Status         PaymentStatus   `gorm:"type:varchar(20);not null;default:'pending';index"`
+	FailureReason  *string         `gorm:"type:text"`
+	PaidAt         *time.Time      `gorm:"type:timestamptz"`
+	RefundedAmount decimal.Decimal `gorm:"type:decimal(10,2);not null;default:0"` // Less than Amount for partial refunds; requested amount while refund_pending
+	RefundedAt     *time.Time      `gorm:"type:timestamptz"`
+	CreatedAt      time.Time       `gorm:"type:timestamptz;default:now()"`
+	UpdatedAt      time.Time       `gorm:"type:timestamptz;default:now()"`
+}
+
+func (Payment) TableName() string {
+	return "booking.payments"
+}
+
+type PaymentEventStatus string
+
+const (
+	PaymentEventSucceeded PaymentEventStatus = "succeeded"
+	PaymentEventFailed    PaymentEventStatus = "failed"
+)
+
+// PaymentNotification is an asynchronous payment result reported by a provider, e.g. through a webhook.
+// Providers may deliver the same event more than once; it is applied once per (Provider, EventID).
+type PaymentNotification struct {
+	Provider      string
+	EventID       string
+	OrderID       string
+	PaymentID     string // Optional; the provider echoes our payment ID back if it knows it
+	TransactionID string
+	Status        PaymentEventStatus
+	Amount        decimal.Decimal
+	FailureReason string
+}
+
+// PaymentNotificationResult describes what applying a PaymentNotification did.
+type PaymentNotificationResult struct {
+	Booking   *Booking
+	Payment   *Payment
+	Duplicate bool // The event had already been applied; nothing changed
+	Orphaned  bool // The money was captured but the order could not be paid with it and must be refunded
+}
@@ -0,0 +1,53 @@
+package model
+
+import (
+	"time"
+)
+
+type SagaStatus string
+
+const (
+	SagaStatusRunning      SagaStatus = "running"
+	SagaStatusCompleted    SagaStatus = "completed"
+	SagaStatusCompensating SagaStatus = "compensating"
+	SagaStatusCompensated  SagaStatus = "compensated"
+	SagaStatusFailed       SagaStatus = "failed" // Compensation failed, retried by the saga recovery worker
+)
+
+type SagaStepStatus string
+
+const (
+	SagaStepStatusStarted            SagaStepStatus = "started"
+	SagaStepStatusCompleted          SagaStepStatus = "completed"
+	SagaStepStatusFailed             SagaStepStatus = "failed"
+	SagaStepStatusCompensated        SagaStepStatus = "compensated"
+	SagaStepStatusCompensationFailed SagaStepStatus = "compensation_failed"
+)
+
+type Saga struct {
+	ID        string
+	Name      string
+	Status    SagaStatus
+	Payload   []byte
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func (Saga) TableName() string {
+	return "booking.sagas"
+}
+
+type SagaStep struct {
+	ID           string
+	SagaID       string
+	StepIndex    int
+	StepName     string
+	Status       SagaStepStatus
+	ErrorMessage *string
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+func (SagaStep) TableName() string {
+	return "booking.saga_steps"
+}
@@ -0,0 +1,54 @@
+package model
+
+import "time"
+
+type TicketStatus string
+
+const (
+	TicketStatusValid    TicketStatus = "valid"
+	TicketStatusUsed     TicketStatus = "used"
+	TicketStatusRefunded TicketStatus = "refunded"
+)
+
+// Ticket is one admission to a session, issued per seat once the order is paid.
+type Ticket struct {
+	ID          string       `gorm:"primaryKey;type:uuid;default:gen_random_uuid()"`
+	OrderID     string       `gorm:"type:uuid;not null;index"`
+	SessionID   string       `gorm:"type:uuid"`
+	SeatID      *string      `gorm:"type:uuid"` // Nil when booked by quantity in an area without a seat map
+	TicketNo    string       `gorm:"type:varchar(32);not null;uniqueIndex"`
+	QRCode      string       `gorm:"type:text"` // Signed payload, see ticket.Signer
+	Status      TicketStatus `gorm:"type:varchar(20);not null;default:'valid';index"`
+	UsedAt      *time.Time   `gorm:"type:timestamptz"`
+	GateID      *string      `gorm:"type:varchar(64)"`
+	CheckedInBy *string      `gorm:"type:varchar(100)"`
+	CreatedAt   time.Time    `gorm:"type:timestamptz;default:now()"`
+	UpdatedAt   time.Time    `gorm:"type:timestamptz;default:now()"`
+}
+
+func (Ticket) TableName() string {
+	return "booking.tickets"
+}
+
+type CheckInResult string
+
+const (
+	CheckInAccepted     CheckInResult = "accepted"
+	CheckInDuplicate    CheckInResult = "duplicate"     // Already checked in; Ticket carries the original check-in
+	CheckInInvalid      CheckInResult = "invalid"       // Bad signature or unknown ticket
+	CheckInWrongSession CheckInResult = "wrong_session" // Valid ticket for another session
+	CheckInRevoked      CheckInResult = "revoked"       // Ticket was refunded
+)
+
+// CheckInScan is one QR code read at a gate; ScannedAt is when the gate read it, which for offline
+// gates is earlier than when the scan reaches the service.
+type CheckInScan struct {
+	Payload   string
+	ScannedAt time.Time
+}
+
+// CheckInOutcome is the verdict on one scan. Ticket is nil when the payload could not be matched to a ticket.
+type CheckInOutcome struct {
+	Result CheckInResult
+	Ticket *Ticket
+}
@@ -2,10 +2,14 @@ package module
 
 import (
 	catalogv1 "github.com/wylu1037/go-micro-boilerplate/gen/go/catalog/v1"
-	notificationv1 "github.com/wylu1037/go-micro-boilerplate/gen/go/notification/v1"
+	identityv1 "github.com/wylu1037/go-micro-boilerplate/gen/go/identity/v1"
 	"github.com/wylu1037/go-micro-boilerplate/services/booking/internal/handler"
+	"github.com/wylu1037/go-micro-boilerplate/services/booking/internal/payment"
 	"github.com/wylu1037/go-micro-boilerplate/services/booking/internal/repository"
 	"github.com/wylu1037/go-micro-boilerplate/services/booking/internal/service"
+	"github.com/wylu1037/go-micro-boilerplate/services/booking/internal/subscriber"
+	"github.com/wylu1037/go-micro-boilerplate/services/booking/internal/ticket"
+	"github.com/wylu1037/go-micro-boilerplate/services/booking/internal/worker"
 	"go-micro.dev/v4"
 	"go.uber.org/fx"
 )
@@ -14,14 +18,31 @@ var Module = fx.Module(
 	"booking",
 	fx.Provide(
 		repository.NewBookingRepository,
+		repository.NewSagaRepository,
+		repository.NewPaymentRepository,
+		repository.NewTicketRepository,
+		repository.NewOutboxRepository,
+		ticket.NewSigner,
+		payment.NewDefaultRegistry,
+		service.NewRefundPolicy,
 		service.NewBookingService,
+		service.NewTicketService,
 		handler.NewBookingGrpcHandler,
+		subscriber.NewShowEventSubscriber,
+		worker.NewExpiryWorker,
+		worker.NewSagaRecoveryWorker,
+		worker.NewTicketWorker,
+		worker.NewOutboxRelay,
 		// Provide clients for other services
 		func(service micro.Service) catalogv1.CatalogService {
 			return catalogv1.NewCatalogService("ticketing.catalog", service.Client())
 		},
-		func(service micro.Service) notificationv1.NotificationService {
-			return notificationv1.NewNotificationService("ticketing.notification", service.Client())
+		func(service micro.Service) identityv1.IdentityService {
+			return identityv1.NewIdentityService("ticketing.identity", service.Client())
 		},
 	),
+	fx.Invoke(worker.RunExpiryWorker),
+	fx.Invoke(worker.RunSagaRecoveryWorker),
+	fx.Invoke(worker.RunTicketWorker),
+	fx.Invoke(worker.RunOutboxRelay),
 )
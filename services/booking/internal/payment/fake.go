@@ -0,0 +1,117 @@
+package payment
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"sync"
+
+	"github.com/shopspring/decimal"
+)
+
+// FakeProvider is an in-memory Provider for tests and local development. Its outcomes depend only on
+// its inputs: transaction IDs are derived from the payment ID, so retrying an authorization returns the
+// same transaction, and a provider created with decline set declines every authorization.
+type FakeProvider struct {
+	decline bool
+
+	mu           sync.Mutex
+	transactions map[string]*fakeTransaction
+	refunds      map[string]*Result // By refund ID
+}
+
+type fakeTransaction struct {
+	status   Status
+	amount   decimal.Decimal
+	refunded decimal.Decimal
+}
+
+func NewFakeProvider(decline bool) *FakeProvider {
+	return &FakeProvider{
+		decline:      decline,
+		transactions: map[string]*fakeTransaction{},
+		refunds:      map[string]*Result{},
+	}
+}
+
+func (p *FakeProvider) Authorize(_ context.Context, req *AuthorizeRequest) (*Result, error) {
+	sum := sha256.Sum256([]byte(req.PaymentID))
+	txnID := "fake_" + hex.EncodeToString(sum[:12])
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if txn, ok := p.transactions[txnID]; ok {
+		return &Result{TransactionID: txnID, Status: txn.status}, nil
+	}
+
+	status := StatusAuthorized
+	message := ""
+	if p.decline {
+		status = StatusDeclined
+		message = "declined by fake provider"
+	}
+	p.transactions[txnID] = &fakeTransaction{status: status, amount: req.Amount}
+
+	return &Result{TransactionID: txnID, Status: status, Message: message}, nil
+}
+
+func (p *FakeProvider) Capture(_ context.Context, transactionID string, amount decimal.Decimal) (*Result, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	txn, ok := p.transactions[transactionID]
+	if !ok {
+		return nil, ErrUnknownTransaction
+	}
+
+	switch {
+	case txn.status == StatusAuthorized && amount.LessThanOrEqual(txn.amount):
+		txn.status = StatusCaptured
+		txn.amount = amount
+	case txn.status == StatusCaptured:
+		// Already captured: idempotent
+	default:
+		return &Result{TransactionID: transactionID, Status: txn.status, Message: "transaction cannot be captured"}, nil
+	}
+
+	return &Result{TransactionID: transactionID, Status: txn.status}, nil
+}
+
+func (p *FakeProvider) Refund(_ context.Context, req *RefundRequest) (*Result, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if result, ok := p.refunds[req.RefundID]; ok {
+		return result, nil
+	}
+
+	txn, ok := p.transactions[req.TransactionID]
+	if !ok {
+		return nil, ErrUnknownTransaction
+	}
+
+	result := &Result{TransactionID: req.TransactionID, Status: StatusRefunded}
+	if txn.status != StatusCaptured || txn.refunded.Add(req.Amount).GreaterThan(txn.amount) {
+		result = &Result{TransactionID: req.TransactionID, Status: txn.status, Message: "transaction cannot be refunded"}
+	} else {
+		txn.refunded = txn.refunded.Add(req.Amount)
+		if txn.refunded.Equal(txn.amount) {
+			txn.status = StatusRefunded
+		}
+	}
+
+	p.refunds[req.RefundID] = result
+	return result, nil
+}
+
+func (p *FakeProvider) Query(_ context.Context, transactionID string) (*Result, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	txn, ok := p.transactions[transactionID]
+	if !ok {
+		return nil, ErrUnknownTransaction
+	}
+	return &Result{TransactionID: transactionID, Status: txn.status}, nil
+}
@@ -0,0 +1,90 @@
+// Package payment abstracts the payment gateways bookings are paid through. Each gateway implements
+// Provider and is registered under the payment_method values clients send with ProcessPayment.
+package payment
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/shopspring/decimal"
+)
+
+var (
+	ErrUnsupportedMethod  = errors.New("unsupported payment method")
+	ErrUnknownTransaction = errors.New("unknown payment transaction")
+)
+
+type Status string
+
+const (
+	StatusAuthorized Status = "authorized" // Funds reserved, not yet captured
+	StatusCaptured   Status = "captured"
+	StatusDeclined   Status = "declined"
+	StatusRefunded   Status = "refunded"
+)
+
+type AuthorizeRequest struct {
+	PaymentID string // Our payment attempt ID, used by providers as idempotency key
+	OrderID   string
+	Amount    decimal.Decimal
+}
+
+type RefundRequest struct {
+	RefundID      string // Our refund ID, used by providers as idempotency key
+	TransactionID string
+	Amount        decimal.Decimal
+}
+
+// Result is the provider's view of a transaction after an operation. A declined payment is reported
+// through Status rather than an error; errors mean the outcome is unknown.
+type Result struct {
+	TransactionID string
+	Status        Status
+	Message       string
+}
+
+type Provider interface {
+	// Authorize reserves the amount on the payer's account.
+	Authorize(ctx context.Context, req *AuthorizeRequest) (*Result, error)
+	// Capture collects a previously authorized amount.
+	Capture(ctx context.Context, transactionID string, amount decimal.Decimal) (*Result, error)
+	// Refund returns a captured amount, fully or in part. Retrying a refund with the same RefundID returns
+	// the outcome of the first attempt instead of refunding again.
+	Refund(ctx context.Context, req *RefundRequest) (*Result, error)
+	// Query returns the current state of a transaction.
+	Query(ctx context.Context, transactionID string) (*Result, error)
+}
+
+// Registry selects the Provider for a payment method.
+type Registry struct {
+	providers map[string]Provider
+}
+
+func NewRegistry() *Registry {
+	return &Registry{providers: map[string]Provider{}}
+}
+
+// Register makes p handle the given payment methods, replacing any provider registered for them before.
+func (r *Registry) Register(p Provider, methods ...string) *Registry {
+	for _, method := range methods {
+		r.providers[method] = p
+	}
+	return r
+}
+
+func (r *Registry) Get(method string) (Provider, error) {
+	p, ok := r.providers[method]
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", ErrUnsupportedMethod, method)
+	}
+	return p, nil
+}
+
+// NewDefaultRegistry registers the providers available to the booking service. No real gateway is
+// integrated yet, so "fake" approves every payment and "fail" declines every payment.
+func NewDefaultRegistry() *Registry {
+	return NewRegistry().
+		Register(NewFakeProvider(false), "fake").
+		Register(NewFakeProvider(true), "fail")
+}
@@ -3,17 +3,38 @@ package repository
 import (
 	"context"
 	"errors"
+	"time"
 
+	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
 	"github.com/wylu1037/go-micro-boilerplate/pkg/db"
 	"github.com/wylu1037/go-micro-boilerplate/services/booking/internal/model"
 )
 
 type BookingRepository interface {
-	Create(ctx context.Context, booking *model.Booking) error
+	// Create inserts the order and records its initial status in the status history. It publishes nothing:
+	// the order is announced by Announce once its seats are reserved.
+	Create(ctx context.Context, booking *model.Booking, change model.StatusChange) error
+	// Announce writes the order created event for a pending order. The event ID derives from the order ID,
+	// so announcing an order again writes nothing. An order closed before it was announced is left
+	// unannounced, and so are the events for its closing. It returns nil if the order does not exist.
+	Announce(ctx context.Context, id string) (*model.Booking, error)
 	GetByID(ctx context.Context, id string) (*model.Booking, error)
-	UpdateStatus(ctx context.Context, id string, status model.BookingStatus) error
+	// Transition locks the order and moves it to status to, failing with model.ErrInvalidTransition if the
+	// state machine does not allow it from the current status. The change is recorded in the status history,
+	// and closing the order queues the release of its seats. It returns nil if the order does not exist.
+	Transition(ctx context.Context, id string, to model.BookingStatus, change model.StatusChange) (*model.Booking, error)
+	ListStatusHistory(ctx context.Context, orderID string) ([]*model.BookingStatusHistory, error)
 	List(ctx context.Context, page, pageSize int, userID string, status *model.BookingStatus) ([]*model.Booking, int64, error)
+	// ListOverdue returns the IDs of up to limit pending orders whose payment deadline is before the given time.
+	ListOverdue(ctx context.Context, before time.Time, limit int) ([]string, error)
+	// Expire expires one overdue order in its own transaction and queues the release of its seats. It returns
+	// nil if the order was paid or closed meanwhile, or another replica is expiring it.
+	Expire(ctx context.Context, id string, before time.Time, change model.StatusChange) (*model.Booking, error)
+	// RecordShowChanged writes an OrderEventShowChanged event for every active order (pending payment or
+	// paid) of the sessions. Event IDs derive from sourceEventID, so recording the same change again writes
+	// nothing. It returns how many events were written.
+	RecordShowChanged(ctx context.Context, sessionIDs []string, sourceEventID, reason string) (int, error)
 }
 
 type bookingRepository struct {
@@ -24,31 +45,59 @@ func NewBookingRepository(db *db.Pool) BookingRepository {
 	return &bookingRepository{db: db}
 }
 
-func (r *bookingRepository) Create(ctx context.Context, booking *model.Booking) error {
+func (r *bookingRepository) Create(ctx context.Context, booking *model.Booking, change model.StatusChange) error {
 	query := `
-		INSERT INTO booking.orders (order_no, user_id, session_id, seat_area_id, quantity, unit_price, total_amount, status, expires_at)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		INSERT INTO booking.orders (id, order_no, user_id, session_id, seat_area_id, quantity, seat_ids, unit_price, total_amount, status, expires_at)
+		VALUES (COALESCE(NULLIF($1, '')::uuid, gen_random_uuid()), $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
 		RETURNING id, created_at, updated_at
 	`
 
-	err := r.db.QueryRow(ctx, query,
-		booking.OrderNo,
-		booking.UserID,
-		booking.SessionID,
-		booking.SeatAreaID,
-		booking.Quantity,
-		booking.UnitPrice,
-		booking.TotalAmount,
-		booking.Status,
-		booking.ExpiresAt,
-	).Scan(&booking.ID, &booking.CreatedAt, &booking.UpdatedAt)
-
-	return err
+	return r.db.Transaction(ctx, func(tx pgx.Tx) error {
+		if scanErr := tx.QueryRow(ctx, query,
+			booking.ID,
+			booking.OrderNo,
+			booking.UserID,
+			booking.SessionID,
+			booking.SeatAreaID,
+			booking.Quantity,
+			seatIDsOrEmpty(booking.SeatIDs),
+			booking.UnitPrice,
+			booking.TotalAmount,
+			booking.Status,
+			booking.ExpiresAt,
+		).Scan(&booking.ID, &booking.CreatedAt, &booking.UpdatedAt); scanErr != nil {
+			return scanErr
+		}
+
+		return insertStatusHistory(ctx, tx, booking.ID, nil, booking.Status, change)
+	})
+}
+
+func (r *bookingRepository) Announce(ctx context.Context, id string) (*model.Booking, error) {
+	var booking *model.Booking
+	err := r.db.Transaction(ctx, func(tx pgx.Tx) error {
+		b, lockErr := lockOrder(ctx, tx, id)
+		if lockErr != nil || b == nil {
+			return lockErr
+		}
+
+		booking = b
+		if b.Status != model.BookingStatusPendingPayment {
+			return nil
+		}
+		_, writeErr := writeOrderEvent(ctx, tx, orderCreatedEventID(b.ID), model.OrderEventCreated, b, "order created")
+		return writeErr
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return booking, nil
 }
 
 func (r *bookingRepository) GetByID(ctx context.Context, id string) (*model.Booking, error) {
 	query := `
-		SELECT id, order_no, user_id, session_id, seat_area_id, quantity, unit_price, total_amount, status, 
+		SELECT id, order_no, user_id, session_id, seat_area_id, quantity, seat_ids, unit_price, total_amount, status, 
 		       expires_at, paid_at, cancelled_at, created_at, updated_at
 		FROM booking.orders
 		WHERE id = $1
@@ -62,6 +111,7 @@ func (r *bookingRepository) GetByID(ctx context.Context, id string) (*model.Book
 		&booking.SessionID,
 		&booking.SeatAreaID,
 		&booking.Quantity,
+		&booking.SeatIDs,
 		&booking.UnitPrice,
 		&booking.TotalAmount,
 		&booking.Status,
@@ -82,14 +132,118 @@ func (r *bookingRepository) GetByID(ctx context.Context, id string) (*model.Book
 	return booking, nil
 }
 
-func (r *bookingRepository) UpdateStatus(ctx context.Context, id string, status model.BookingStatus) error {
-	query := `
+func (r *bookingRepository) Transition(ctx context.Context, id string, to model.BookingStatus, change model.StatusChange) (*model.Booking, error) {
+	selectQuery := `
+		SELECT id, order_no, user_id, session_id, seat_area_id, quantity, seat_ids, unit_price, total_amount, status,
+		       expires_at, paid_at, cancelled_at, created_at, updated_at
+		FROM booking.orders
+		WHERE id = $1
+		FOR UPDATE
+	`
+
+	// The status guard is redundant with the row lock but keeps the update a compare-and-set on its own.
+	updateQuery := `
 		UPDATE booking.orders
-		SET status = $1, updated_at = NOW()
-		WHERE id = $2
+		SET status = $1,
+		    cancelled_at = CASE WHEN $2 THEN NOW() ELSE cancelled_at END,
+		    updated_at = NOW()
+		WHERE id = $3 AND status = $4
+		RETURNING cancelled_at, updated_at
+	`
+
+	var booking *model.Booking
+	err := r.db.Transaction(ctx, func(tx pgx.Tx) error {
+		b := &model.Booking{}
+		scanErr := tx.QueryRow(ctx, selectQuery, id).Scan(
+			&b.ID,
+			&b.OrderNo,
+			&b.UserID,
+			&b.SessionID,
+			&b.SeatAreaID,
+			&b.Quantity,
+			&b.SeatIDs,
+			&b.UnitPrice,
+			&b.TotalAmount,
+			&b.Status,
+			&b.ExpiresAt,
+			&b.PaidAt,
+			&b.CancelledAt,
+			&b.CreatedAt,
+			&b.UpdatedAt,
+		)
+		if errors.Is(scanErr, pgx.ErrNoRows) {
+			return nil
+		}
+		if scanErr != nil {
+			return scanErr
+		}
+
+		from := b.Status
+		if !from.CanTransitionTo(to) {
+			return model.ErrInvalidTransition
+		}
+
+		closes := to == model.BookingStatusCancelled || to == model.BookingStatusExpired || to == model.BookingStatusFailed
+		updateErr := tx.QueryRow(ctx, updateQuery, to, closes, b.ID, from).Scan(&b.CancelledAt, &b.UpdatedAt)
+		if errors.Is(updateErr, pgx.ErrNoRows) {
+			return model.ErrInvalidTransition
+		}
+		if updateErr != nil {
+			return updateErr
+		}
+
+		if historyErr := insertStatusHistory(ctx, tx, b.ID, &from, to, change); historyErr != nil {
+			return historyErr
+		}
+
+		b.Status = to
+		if eventErr := insertOrderEvent(ctx, tx, b, change); eventErr != nil {
+			return eventErr
+		}
+
+		booking = b
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return booking, nil
+}
+
+func (r *bookingRepository) ListStatusHistory(ctx context.Context, orderID string) ([]*model.BookingStatusHistory, error) {
+	query := `
+		SELECT id, order_id, from_status, to_status, actor_type, actor_id, reason, created_at
+		FROM booking.order_status_history
+		WHERE order_id = $1
+		ORDER BY created_at, id
 	`
-	_, err := r.db.Exec(ctx, query, status, id)
-	return err
+
+	rows, err := r.db.Query(ctx, query, orderID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	history := []*model.BookingStatusHistory{}
+	for rows.Next() {
+		h := &model.BookingStatusHistory{}
+		if scanErr := rows.Scan(
+			&h.ID,
+			&h.OrderID,
+			&h.FromStatus,
+			&h.ToStatus,
+			&h.ActorType,
+			&h.ActorID,
+			&h.Reason,
+			&h.CreatedAt,
+		); scanErr != nil {
+			return nil, scanErr
+		}
+		history = append(history, h)
+	}
+
+	return history, rows.Err()
 }
 
 func (r *bookingRepository) List(ctx context.Context, page, pageSize int, userID string, status *model.BookingStatus) ([]*model.Booking, int64, error) {
@@ -121,7 +275,7 @@ func (r *bookingRepository) List(ctx context.Context, page, pageSize int, userID
 
 	// List items
 	listQuery := `
-		SELECT id, order_no, user_id, session_id, seat_area_id, quantity, unit_price, total_amount, status,
+		SELECT id, order_no, user_id, session_id, seat_area_id, quantity, seat_ids, unit_price, total_amount, status,
 		       expires_at, paid_at, cancelled_at, created_at, updated_at
 		` + baseQuery + `
 		ORDER BY created_at DESC
@@ -145,6 +299,7 @@ func (r *bookingRepository) List(ctx context.Context, page, pageSize int, userID
 			&b.SessionID,
 			&b.SeatAreaID,
 			&b.Quantity,
+			&b.SeatIDs,
 			&b.UnitPrice,
 			&b.TotalAmount,
 			&b.Status,
@@ -162,3 +317,155 @@ func (r *bookingRepository) List(ctx context.Context, page, pageSize int, userID
 
 	return bookings, total, nil
 }
+
+func (r *bookingRepository) ListOverdue(ctx context.Context, before time.Time, limit int) ([]string, error) {
+	query := `
+		SELECT id
+		FROM booking.orders
+		WHERE status = $1 AND expires_at < $2
+		ORDER BY expires_at
+		LIMIT $3
+	`
+
+	rows, err := r.db.Query(ctx, query, model.BookingStatusPendingPayment, before, limit)
+	if err != nil {
+		return nil, err
+	}
+	return pgx.CollectRows(rows, pgx.RowTo[string])
+}
+
+func (r *bookingRepository) Expire(ctx context.Context, id string, before time.Time, change model.StatusChange) (*model.Booking, error) {
+	selectQuery := `
+		SELECT id, order_no, user_id, session_id, seat_area_id, quantity, seat_ids, unit_price, total_amount, status,
+		       expires_at, paid_at, cancelled_at, created_at, updated_at
+		FROM booking.orders
+		WHERE id = $1 AND status = $2 AND expires_at < $3
+		FOR UPDATE SKIP LOCKED
+	`
+
+	updateQuery := `
+		UPDATE booking.orders
+		SET status = $1, cancelled_at = NOW(), updated_at = NOW()
+		WHERE id = $2
+		RETURNING cancelled_at, updated_at
+	`
+
+	var expired *model.Booking
+	err := r.db.Transaction(ctx, func(tx pgx.Tx) error {
+		b := &model.Booking{}
+		scanErr := tx.QueryRow(ctx, selectQuery, id, model.BookingStatusPendingPayment, before).Scan(
+			&b.ID,
+			&b.OrderNo,
+			&b.UserID,
+			&b.SessionID,
+			&b.SeatAreaID,
+			&b.Quantity,
+			&b.SeatIDs,
+			&b.UnitPrice,
+			&b.TotalAmount,
+			&b.Status,
+			&b.ExpiresAt,
+			&b.PaidAt,
+			&b.CancelledAt,
+			&b.CreatedAt,
+			&b.UpdatedAt,
+		)
+		if errors.Is(scanErr, pgx.ErrNoRows) {
+			return nil
+		}
+		if scanErr != nil {
+			return scanErr
+		}
+
+		if updateErr := tx.QueryRow(ctx, updateQuery, model.BookingStatusExpired, b.ID).Scan(&b.CancelledAt, &b.UpdatedAt); updateErr != nil {
+			return updateErr
+		}
+		if historyErr := insertStatusHistory(ctx, tx, b.ID, &b.Status, model.BookingStatusExpired, change); historyErr != nil {
+			return historyErr
+		}
+		b.Status = model.BookingStatusExpired
+		if eventErr := insertOrderEvent(ctx, tx, b, change); eventErr != nil {
+			return eventErr
+		}
+
+		expired = b
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return expired, nil
+}
+
+func (r *bookingRepository) RecordShowChanged(ctx context.Context, sessionIDs []string, sourceEventID, reason string) (int, error) {
+	query := `
+		SELECT id, order_no, user_id, session_id, seat_area_id, quantity, seat_ids, unit_price, total_amount, status,
+		       expires_at, paid_at, cancelled_at, created_at, updated_at
+		FROM booking.orders
+		WHERE session_id = ANY($1) AND status IN ($2, $3)
+	`
+
+	written := 0
+	err := r.db.Transaction(ctx, func(tx pgx.Tx) error {
+		rows, queryErr := tx.Query(ctx, query, sessionIDs, model.BookingStatusPendingPayment, model.BookingStatusPaid)
+		if queryErr != nil {
+			return queryErr
+		}
+
+		bookings := []*model.Booking{}
+		for rows.Next() {
+			b := &model.Booking{}
+			if scanErr := rows.Scan(
+				&b.ID,
+				&b.OrderNo,
+				&b.UserID,
+				&b.SessionID,
+				&b.SeatAreaID,
+				&b.Quantity,
+				&b.SeatIDs,
+				&b.UnitPrice,
+				&b.TotalAmount,
+				&b.Status,
+				&b.ExpiresAt,
+				&b.PaidAt,
+				&b.CancelledAt,
+				&b.CreatedAt,
+				&b.UpdatedAt,
+			); scanErr != nil {
+				rows.Close()
+				return scanErr
+			}
+			bookings = append(bookings, b)
+		}
+		rows.Close()
+		if rowsErr := rows.Err(); rowsErr != nil {
+			return rowsErr
+		}
+
+		for _, b := range bookings {
+			eventID := uuid.NewSHA1(uuid.NameSpaceURL, []byte(sourceEventID+"/"+b.ID)).String()
+			created, writeErr := writeOrderEvent(ctx, tx, eventID, model.OrderEventShowChanged, b, reason)
+			if writeErr != nil {
+				return writeErr
+			}
+			if created {
+				written++
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return written, nil
+}
+
+// seatIDsOrEmpty keeps a nil slice from being written as NULL into the NOT NULL seat_ids column.
+func seatIDsOrEmpty(seatIDs []string) []string {
+	if seatIDs == nil {
+		return []string{}
+	}
+	return seatIDs
+}
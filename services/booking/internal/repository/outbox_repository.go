@@ -0,0 +1,217 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	bookingv1 "github.com/wylu1037/go-micro-boilerplate/gen/go/booking/v1"
+	"github.com/wylu1037/go-micro-boilerplate/pkg/db"
+	"github.com/wylu1037/go-micro-boilerplate/services/booking/internal/model"
+)
+
+type OutboxRepository interface {
+	// Relay claims up to limit pending events that are due and calls publish for each of them. Published
+	// events are marked published; a failed event is retried after backoff(attempts), or marked dead once
+	// it has failed maxAttempts times. Order tasks are never marked dead: giving up on one would leave seats
+	// held or a refund unpaid, so they are retried for as long as they fail. Rows are locked with FOR UPDATE
+	// SKIP LOCKED, so concurrent replicas never publish the same event at the same time. It returns the claimed events with their new status.
+	Relay(ctx context.Context, limit, maxAttempts int, backoff func(attempts int) time.Duration, publish func(ctx context.Context, event *model.OutboxEvent) error) ([]*model.OutboxEvent, error)
+}
+
+type outboxRepository struct {
+	db *db.Pool
+}
+
+func NewOutboxRepository(db *db.Pool) OutboxRepository {
+	return &outboxRepository{db: db}
+}
+
+func (r *outboxRepository) Relay(ctx context.Context, limit, maxAttempts int, backoff func(attempts int) time.Duration, publish func(ctx context.Context, event *model.OutboxEvent) error) ([]*model.OutboxEvent, error) {
+	selectQuery := `
+		SELECT id, aggregate_id, event_type, payload, status, attempts, next_attempt_at, last_error, created_at, published_at
+		FROM booking.outbox
+		WHERE status = $1 AND next_attempt_at <= NOW()
+		ORDER BY created_at
+		LIMIT $2
+		FOR UPDATE SKIP LOCKED
+	`
+
+	publishedQuery := `
+		UPDATE booking.outbox
+		SET status = $1, attempts = attempts + 1, last_error = NULL, published_at = NOW()
+		WHERE id = $2
+		RETURNING attempts, published_at
+	`
+
+	failedQuery := `
+		UPDATE booking.outbox
+		SET status = $1, attempts = $2, next_attempt_at = $3, last_error = $4
+		WHERE id = $5
+	`
+
+	events := []*model.OutboxEvent{}
+	err := r.db.Transaction(ctx, func(tx pgx.Tx) error {
+		rows, queryErr := tx.Query(ctx, selectQuery, model.OutboxStatusPending, limit)
+		if queryErr != nil {
+			return queryErr
+		}
+
+		for rows.Next() {
+			e := &model.OutboxEvent{}
+			if scanErr := rows.Scan(
+				&e.ID,
+				&e.AggregateID,
+				&e.EventType,
+				&e.Payload,
+				&e.Status,
+				&e.Attempts,
+				&e.NextAttemptAt,
+				&e.LastError,
+				&e.CreatedAt,
+				&e.PublishedAt,
+			); scanErr != nil {
+				rows.Close()
+				return scanErr
+			}
+			events = append(events, e)
+		}
+		rows.Close()
+		if rowsErr := rows.Err(); rowsErr != nil {
+			return rowsErr
+		}
+
+		for _, e := range events {
+			publishErr := publish(ctx, e)
+			if publishErr == nil {
+				if updateErr := tx.QueryRow(ctx, publishedQuery, model.OutboxStatusPublished, e.ID).Scan(&e.Attempts, &e.PublishedAt); updateErr != nil {
+					return updateErr
+				}
+				e.Status = model.OutboxStatusPublished
+				e.LastError = nil
+				continue
+			}
+
+			e.Attempts++
+			lastError := publishErr.Error()
+			e.LastError = &lastError
+			if e.Attempts >= maxAttempts && !model.IsOrderTask(e.EventType) {
+				e.Status = model.OutboxStatusDead
+			} else {
+				e.NextAttemptAt = time.Now().Add(backoff(e.Attempts))
+			}
+			if _, updateErr := tx.Exec(ctx, failedQuery, e.Status, e.Attempts, e.NextAttemptAt, e.LastError, e.ID); updateErr != nil {
+				return updateErr
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return events, nil
+}
+
+// insertOrderEvent writes the event for the order having entered its current status into the outbox,
+// inside the transaction that changed it, so an event is published if and only if the change commits.
+// Statuses without an event are ignored, and so are all statuses of an order that was never announced,
+// since nobody was told about it. A status that gives the seats back also queues the
+// model.OrderTaskReleaseSeats task, so the catalog call is retried by the relay instead of running
+// inside this transaction.
+func insertOrderEvent(ctx context.Context, tx pgx.Tx, booking *model.Booking, change model.StatusChange) error {
+	if eventType := model.OrderEventType(booking.Status); eventType != "" {
+		announced, err := orderAnnounced(ctx, tx, booking.ID)
+		if err != nil {
+			return err
+		}
+		if announced {
+			if _, writeErr := writeOrderEvent(ctx, tx, uuid.NewString(), eventType, booking, change.Reason); writeErr != nil {
+				return writeErr
+			}
+		}
+	}
+
+	if booking.Status.ReleasesSeats() {
+		if _, err := writeOrderEvent(ctx, tx, uuid.NewString(), model.OrderTaskReleaseSeats, booking, change.Reason); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// orderCreatedEventID is the ID of the order created event, so an order is announced at most once.
+func orderCreatedEventID(orderID string) string {
+	return uuid.NewSHA1(uuid.NameSpaceURL, []byte(orderID+"/"+model.OrderEventCreated)).String()
+}
+
+// orderAnnounced reports whether the order created event of the order was written.
+func orderAnnounced(ctx context.Context, tx pgx.Tx, orderID string) (bool, error) {
+	query := `SELECT EXISTS (SELECT 1 FROM booking.outbox WHERE id = $1)`
+
+	var announced bool
+	err := tx.QueryRow(ctx, query, orderCreatedEventID(orderID)).Scan(&announced)
+	return announced, err
+}
+
+// writeOrderEvent writes an order event with the given ID into the outbox. It reports false, writing
+// nothing, if the outbox already has an event with the ID.
+func writeOrderEvent(ctx context.Context, tx pgx.Tx, eventID, eventType string, booking *model.Booking, reason string) (bool, error) {
+	query := `
+		INSERT INTO booking.outbox (id, aggregate_id, event_type, payload)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (id) DO NOTHING
+	`
+
+	event := &bookingv1.OrderEvent{
+		EventId:     eventID,
+		EventType:   eventType,
+		OccurredAt:  timestamppb.Now(),
+		BookingId:   booking.ID,
+		OrderNo:     booking.OrderNo,
+		UserId:      booking.UserID,
+		SessionId:   booking.SessionID,
+		SeatAreaId:  booking.SeatAreaID,
+		Quantity:    booking.Quantity,
+		SeatIds:     booking.SeatIDs,
+		TotalAmount: booking.TotalAmount.String(),
+		Status:      string(booking.Status),
+		Reason:      reason,
+	}
+	if booking.ExpiresAt != nil {
+		event.ExpiresAt = timestamppb.New(*booking.ExpiresAt)
+	}
+
+	payload, err := protojson.Marshal(event)
+	if err != nil {
+		return false, err
+	}
+
+	tag, err := tx.Exec(ctx, query, eventID, booking.ID, eventType, payload)
+	if err != nil {
+		return false, err
+	}
+	return tag.RowsAffected() > 0, nil
+}
+
+// writeOrderTask queues an order task with a JSON payload into the outbox; the relay runs it in-process.
+func writeOrderTask(ctx context.Context, tx pgx.Tx, taskID, taskType, orderID string, payload any) error {
+	query := `
+		INSERT INTO booking.outbox (id, aggregate_id, event_type, payload)
+		VALUES ($1, $2, $3, $4)
+	`
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(ctx, query, taskID, orderID, taskType, body)
+	return err
+}
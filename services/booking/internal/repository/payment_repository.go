@@ -0,0 +1,687 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/shopspring/decimal"
+
+	"github.com/wylu1037/go-micro-boilerplate/pkg/db"
+	"github.com/wylu1037/go-micro-boilerplate/services/booking/internal/model"
+)
+
+type PaymentRepository interface {
+	Create(ctx context.Context, payment *model.Payment) error
+	// UpdateStatus records the outcome of a payment attempt that did not (or no longer) pay for the order.
+	UpdateStatus(ctx context.Context, payment *model.Payment) error
+	// RecordRefund stores a (possibly partial) refund of payment.RefundedAmount and marks the payment refunded.
+	RecordRefund(ctx context.Context, payment *model.Payment) error
+	// Complete marks the payment successful and the order paid in one transaction. It reports false and
+	// changes nothing if the order is no longer pending payment, e.g. because it expired meanwhile.
+	Complete(ctx context.Context, payment *model.Payment, change model.StatusChange) (bool, error)
+	ListByOrderID(ctx context.Context, orderID string) ([]*model.Payment, error)
+	// RequestRefund moves the paid order to refund_pending and records the refund of amount on the payment.
+	// The same transaction queues the model.OrderTaskRefundPayment task that asks the provider for the money,
+	// so the provider is never called while the order is locked. It fails with model.ErrInvalidTransition
+	// if the order is not paid or the payment not successful, and returns nil if the order does not exist.
+	RequestRefund(ctx context.Context, payment *model.Payment, amount decimal.Decimal, change model.StatusChange) (*model.Booking, error)
+	// CompleteRefund records that the provider returned the money of a pending refund: the payment and the
+	// order become refunded, the order's valid tickets are voided and the release of its seats is queued, all
+	// in one transaction. An order that is no longer refund_pending is returned unchanged.
+	CompleteRefund(ctx context.Context, payment *model.Payment, change model.StatusChange) (*model.Booking, error)
+	// DeclineRefund records that the provider refused a pending refund: the order is paid again and the
+	// payment successful, with reason as its failure reason. An order that is no longer refund_pending is
+	// returned unchanged.
+	DeclineRefund(ctx context.Context, payment *model.Payment, reason string, change model.StatusChange) (*model.Booking, error)
+	// ApplyNotification applies an asynchronous payment result once per provider event; redelivered events
+	// are reported as duplicates and change nothing. A success pays the order if it is still pending, a
+	// failure fails the attempt and the pending order, queuing the release of its seats. It returns nil if
+	// the order does not exist.
+	ApplyNotification(ctx context.Context, n *model.PaymentNotification) (*model.PaymentNotificationResult, error)
+}
+
+type paymentRepository struct {
+	db *db.Pool
+}
+
+func NewPaymentRepository(db *db.Pool) PaymentRepository {
+	return &paymentRepository{db: db}
+}
+
+func (r *paymentRepository) Create(ctx context.Context, payment *model.Payment) error {
+	query := `
+		INSERT INTO booking.payments (order_id, payment_method, amount, status)
+		VALUES ($1, $2, $3, $4)
+		RETURNING id, created_at, updated_at
+	`
+
+	return r.db.QueryRow(ctx, query,
+		payment.OrderID,
+		payment.PaymentMethod,
+		payment.Amount,
+		payment.Status,
+	).Scan(&payment.ID, &payment.CreatedAt, &payment.UpdatedAt)
+}
+
+func (r *paymentRepository) UpdateStatus(ctx context.Context, payment *model.Payment) error {
+	query := `
+		UPDATE booking.payments
+		SET status = $1, transaction_id = $2, failure_reason = $3, updated_at = NOW()
+		WHERE id = $4
+		RETURNING updated_at
+	`
+
+	return r.db.QueryRow(ctx, query,
+		payment.Status,
+		payment.TransactionID,
+		payment.FailureReason,
+		payment.ID,
+	).Scan(&payment.UpdatedAt)
+}
+
+func (r *paymentRepository) RecordRefund(ctx context.Context, payment *model.Payment) error {
+	query := `
+		UPDATE booking.payments
+		SET status = $1, refunded_amount = $2, failure_reason = $3, refunded_at = NOW(), updated_at = NOW()
+		WHERE id = $4
+		RETURNING refunded_at, updated_at
+	`
+
+	err := r.db.QueryRow(ctx, query,
+		model.PaymentStatusRefunded,
+		payment.RefundedAmount,
+		payment.FailureReason,
+		payment.ID,
+	).Scan(&payment.RefundedAt, &payment.UpdatedAt)
+	if err != nil {
+		return err
+	}
+
+	payment.Status = model.PaymentStatusRefunded
+	return nil
+}
+
+func (r *paymentRepository) Complete(ctx context.Context, payment *model.Payment, change model.StatusChange) (bool, error) {
+	orderQuery := `
+		UPDATE booking.orders
+		SET status = $1, paid_at = NOW(), updated_at = NOW()
+		WHERE id = $2 AND status = $3
+		RETURNING id, order_no, user_id, session_id, seat_area_id, quantity, seat_ids, unit_price, total_amount, status,
+		          expires_at, paid_at, cancelled_at, created_at, updated_at
+	`
+
+	paymentQuery := `
+		UPDATE booking.payments
+		SET status = $1, transaction_id = $2, failure_reason = NULL, paid_at = $3, updated_at = NOW()
+		WHERE id = $4
+		RETURNING updated_at
+	`
+
+	completed := false
+	err := r.db.Transaction(ctx, func(tx pgx.Tx) error {
+		booking := &model.Booking{}
+		orderErr := tx.QueryRow(ctx, orderQuery, model.BookingStatusPaid, payment.OrderID, model.BookingStatusPendingPayment).Scan(
+			&booking.ID,
+			&booking.OrderNo,
+			&booking.UserID,
+			&booking.SessionID,
+			&booking.SeatAreaID,
+			&booking.Quantity,
+			&booking.SeatIDs,
+			&booking.UnitPrice,
+			&booking.TotalAmount,
+			&booking.Status,
+			&booking.ExpiresAt,
+			&booking.PaidAt,
+			&booking.CancelledAt,
+			&booking.CreatedAt,
+			&booking.UpdatedAt,
+		)
+		if errors.Is(orderErr, pgx.ErrNoRows) {
+			return nil
+		}
+		if orderErr != nil {
+			return orderErr
+		}
+
+		from := model.BookingStatusPendingPayment
+		if historyErr := insertStatusHistory(ctx, tx, payment.OrderID, &from, model.BookingStatusPaid, change); historyErr != nil {
+			return historyErr
+		}
+		if eventErr := insertOrderEvent(ctx, tx, booking, change); eventErr != nil {
+			return eventErr
+		}
+
+		paidAt := *booking.PaidAt
+
+		if scanErr := tx.QueryRow(ctx, paymentQuery,
+			model.PaymentStatusSuccess,
+			payment.TransactionID,
+			paidAt,
+			payment.ID,
+		).Scan(&payment.UpdatedAt); scanErr != nil {
+			return scanErr
+		}
+
+		payment.Status = model.PaymentStatusSuccess
+		payment.FailureReason = nil
+		payment.PaidAt = &paidAt
+		completed = true
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return completed, nil
+}
+
+func (r *paymentRepository) ListByOrderID(ctx context.Context, orderID string) ([]*model.Payment, error) {
+	query := `
+		SELECT id, order_id, payment_method, transaction_id, amount, status, failure_reason, paid_at, refunded_amount, refunded_at,
+		       created_at, updated_at
+		FROM booking.payments
+		WHERE order_id = $1
+		ORDER BY created_at
+	`
+
+	rows, err := r.db.Query(ctx, query, orderID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	payments := []*model.Payment{}
+	for rows.Next() {
+		p := &model.Payment{}
+		if scanErr := rows.Scan(
+			&p.ID,
+			&p.OrderID,
+			&p.PaymentMethod,
+			&p.TransactionID,
+			&p.Amount,
+			&p.Status,
+			&p.FailureReason,
+			&p.PaidAt,
+			&p.RefundedAmount,
+			&p.RefundedAt,
+			&p.CreatedAt,
+			&p.UpdatedAt,
+		); scanErr != nil {
+			return nil, scanErr
+		}
+		payments = append(payments, p)
+	}
+
+	return payments, rows.Err()
+}
+
+func (r *paymentRepository) RequestRefund(ctx context.Context, payment *model.Payment, amount decimal.Decimal, change model.StatusChange) (*model.Booking, error) {
+	paymentQuery := `
+		UPDATE booking.payments
+		SET status = $1, refunded_amount = $2, updated_at = NOW()
+		WHERE id = $3 AND order_id = $4 AND status = $5
+		RETURNING updated_at
+	`
+
+	var booking *model.Booking
+	err := r.db.Transaction(ctx, func(tx pgx.Tx) error {
+		b, lockErr := lockOrder(ctx, tx, payment.OrderID)
+		if lockErr != nil || b == nil {
+			return lockErr
+		}
+
+		if transitionErr := transitionOrder(ctx, tx, b, model.BookingStatusRefundPending, change); transitionErr != nil {
+			return transitionErr
+		}
+
+		var updatedAt time.Time
+		scanErr := tx.QueryRow(ctx, paymentQuery,
+			model.PaymentStatusRefundPending,
+			amount,
+			payment.ID,
+			payment.OrderID,
+			model.PaymentStatusSuccess,
+		).Scan(&updatedAt)
+		if errors.Is(scanErr, pgx.ErrNoRows) {
+			return model.ErrInvalidTransition
+		}
+		if scanErr != nil {
+			return scanErr
+		}
+
+		if taskErr := writeOrderTask(ctx, tx, uuid.NewString(), model.OrderTaskRefundPayment, b.ID, &model.RefundTask{PaymentID: payment.ID}); taskErr != nil {
+			return taskErr
+		}
+
+		payment.Status = model.PaymentStatusRefundPending
+		payment.RefundedAmount = amount
+		payment.UpdatedAt = updatedAt
+		booking = b
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return booking, nil
+}
+
+func (r *paymentRepository) CompleteRefund(ctx context.Context, payment *model.Payment, change model.StatusChange) (*model.Booking, error) {
+	paymentQuery := `
+		UPDATE booking.payments
+		SET status = $1, refunded_at = NOW(), updated_at = NOW()
+		WHERE id = $2 AND status = $3
+		RETURNING refunded_at, updated_at
+	`
+
+	var booking *model.Booking
+	err := r.db.Transaction(ctx, func(tx pgx.Tx) error {
+		b, lockErr := lockOrder(ctx, tx, payment.OrderID)
+		if lockErr != nil || b == nil {
+			return lockErr
+		}
+		booking = b
+		if b.Status != model.BookingStatusRefundPending {
+			return nil
+		}
+
+		if transitionErr := transitionOrder(ctx, tx, b, model.BookingStatusRefunded, change); transitionErr != nil {
+			return transitionErr
+		}
+		if eventErr := insertOrderEvent(ctx, tx, b, change); eventErr != nil {
+			return eventErr
+		}
+		if ticketErr := refundOrderTickets(ctx, tx, b.ID); ticketErr != nil {
+			return ticketErr
+		}
+
+		scanErr := tx.QueryRow(ctx, paymentQuery,
+			model.PaymentStatusRefunded,
+			payment.ID,
+			model.PaymentStatusRefundPending,
+		).Scan(&payment.RefundedAt, &payment.UpdatedAt)
+		if errors.Is(scanErr, pgx.ErrNoRows) {
+			return model.ErrInvalidTransition
+		}
+		if scanErr != nil {
+			return scanErr
+		}
+
+		payment.Status = model.PaymentStatusRefunded
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return booking, nil
+}
+
+func (r *paymentRepository) DeclineRefund(ctx context.Context, payment *model.Payment, reason string, change model.StatusChange) (*model.Booking, error) {
+	paymentQuery := `
+		UPDATE booking.payments
+		SET status = $1, refunded_amount = 0, failure_reason = $2, updated_at = NOW()
+		WHERE id = $3 AND status = $4
+		RETURNING updated_at
+	`
+
+	var booking *model.Booking
+	err := r.db.Transaction(ctx, func(tx pgx.Tx) error {
+		b, lockErr := lockOrder(ctx, tx, payment.OrderID)
+		if lockErr != nil || b == nil {
+			return lockErr
+		}
+		booking = b
+		if b.Status != model.BookingStatusRefundPending {
+			return nil
+		}
+
+		// No order event: the order is paid again, which its holder was already told about
+		if transitionErr := transitionOrder(ctx, tx, b, model.BookingStatusPaid, change); transitionErr != nil {
+			return transitionErr
+		}
+
+		scanErr := tx.QueryRow(ctx, paymentQuery,
+			model.PaymentStatusSuccess,
+			reason,
+			payment.ID,
+			model.PaymentStatusRefundPending,
+		).Scan(&payment.UpdatedAt)
+		if errors.Is(scanErr, pgx.ErrNoRows) {
+			return model.ErrInvalidTransition
+		}
+		if scanErr != nil {
+			return scanErr
+		}
+
+		payment.Status = model.PaymentStatusSuccess
+		payment.RefundedAmount = decimal.Zero
+		payment.FailureReason = &reason
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return booking, nil
+}
+
+// lockOrder selects an order FOR UPDATE; it returns nil if the order does not exist.
+func lockOrder(ctx context.Context, tx pgx.Tx, id string) (*model.Booking, error) {
+	query := `
+		SELECT id, order_no, user_id, session_id, seat_area_id, quantity, seat_ids, unit_price, total_amount, status,
+		       expires_at, paid_at, cancelled_at, created_at, updated_at
+		FROM booking.orders
+		WHERE id = $1
+		FOR UPDATE
+	`
+
+	b := &model.Booking{}
+	err := tx.QueryRow(ctx, query, id).Scan(
+		&b.ID,
+		&b.OrderNo,
+		&b.UserID,
+		&b.SessionID,
+		&b.SeatAreaID,
+		&b.Quantity,
+		&b.SeatIDs,
+		&b.UnitPrice,
+		&b.TotalAmount,
+		&b.Status,
+		&b.ExpiresAt,
+		&b.PaidAt,
+		&b.CancelledAt,
+		&b.CreatedAt,
+		&b.UpdatedAt,
+	)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// transitionOrder moves a locked order to status to and records the change in the status history. Writing
+// the order event is left to the caller.
+func transitionOrder(ctx context.Context, tx pgx.Tx, b *model.Booking, to model.BookingStatus, change model.StatusChange) error {
+	query := `
+		UPDATE booking.orders
+		SET status = $1, updated_at = NOW()
+		WHERE id = $2
+		RETURNING updated_at
+	`
+
+	if !b.Status.CanTransitionTo(to) {
+		return model.ErrInvalidTransition
+	}
+	if err := tx.QueryRow(ctx, query, to, b.ID).Scan(&b.UpdatedAt); err != nil {
+		return err
+	}
+	if err := insertStatusHistory(ctx, tx, b.ID, &b.Status, to, change); err != nil {
+		return err
+	}
+
+	b.Status = to
+	return nil
+}
+
+func (r *paymentRepository) ApplyNotification(ctx context.Context, n *model.PaymentNotification) (*model.PaymentNotificationResult, error) {
+	orderQuery := `
+		SELECT id, order_no, user_id, session_id, seat_area_id, quantity, seat_ids, unit_price, total_amount, status,
+		       expires_at, paid_at, cancelled_at, created_at, updated_at
+		FROM booking.orders
+		WHERE id = $1
+		FOR UPDATE
+	`
+
+	eventQuery := `
+		INSERT INTO booking.payment_events (provider, event_id, order_id, status)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (provider, event_id) DO NOTHING
+		RETURNING id
+	`
+
+	eventPaymentQuery := `
+		UPDATE booking.payment_events
+		SET payment_id = $1
+		WHERE id = $2
+	`
+
+	result := &model.PaymentNotificationResult{}
+	err := r.db.Transaction(ctx, func(tx pgx.Tx) error {
+		booking := &model.Booking{}
+		if scanErr := tx.QueryRow(ctx, orderQuery, n.OrderID).Scan(
+			&booking.ID,
+			&booking.OrderNo,
+			&booking.UserID,
+			&booking.SessionID,
+			&booking.SeatAreaID,
+			&booking.Quantity,
+			&booking.SeatIDs,
+			&booking.UnitPrice,
+			&booking.TotalAmount,
+			&booking.Status,
+			&booking.ExpiresAt,
+			&booking.PaidAt,
+			&booking.CancelledAt,
+			&booking.CreatedAt,
+			&booking.UpdatedAt,
+		); scanErr != nil {
+			if errors.Is(scanErr, pgx.ErrNoRows) {
+				return nil
+			}
+			return scanErr
+		}
+		result.Booking = booking
+
+		var eventID string
+		eventErr := tx.QueryRow(ctx, eventQuery, n.Provider, n.EventID, n.OrderID, n.Status).Scan(&eventID)
+		if errors.Is(eventErr, pgx.ErrNoRows) {
+			result.Duplicate = true
+			return nil
+		}
+		if eventErr != nil {
+			return eventErr
+		}
+
+		payment, findErr := r.findNotifiedPayment(ctx, tx, n)
+		if findErr != nil {
+			return findErr
+		}
+		result.Payment = payment
+
+		switch n.Status {
+		case model.PaymentEventSucceeded:
+			orphaned, payErr := r.applySucceeded(ctx, tx, booking, payment, n)
+			if payErr != nil {
+				return payErr
+			}
+			result.Orphaned = orphaned
+		case model.PaymentEventFailed:
+			if failErr := r.applyFailed(ctx, tx, booking, payment, n); failErr != nil {
+				return failErr
+			}
+		}
+
+		_, updateErr := tx.Exec(ctx, eventPaymentQuery, payment.ID, eventID)
+		return updateErr
+	})
+	if err != nil {
+		return nil, err
+	}
+	if result.Booking == nil {
+		return nil, nil
+	}
+
+	return result, nil
+}
+
+// findNotifiedPayment locks the attempt the notification is about, matched by our payment ID or the
+// provider's transaction ID. Payments started on the provider side have no attempt yet; one is created.
+func (r *paymentRepository) findNotifiedPayment(ctx context.Context, tx pgx.Tx, n *model.PaymentNotification) (*model.Payment, error) {
+	selectQuery := `
+		SELECT id, order_id, payment_method, transaction_id, amount, status, failure_reason, paid_at, refunded_amount, refunded_at,
+		       created_at, updated_at
+		FROM booking.payments
+		WHERE order_id = $1 AND (id = $2::uuid OR transaction_id = $3)
+		ORDER BY created_at DESC
+		LIMIT 1
+		FOR UPDATE
+	`
+
+	insertQuery := `
+		INSERT INTO booking.payments (order_id, payment_method, transaction_id, amount, status)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING id, created_at, updated_at
+	`
+
+	var paymentID *string
+	if n.PaymentID != "" {
+		paymentID = &n.PaymentID
+	}
+
+	p := &model.Payment{}
+	err := tx.QueryRow(ctx, selectQuery, n.OrderID, paymentID, n.TransactionID).Scan(
+		&p.ID,
+		&p.OrderID,
+		&p.PaymentMethod,
+		&p.TransactionID,
+		&p.Amount,
+		&p.Status,
+		&p.FailureReason,
+		&p.PaidAt,
+		&p.RefundedAmount,
+		&p.RefundedAt,
+		&p.CreatedAt,
+		&p.UpdatedAt,
+	)
+	if err == nil {
+		return p, nil
+	}
+	if !errors.Is(err, pgx.ErrNoRows) {
+		return nil, err
+	}
+
+	transactionID := n.TransactionID
+	p = &model.Payment{
+		OrderID:       n.OrderID,
+		PaymentMethod: n.Provider,
+		TransactionID: &transactionID,
+		Amount:        n.Amount,
+		Status:        model.PaymentStatusPending,
+	}
+	if scanErr := tx.QueryRow(ctx, insertQuery,
+		p.OrderID,
+		p.PaymentMethod,
+		p.TransactionID,
+		p.Amount,
+		p.Status,
+	).Scan(&p.ID, &p.CreatedAt, &p.UpdatedAt); scanErr != nil {
+		return nil, scanErr
+	}
+
+	return p, nil
+}
+
+// applySucceeded marks the attempt successful and pays the order if it is still pending. It reports
+// whether the captured money could not be applied to the order, e.g. because the order expired.
+func (r *paymentRepository) applySucceeded(ctx context.Context, tx pgx.Tx, booking *model.Booking, payment *model.Payment, n *model.PaymentNotification) (bool, error) {
+	if payment.Status == model.PaymentStatusSuccess {
+		return false, nil // Already completed, e.g. by the synchronous ProcessPayment path
+	}
+
+	orderQuery := `
+		UPDATE booking.orders
+		SET status = $1, paid_at = NOW(), updated_at = NOW()
+		WHERE id = $2
+		RETURNING paid_at, updated_at
+	`
+
+	orphaned := !booking.Status.CanTransitionTo(model.BookingStatusPaid)
+	paidAt := time.Now()
+	if !orphaned {
+		if scanErr := tx.QueryRow(ctx, orderQuery, model.BookingStatusPaid, booking.ID).Scan(&paidAt, &booking.UpdatedAt); scanErr != nil {
+			return false, scanErr
+		}
+		if historyErr := insertStatusHistory(ctx, tx, booking.ID, &booking.Status, model.BookingStatusPaid, notificationChange(n)); historyErr != nil {
+			return false, historyErr
+		}
+		booking.Status = model.BookingStatusPaid
+		booking.PaidAt = &paidAt
+		if eventErr := insertOrderEvent(ctx, tx, booking, notificationChange(n)); eventErr != nil {
+			return false, eventErr
+		}
+	}
+
+	payment.Status = model.PaymentStatusSuccess
+	payment.TransactionID = &n.TransactionID
+	payment.FailureReason = nil
+	payment.PaidAt = &paidAt
+	return orphaned, r.updatePayment(ctx, tx, payment)
+}
+
+// applyFailed marks the attempt failed and fails the order if it is still pending, which queues the release
+// of its seats. A failure reported for an attempt that already succeeded is ignored.
+func (r *paymentRepository) applyFailed(ctx context.Context, tx pgx.Tx, booking *model.Booking, payment *model.Payment, n *model.PaymentNotification) error {
+	if payment.Status == model.PaymentStatusSuccess {
+		return nil
+	}
+
+	orderQuery := `
+		UPDATE booking.orders
+		SET status = $1, cancelled_at = NOW(), updated_at = NOW()
+		WHERE id = $2
+		RETURNING cancelled_at, updated_at
+	`
+
+	if booking.Status.CanTransitionTo(model.BookingStatusFailed) {
+		if scanErr := tx.QueryRow(ctx, orderQuery, model.BookingStatusFailed, booking.ID).Scan(&booking.CancelledAt, &booking.UpdatedAt); scanErr != nil {
+			return scanErr
+		}
+		if historyErr := insertStatusHistory(ctx, tx, booking.ID, &booking.Status, model.BookingStatusFailed, notificationChange(n)); historyErr != nil {
+			return historyErr
+		}
+		booking.Status = model.BookingStatusFailed
+		if eventErr := insertOrderEvent(ctx, tx, booking, notificationChange(n)); eventErr != nil {
+			return eventErr
+		}
+	}
+
+	payment.Status = model.PaymentStatusFailed
+	payment.TransactionID = &n.TransactionID
+	if n.FailureReason != "" {
+		payment.FailureReason = &n.FailureReason
+	}
+	return r.updatePayment(ctx, tx, payment)
+}
+
+func notificationChange(n *model.PaymentNotification) model.StatusChange {
+	return model.StatusChange{
+		ActorType: model.StatusActorProvider,
+		ActorID:   n.Provider,
+		Reason:    "payment event " + n.EventID,
+	}
+}
+
+func (r *paymentRepository) updatePayment(ctx context.Context, tx pgx.Tx, payment *model.Payment) error {
+	query := `
+		UPDATE booking.payments
+		SET status = $1, transaction_id = $2, failure_reason = $3, paid_at = $4, updated_at = NOW()
+		WHERE id = $5
+		RETURNING updated_at
+	`
+
+	return tx.QueryRow(ctx, query,
+		payment.Status,
+		payment.TransactionID,
+		payment.FailureReason,
+		payment.PaidAt,
+		payment.ID,
+	).Scan(&payment.UpdatedAt)
+}
@@ -0,0 +1,152 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/wylu1037/go-micro-boilerplate/pkg/db"
+	"github.com/wylu1037/go-micro-boilerplate/services/booking/internal/model"
+)
+
+type SagaRepository interface {
+	Create(ctx context.Context, saga *model.Saga) error
+	Update(ctx context.Context, id string, status model.SagaStatus, payload []byte) error
+	SaveStep(ctx context.Context, step *model.SagaStep) error
+	ListSteps(ctx context.Context, sagaID string) ([]*model.SagaStep, error)
+	ClaimStale(ctx context.Context, name string, before time.Time, limit int) ([]*model.Saga, error)
+}
+
+type sagaRepository struct {
+	db *db.Pool
+}
+
+func NewSagaRepository(db *db.Pool) SagaRepository {
+	return &sagaRepository{db: db}
+}
+
+func (r *sagaRepository) Create(ctx context.Context, saga *model.Saga) error {
+	query := `
+		INSERT INTO booking.sagas (name, status, payload)
+		VALUES ($1, $2, $3)
+		RETURNING id, created_at, updated_at
+	`
+
+	return r.db.QueryRow(ctx, query,
+		saga.Name,
+		saga.Status,
+		saga.Payload,
+	).Scan(&saga.ID, &saga.CreatedAt, &saga.UpdatedAt)
+}
+
+func (r *sagaRepository) Update(ctx context.Context, id string, status model.SagaStatus, payload []byte) error {
+	query := `
+		UPDATE booking.sagas
+		SET status = $1, payload = $2, updated_at = NOW()
+		WHERE id = $3
+	`
+	_, err := r.db.Exec(ctx, query, status, payload, id)
+	return err
+}
+
+// SaveStep inserts the step record or overwrites the status of an existing one.
+func (r *sagaRepository) SaveStep(ctx context.Context, step *model.SagaStep) error {
+	query := `
+		INSERT INTO booking.saga_steps (saga_id, step_index, step_name, status, error_message)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (saga_id, step_index)
+		DO UPDATE SET status = EXCLUDED.status, error_message = EXCLUDED.error_message, updated_at = NOW()
+		RETURNING id, created_at, updated_at
+	`
+
+	return r.db.QueryRow(ctx, query,
+		step.SagaID,
+		step.StepIndex,
+		step.StepName,
+		step.Status,
+		step.ErrorMessage,
+	).Scan(&step.ID, &step.CreatedAt, &step.UpdatedAt)
+}
+
+func (r *sagaRepository) ListSteps(ctx context.Context, sagaID string) ([]*model.SagaStep, error) {
+	query := `
+		SELECT id, saga_id, step_index, step_name, status, error_message, created_at, updated_at
+		FROM booking.saga_steps
+		WHERE saga_id = $1
+		ORDER BY step_index
+	`
+
+	rows, err := r.db.Query(ctx, query, sagaID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	steps := []*model.SagaStep{}
+	for rows.Next() {
+		step := &model.SagaStep{}
+		if scanErr := rows.Scan(
+			&step.ID,
+			&step.SagaID,
+			&step.StepIndex,
+			&step.StepName,
+			&step.Status,
+			&step.ErrorMessage,
+			&step.CreatedAt,
+			&step.UpdatedAt,
+		); scanErr != nil {
+			return nil, scanErr
+		}
+		steps = append(steps, step)
+	}
+
+	return steps, rows.Err()
+}
+
+// ClaimStale returns up to limit unfinished or failed sagas of the given name that have not been touched since before.
+// Claimed sagas get their updated_at bumped in the same statement, so other replicas will not pick them up
+// until they become stale again.
+func (r *sagaRepository) ClaimStale(ctx context.Context, name string, before time.Time, limit int) ([]*model.Saga, error) {
+	query := `
+		UPDATE booking.sagas
+		SET updated_at = NOW()
+		WHERE id IN (
+			SELECT id FROM booking.sagas
+			WHERE name = $1 AND status IN ($2, $3, $4) AND updated_at < $5
+			ORDER BY updated_at
+			LIMIT $6
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING id, name, status, payload, created_at, updated_at
+	`
+
+	rows, err := r.db.Query(ctx, query,
+		name,
+		model.SagaStatusRunning,
+		model.SagaStatusCompensating,
+		model.SagaStatusFailed,
+		before,
+		limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	sagas := []*model.Saga{}
+	for rows.Next() {
+		saga := &model.Saga{}
+		if scanErr := rows.Scan(
+			&saga.ID,
+			&saga.Name,
+			&saga.Status,
+			&saga.Payload,
+			&saga.CreatedAt,
+			&saga.UpdatedAt,
+		); scanErr != nil {
+			return nil, scanErr
+		}
+		sagas = append(sagas, saga)
+	}
+
+	return sagas, rows.Err()
+}
@@ -0,0 +1,21 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/wylu1037/go-micro-boilerplate/services/booking/internal/model"
+)
+
+// insertStatusHistory records a status change of an order inside the transaction that makes it,
+// so the history can never disagree with booking.orders.
+func insertStatusHistory(ctx context.Context, tx pgx.Tx, orderID string, from *model.BookingStatus, to model.BookingStatus, change model.StatusChange) error {
+	query := `
+		INSERT INTO booking.order_status_history (order_id, from_status, to_status, actor_type, actor_id, reason)
+		VALUES ($1, $2, $3, $4, $5, $6)
+	`
+
+	_, err := tx.Exec(ctx, query, orderID, from, to, change.ActorType, change.ActorID, change.Reason)
+	return err
+}
@@ -0,0 +1,228 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/wylu1037/go-micro-boilerplate/pkg/db"
+	"github.com/wylu1037/go-micro-boilerplate/services/booking/internal/model"
+)
+
+const ticketColumns = `id, order_id, session_id, seat_id, ticket_no, qr_code, status, used_at, gate_id, checked_in_by, created_at, updated_at`
+
+type TicketRepository interface {
+	// CreateBatch inserts one order's tickets, skipping any whose ticket number already exists, so issuing
+	// the same order's tickets again is a no-op. Nothing is inserted once the order is no longer paid.
+	CreateBatch(ctx context.Context, tickets []*model.Ticket) error
+	GetByID(ctx context.Context, id string) (*model.Ticket, error)
+	ListByOrderID(ctx context.Context, orderID string) ([]*model.Ticket, error)
+	ListByUserID(ctx context.Context, userID string, page, pageSize int) ([]*model.Ticket, int64, error)
+	// MarkUsed checks a valid ticket in and reports false, changing nothing, if it is not valid any more,
+	// so two gates scanning the same ticket cannot both accept it.
+	MarkUsed(ctx context.Context, ticket *model.Ticket, usedAt time.Time, gateID, checkedInBy string) (bool, error)
+	// ListPaidOrdersWithoutTickets returns up to limit IDs of paid orders that have no tickets yet.
+	ListPaidOrdersWithoutTickets(ctx context.Context, limit int) ([]string, error)
+}
+
+type ticketRepository struct {
+	db *db.Pool
+}
+
+func NewTicketRepository(db *db.Pool) TicketRepository {
+	return &ticketRepository{db: db}
+}
+
+func (r *ticketRepository) CreateBatch(ctx context.Context, tickets []*model.Ticket) error {
+	query := `
+		INSERT INTO booking.tickets (id, order_id, session_id, seat_id, ticket_no, qr_code, status)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		ON CONFLICT (ticket_no) DO NOTHING
+	`
+
+	if len(tickets) == 0 {
+		return nil
+	}
+
+	// Locking the order serializes issuance with a refund, which voids the tickets in its own transaction
+	statusQuery := `SELECT status FROM booking.orders WHERE id = $1 FOR SHARE`
+
+	return r.db.Transaction(ctx, func(tx pgx.Tx) error {
+		var status model.BookingStatus
+		scanErr := tx.QueryRow(ctx, statusQuery, tickets[0].OrderID).Scan(&status)
+		if errors.Is(scanErr, pgx.ErrNoRows) {
+			return nil
+		}
+		if scanErr != nil {
+			return scanErr
+		}
+		if status != model.BookingStatusPaid {
+			return nil
+		}
+
+		for _, t := range tickets {
+			if _, execErr := tx.Exec(ctx, query,
+				t.ID,
+				t.OrderID,
+				t.SessionID,
+				t.SeatID,
+				t.TicketNo,
+				t.QRCode,
+				t.Status,
+			); execErr != nil {
+				return execErr
+			}
+		}
+		return nil
+	})
+}
+
+func (r *ticketRepository) GetByID(ctx context.Context, id string) (*model.Ticket, error) {
+	query := `SELECT ` + ticketColumns + ` FROM booking.tickets WHERE id = $1`
+
+	t, err := scanTicket(r.db.QueryRow(ctx, query, id))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+func (r *ticketRepository) ListByOrderID(ctx context.Context, orderID string) ([]*model.Ticket, error) {
+	query := `SELECT ` + ticketColumns + ` FROM booking.tickets WHERE order_id = $1 ORDER BY ticket_no`
+
+	rows, err := r.db.Query(ctx, query, orderID)
+	if err != nil {
+		return nil, err
+	}
+	return collectTickets(rows)
+}
+
+func (r *ticketRepository) ListByUserID(ctx context.Context, userID string, page, pageSize int) ([]*model.Ticket, int64, error) {
+	countQuery := `
+		SELECT COUNT(*)
+		FROM booking.tickets t
+		JOIN booking.orders o ON o.id = t.order_id
+		WHERE o.user_id = $1
+	`
+
+	listQuery := `
+		SELECT t.id, t.order_id, t.session_id, t.seat_id, t.ticket_no, t.qr_code, t.status, t.used_at, t.gate_id, t.checked_in_by,
+		       t.created_at, t.updated_at
+		FROM booking.tickets t
+		JOIN booking.orders o ON o.id = t.order_id
+		WHERE o.user_id = $1
+		ORDER BY t.created_at DESC, t.ticket_no
+		LIMIT $2 OFFSET $3
+	`
+
+	var total int64
+	if err := r.db.QueryRow(ctx, countQuery, userID).Scan(&total); err != nil {
+		return nil, 0, err
+	}
+
+	rows, err := r.db.Query(ctx, listQuery, userID, pageSize, (page-1)*pageSize)
+	if err != nil {
+		return nil, 0, err
+	}
+	tickets, err := collectTickets(rows)
+	if err != nil {
+		return nil, 0, err
+	}
+	return tickets, total, nil
+}
+
+func (r *ticketRepository) MarkUsed(ctx context.Context, ticket *model.Ticket, usedAt time.Time, gateID, checkedInBy string) (bool, error) {
+	query := `
+		UPDATE booking.tickets
+		SET status = $1, used_at = $2, gate_id = $3, checked_in_by = $4, updated_at = NOW()
+		WHERE id = $5 AND status = $6
+		RETURNING used_at, updated_at
+	`
+
+	err := r.db.QueryRow(ctx, query,
+		model.TicketStatusUsed,
+		usedAt,
+		gateID,
+		checkedInBy,
+		ticket.ID,
+		model.TicketStatusValid,
+	).Scan(&ticket.UsedAt, &ticket.UpdatedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	ticket.Status = model.TicketStatusUsed
+	ticket.GateID = &gateID
+	ticket.CheckedInBy = &checkedInBy
+	return true, nil
+}
+
+func (r *ticketRepository) ListPaidOrdersWithoutTickets(ctx context.Context, limit int) ([]string, error) {
+	query := `
+		SELECT o.id
+		FROM booking.orders o
+		WHERE o.status = $1
+		  AND NOT EXISTS (SELECT 1 FROM booking.tickets t WHERE t.order_id = o.id)
+		ORDER BY o.paid_at
+		LIMIT $2
+	`
+
+	rows, err := r.db.Query(ctx, query, model.BookingStatusPaid, limit)
+	if err != nil {
+		return nil, err
+	}
+	return pgx.CollectRows(rows, pgx.RowTo[string])
+}
+
+func scanTicket(row pgx.Row) (*model.Ticket, error) {
+	t := &model.Ticket{}
+	err := row.Scan(
+		&t.ID,
+		&t.OrderID,
+		&t.SessionID,
+		&t.SeatID,
+		&t.TicketNo,
+		&t.QRCode,
+		&t.Status,
+		&t.UsedAt,
+		&t.GateID,
+		&t.CheckedInBy,
+		&t.CreatedAt,
+		&t.UpdatedAt,
+	)
+	return t, err
+}
+
+func collectTickets(rows pgx.Rows) ([]*model.Ticket, error) {
+	defer rows.Close()
+
+	tickets := []*model.Ticket{}
+	for rows.Next() {
+		t, scanErr := scanTicket(rows)
+		if scanErr != nil {
+			return nil, scanErr
+		}
+		tickets = append(tickets, t)
+	}
+	return tickets, rows.Err()
+}
+
+// refundOrderTickets marks the order's valid tickets refunded inside the refund transaction.
+func refundOrderTickets(ctx context.Context, tx pgx.Tx, orderID string) error {
+	query := `
+		UPDATE booking.tickets
+		SET status = $1, updated_at = NOW()
+		WHERE order_id = $2 AND status = $3
+	`
+
+	_, err := tx.Exec(ctx, query, model.TicketStatusRefunded, orderID, model.TicketStatusValid)
+	return err
+}
@@ -1,17 +1,23 @@
 package router
 
 import (
-	bookingv1 "github.com/wylu1037/go-micro-boilerplate/gen/go/booking/v1"
 	"go-micro.dev/v4"
+	"go-micro.dev/v4/server"
+
+	bookingv1 "github.com/wylu1037/go-micro-boilerplate/gen/go/booking/v1"
+	"github.com/wylu1037/go-micro-boilerplate/services/booking/internal/model"
+	"github.com/wylu1037/go-micro-boilerplate/services/booking/internal/subscriber"
 )
 
 func NewRouter(
 	service micro.Service,
 	handler bookingv1.BookingServiceHandler,
+	showEvents *subscriber.ShowEventSubscriber,
 ) Router {
 	return &router{
 		microService: service,
 		handler:      handler,
+		showEvents:   showEvents,
 	}
 }
 
@@ -22,8 +28,13 @@ type Router interface {
 type router struct {
 	microService micro.Service
 	handler      bookingv1.BookingServiceHandler
+	showEvents   *subscriber.ShowEventSubscriber
 }
 
 func (r *router) Register() {
 	bookingv1.RegisterBookingServiceHandler(r.microService.Server(), r.handler)
+
+	// Replicas share a queue, so each event is handled by one of them.
+	queue := server.SubscriberQueue(r.microService.Server().Options().Name)
+	micro.RegisterSubscriber(model.ShowEventChanged, r.microService.Server(), r.showEvents.Handle, queue)
 }
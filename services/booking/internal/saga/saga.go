@@ -0,0 +1,243 @@
+// Package saga implements a small orchestration-based saga engine backed by the booking.sagas
+// and booking.saga_steps tables. Every step transition is persisted before moving on, so a saga
+// interrupted by a crash can be resumed or compensated by Recover.
+package saga
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/wylu1037/go-micro-boilerplate/services/booking/internal/model"
+	"github.com/wylu1037/go-micro-boilerplate/services/booking/internal/repository"
+)
+
+// Step is a single unit of work. Compensate undoes Action and may be nil for read-only steps.
+// Compensations also run for a step whose Action failed or was interrupted mid-flight (a timeout
+// can hide a remote change that did commit), so they must be idempotent and tolerate the Action
+// never having taken effect.
+type Step[T any] struct {
+	Name       string
+	Action     func(ctx context.Context, data *T) error
+	Compensate func(ctx context.Context, data *T) error
+}
+
+// Definition describes a saga. Pivot is the index of the last step that can still be undone:
+// a failure up to and including the pivot compensates the saga, while steps after the pivot
+// are only ever retried forward.
+type Definition[T any] struct {
+	Name  string
+	Steps []Step[T]
+	Pivot int
+}
+
+type Orchestrator[T any] struct {
+	def    Definition[T]
+	repo   repository.SagaRepository
+	logger *zap.Logger
+}
+
+func New[T any](def Definition[T], repo repository.SagaRepository, logger *zap.Logger) *Orchestrator[T] {
+	return &Orchestrator[T]{
+		def:    def,
+		repo:   repo,
+		logger: logger.With(zap.String("saga", def.Name)),
+	}
+}
+
+// Execute persists a new saga for data and runs all of its steps. If a step up to the pivot fails,
+// the completed steps are compensated in reverse order and the step error is returned.
+// A failure after the pivot leaves the saga running for Recover to retry and is not returned.
+func (o *Orchestrator[T]) Execute(ctx context.Context, data *T) error {
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return fmt.Errorf("failed to encode saga payload: %w", err)
+	}
+
+	s := &model.Saga{
+		Name:    o.def.Name,
+		Status:  model.SagaStatusRunning,
+		Payload: payload,
+	}
+	if createErr := o.repo.Create(ctx, s); createErr != nil {
+		return fmt.Errorf("failed to create saga: %w", createErr)
+	}
+
+	return o.forward(ctx, s, data, make(map[int]model.SagaStepStatus, len(o.def.Steps)), 0)
+}
+
+// Recover resumes or compensates up to limit sagas that have not been touched for staleAfter,
+// which usually means the process running them crashed. Failed sagas, whose compensation errored,
+// are compensated again on every pass until it succeeds. It returns the number of sagas handled.
+func (o *Orchestrator[T]) Recover(ctx context.Context, staleAfter time.Duration, limit int) (int, error) {
+	sagas, err := o.repo.ClaimStale(ctx, o.def.Name, time.Now().Add(-staleAfter), limit)
+	if err != nil {
+		return 0, fmt.Errorf("failed to claim stale sagas: %w", err)
+	}
+
+	for _, s := range sagas {
+		if recoverErr := o.recoverOne(ctx, s); recoverErr != nil {
+			o.logger.Error("failed to recover saga", zap.String("saga_id", s.ID), zap.Error(recoverErr))
+		}
+	}
+
+	return len(sagas), nil
+}
+
+func (o *Orchestrator[T]) recoverOne(ctx context.Context, s *model.Saga) error {
+	data := new(T)
+	if err := json.Unmarshal(s.Payload, data); err != nil {
+		return fmt.Errorf("failed to decode saga payload: %w", err)
+	}
+
+	steps, err := o.repo.ListSteps(ctx, s.ID)
+	if err != nil {
+		return fmt.Errorf("failed to list saga steps: %w", err)
+	}
+
+	statuses := make(map[int]model.SagaStepStatus, len(steps))
+	for _, step := range steps {
+		statuses[step.StepIndex] = step.Status
+	}
+
+	if s.Status == model.SagaStatusRunning && o.pivotCompleted(statuses) {
+		next := 0
+		for next < len(o.def.Steps) && statuses[next] == model.SagaStepStatusCompleted {
+			next++
+		}
+		o.logger.Info("Resuming saga", zap.String("saga_id", s.ID), zap.Int("step_index", next))
+		return o.forward(ctx, s, data, statuses, next)
+	}
+
+	if s.Status == model.SagaStatusFailed {
+		o.logger.Warn("Retrying failed saga compensation", zap.String("saga_id", s.ID))
+	} else {
+		o.logger.Info("Compensating interrupted saga", zap.String("saga_id", s.ID))
+	}
+	return o.compensate(ctx, s, data, statuses)
+}
+
+func (o *Orchestrator[T]) pivotCompleted(statuses map[int]model.SagaStepStatus) bool {
+	for i := 0; i <= o.def.Pivot && i < len(o.def.Steps); i++ {
+		if statuses[i] != model.SagaStepStatusCompleted {
+			return false
+		}
+	}
+	return true
+}
+
+func (o *Orchestrator[T]) forward(ctx context.Context, s *model.Saga, data *T, statuses map[int]model.SagaStepStatus, from int) error {
+	for i := from; i < len(o.def.Steps); i++ {
+		step := o.def.Steps[i]
+
+		if err := o.saveStep(ctx, s, i, model.SagaStepStatusStarted, nil); err != nil {
+			return err
+		}
+		statuses[i] = model.SagaStepStatusStarted
+
+		if actionErr := step.Action(ctx, data); actionErr != nil {
+			statuses[i] = model.SagaStepStatusFailed
+			if err := o.saveStep(ctx, s, i, model.SagaStepStatusFailed, actionErr); err != nil {
+				o.logger.Error("failed to record saga step failure", zap.String("saga_id", s.ID), zap.Error(err))
+			}
+
+			if i > o.def.Pivot {
+				// Past the point of no return: leave the saga running so Recover retries this step.
+				o.logger.Warn("saga step failed after pivot, will retry",
+					zap.String("saga_id", s.ID),
+					zap.String("step", step.Name),
+					zap.Error(actionErr),
+				)
+				return nil
+			}
+
+			if err := o.compensate(ctx, s, data, statuses); err != nil {
+				o.logger.Error("saga compensation failed", zap.String("saga_id", s.ID), zap.Error(err))
+			}
+			return actionErr
+		}
+
+		statuses[i] = model.SagaStepStatusCompleted
+		if err := o.saveStep(ctx, s, i, model.SagaStepStatusCompleted, nil); err != nil {
+			return err
+		}
+		// Persist the payload after every step so that values produced by it survive a crash.
+		if err := o.updateSaga(ctx, s, model.SagaStatusRunning, data); err != nil {
+			return err
+		}
+	}
+
+	return o.updateSaga(ctx, s, model.SagaStatusCompleted, data)
+}
+
+// compensate undoes every step that was started, in reverse order. That includes the step whose Action
+// reported an error, since the error does not prove the Action had no effect.
+func (o *Orchestrator[T]) compensate(ctx context.Context, s *model.Saga, data *T, statuses map[int]model.SagaStepStatus) error {
+	if err := o.updateSaga(ctx, s, model.SagaStatusCompensating, data); err != nil {
+		return err
+	}
+
+	for i := len(o.def.Steps) - 1; i >= 0; i-- {
+		step := o.def.Steps[i]
+		switch statuses[i] {
+		case model.SagaStepStatusStarted, model.SagaStepStatusCompleted, model.SagaStepStatusFailed,
+			model.SagaStepStatusCompensationFailed:
+		default:
+			continue
+		}
+
+		if step.Compensate != nil {
+			if compErr := step.Compensate(ctx, data); compErr != nil {
+				if err := o.saveStep(ctx, s, i, model.SagaStepStatusCompensationFailed, compErr); err != nil {
+					o.logger.Error("failed to record saga compensation failure", zap.String("saga_id", s.ID), zap.Error(err))
+				}
+				if err := o.updateSaga(ctx, s, model.SagaStatusFailed, data); err != nil {
+					o.logger.Error("failed to mark saga failed", zap.String("saga_id", s.ID), zap.Error(err))
+				}
+				return fmt.Errorf("failed to compensate step %s: %w", step.Name, compErr)
+			}
+		}
+
+		statuses[i] = model.SagaStepStatusCompensated
+		if err := o.saveStep(ctx, s, i, model.SagaStepStatusCompensated, nil); err != nil {
+			return err
+		}
+	}
+
+	return o.updateSaga(ctx, s, model.SagaStatusCompensated, data)
+}
+
+func (o *Orchestrator[T]) saveStep(ctx context.Context, s *model.Saga, index int, status model.SagaStepStatus, stepErr error) error {
+	step := &model.SagaStep{
+		SagaID:    s.ID,
+		StepIndex: index,
+		StepName:  o.def.Steps[index].Name,
+		Status:    status,
+	}
+	if stepErr != nil {
+		msg := stepErr.Error()
+		step.ErrorMessage = &msg
+	}
+
+	if err := o.repo.SaveStep(ctx, step); err != nil {
+		return fmt.Errorf("failed to save saga step %s: %w", step.StepName, err)
+	}
+	return nil
+}
+
+func (o *Orchestrator[T]) updateSaga(ctx context.Context, s *model.Saga, status model.SagaStatus, data *T) error {
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return fmt.Errorf("failed to encode saga payload: %w", err)
+	}
+
+	if updateErr := o.repo.Update(ctx, s.ID, status, payload); updateErr != nil {
+		return fmt.Errorf("failed to update saga: %w", updateErr)
+	}
+	s.Status = status
+	s.Payload = payload
+	return nil
+}
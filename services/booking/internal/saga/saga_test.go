@@ -0,0 +1,227 @@
+package saga
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strconv"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/wylu1037/go-micro-boilerplate/services/booking/internal/model"
+)
+
+// memorySagaRepository keeps sagas and their step log in memory.
+type memorySagaRepository struct {
+	sagas map[string]*model.Saga
+	steps map[string]map[int]*model.SagaStep
+}
+
+func newMemorySagaRepository() *memorySagaRepository {
+	return &memorySagaRepository{
+		sagas: make(map[string]*model.Saga),
+		steps: make(map[string]map[int]*model.SagaStep),
+	}
+}
+
+func (r *memorySagaRepository) Create(_ context.Context, s *model.Saga) error {
+	s.ID = strconv.Itoa(len(r.sagas) + 1)
+	stored := *s
+	r.sagas[s.ID] = &stored
+	r.steps[s.ID] = make(map[int]*model.SagaStep)
+	return nil
+}
+
+func (r *memorySagaRepository) Update(_ context.Context, id string, status model.SagaStatus, payload []byte) error {
+	r.sagas[id].Status = status
+	r.sagas[id].Payload = payload
+	return nil
+}
+
+func (r *memorySagaRepository) SaveStep(_ context.Context, step *model.SagaStep) error {
+	stored := *step
+	r.steps[step.SagaID][step.StepIndex] = &stored
+	return nil
+}
+
+func (r *memorySagaRepository) ListSteps(_ context.Context, sagaID string) ([]*model.SagaStep, error) {
+	steps := []*model.SagaStep{}
+	for _, step := range r.steps[sagaID] {
+		steps = append(steps, step)
+	}
+	return steps, nil
+}
+
+func (r *memorySagaRepository) ClaimStale(_ context.Context, name string, _ time.Time, limit int) ([]*model.Saga, error) {
+	claimed := []*model.Saga{}
+	for _, s := range r.sagas {
+		switch s.Status {
+		case model.SagaStatusRunning, model.SagaStatusCompensating, model.SagaStatusFailed:
+			if s.Name == name && len(claimed) < limit {
+				copied := *s
+				claimed = append(claimed, &copied)
+			}
+		}
+	}
+	return claimed, nil
+}
+
+func (r *memorySagaRepository) only(t *testing.T) *model.Saga {
+	t.Helper()
+	if len(r.sagas) != 1 {
+		t.Fatalf("want one saga, got %d", len(r.sagas))
+	}
+	return r.sagas["1"]
+}
+
+type testData struct {
+	Value string `json:"value"`
+}
+
+// recorder builds steps that log their actions and compensations and fail as told.
+type recorder struct {
+	calls []string
+	fail  map[string]error
+}
+
+func (r *recorder) step(name string) Step[testData] {
+	return Step[testData]{
+		Name: name,
+		Action: func(context.Context, *testData) error {
+			r.calls = append(r.calls, name)
+			return r.fail[name]
+		},
+		Compensate: func(context.Context, *testData) error {
+			r.calls = append(r.calls, "undo "+name)
+			return r.fail["undo "+name]
+		},
+	}
+}
+
+func newTestSaga(rec *recorder, repo *memorySagaRepository) *Orchestrator[testData] {
+	return New(Definition[testData]{
+		Name:  "test",
+		Steps: []Step[testData]{rec.step("a"), rec.step("b"), rec.step("c"), rec.step("d")},
+		Pivot: 2,
+	}, repo, zap.NewNop())
+}
+
+func assertCalls(t *testing.T, rec *recorder, want ...string) {
+	t.Helper()
+	if !slices.Equal(rec.calls, want) {
+		t.Fatalf("calls = %v, want %v", rec.calls, want)
+	}
+	rec.calls = nil
+}
+
+func TestExecuteCompletes(t *testing.T) {
+	repo := newMemorySagaRepository()
+	rec := &recorder{}
+
+	if err := newTestSaga(rec, repo).Execute(context.Background(), &testData{Value: "x"}); err != nil {
+		t.Fatal(err)
+	}
+
+	assertCalls(t, rec, "a", "b", "c", "d")
+	if s := repo.only(t); s.Status != model.SagaStatusCompleted {
+		t.Fatalf("status = %s, want completed", s.Status)
+	}
+}
+
+func TestExecuteCompensatesUpToThePivot(t *testing.T) {
+	repo := newMemorySagaRepository()
+	stepErr := errors.New("no seats")
+	rec := &recorder{fail: map[string]error{"c": stepErr}}
+
+	err := newTestSaga(rec, repo).Execute(context.Background(), &testData{})
+	if !errors.Is(err, stepErr) {
+		t.Fatalf("want the step error, got %v", err)
+	}
+
+	// The failed step is compensated too: its error does not prove it had no effect
+	assertCalls(t, rec, "a", "b", "c", "undo c", "undo b", "undo a")
+	s := repo.only(t)
+	if s.Status != model.SagaStatusCompensated {
+		t.Fatalf("status = %s, want compensated", s.Status)
+	}
+	for i, step := range repo.steps[s.ID] {
+		if i <= 2 && step.Status != model.SagaStepStatusCompensated {
+			t.Fatalf("step %d is %s, want compensated", i, step.Status)
+		}
+	}
+	if _, ran := repo.steps[s.ID][3]; ran {
+		t.Fatal("step after the failure must not run")
+	}
+}
+
+func TestFailureAfterPivotIsRetriedForward(t *testing.T) {
+	repo := newMemorySagaRepository()
+	rec := &recorder{fail: map[string]error{"d": errors.New("outbox unavailable")}}
+	saga := newTestSaga(rec, repo)
+
+	if err := saga.Execute(context.Background(), &testData{}); err != nil {
+		t.Fatalf("a failure after the pivot must not fail the saga, got %v", err)
+	}
+	assertCalls(t, rec, "a", "b", "c", "d")
+	if s := repo.only(t); s.Status != model.SagaStatusRunning {
+		t.Fatalf("status = %s, want running", s.Status)
+	}
+
+	rec.fail = nil
+	if _, err := saga.Recover(context.Background(), time.Minute, 10); err != nil {
+		t.Fatal(err)
+	}
+	assertCalls(t, rec, "d")
+	if s := repo.only(t); s.Status != model.SagaStatusCompleted {
+		t.Fatalf("status = %s, want completed", s.Status)
+	}
+}
+
+func TestFailedCompensationIsRetried(t *testing.T) {
+	repo := newMemorySagaRepository()
+	rec := &recorder{fail: map[string]error{
+		"b":      errors.New("catalog down"),
+		"undo a": errors.New("still down"),
+	}}
+	saga := newTestSaga(rec, repo)
+
+	if err := saga.Execute(context.Background(), &testData{}); err == nil {
+		t.Fatal("want the step error")
+	}
+	assertCalls(t, rec, "a", "b", "undo b", "undo a")
+	if s := repo.only(t); s.Status != model.SagaStatusFailed {
+		t.Fatalf("status = %s, want failed", s.Status)
+	}
+
+	rec.fail = nil
+	if _, err := saga.Recover(context.Background(), time.Minute, 10); err != nil {
+		t.Fatal(err)
+	}
+	// Only the compensation that failed runs again
+	assertCalls(t, rec, "undo a")
+	if s := repo.only(t); s.Status != model.SagaStatusCompensated {
+		t.Fatalf("status = %s, want compensated", s.Status)
+	}
+}
+
+func TestInterruptedSagaBeforePivotIsCompensated(t *testing.T) {
+	repo := newMemorySagaRepository()
+	rec := &recorder{}
+	saga := newTestSaga(rec, repo)
+
+	// A crash right after step b started: the saga is running with b in flight
+	s := &model.Saga{Name: "test", Status: model.SagaStatusRunning, Payload: []byte(`{}`)}
+	_ = repo.Create(context.Background(), s)
+	_ = repo.SaveStep(context.Background(), &model.SagaStep{SagaID: s.ID, StepIndex: 0, Status: model.SagaStepStatusCompleted})
+	_ = repo.SaveStep(context.Background(), &model.SagaStep{SagaID: s.ID, StepIndex: 1, Status: model.SagaStepStatusStarted})
+
+	if _, err := saga.Recover(context.Background(), time.Minute, 10); err != nil {
+		t.Fatal(err)
+	}
+	assertCalls(t, rec, "undo b", "undo a")
+	if got := repo.only(t); got.Status != model.SagaStatusCompensated {
+		t.Fatalf("status = %s, want compensated", got.Status)
+	}
+}
@@ -8,11 +8,20 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/google/uuid"
+	"github.com/samber/lo"
 	"github.com/shopspring/decimal"
+	"go-micro.dev/v4/auth"
+	"go.uber.org/zap"
+
 	catalogv1 "github.com/wylu1037/go-micro-boilerplate/gen/go/catalog/v1"
-	notificationv1 "github.com/wylu1037/go-micro-boilerplate/gen/go/notification/v1"
+	identityv1 "github.com/wylu1037/go-micro-boilerplate/gen/go/identity/v1"
+	"github.com/wylu1037/go-micro-boilerplate/pkg/config"
+	"github.com/wylu1037/go-micro-boilerplate/pkg/middleware"
 	"github.com/wylu1037/go-micro-boilerplate/services/booking/internal/model"
+	paymentpkg "github.com/wylu1037/go-micro-boilerplate/services/booking/internal/payment"
 	"github.com/wylu1037/go-micro-boilerplate/services/booking/internal/repository"
+	"github.com/wylu1037/go-micro-boilerplate/services/booking/internal/saga"
 )
 
 var (
@@ -20,99 +29,145 @@ var (
 	ErrNotEnoughSeats      = errors.New("not enough available seats")
 	ErrBookingNotFound     = errors.New("booking not found")
 	ErrInvalidBookingState = errors.New("invalid booking state for payment")
+	ErrInvalidQuantity     = errors.New("quantity must be positive and match the selected seats")
+	ErrPaymentDeclined     = errors.New("payment declined")
+	ErrPaymentMismatch     = errors.New("payment notification does not match the booking")
+	ErrRefundNotAllowed    = errors.New("booking is no longer refundable")
+	ErrInvalidRefundAmount = errors.New("refund amount must be positive and within the refund policy")
+	ErrEmailNotVerified    = errors.New("email address must be verified before booking")
 )
 
 type BookingService interface {
-	CreateBooking(ctx context.Context, userID, sessionID, seatAreaID string, quantity int32) (*model.Booking, error)
+	CreateBooking(ctx context.Context, userID, sessionID, seatAreaID string, quantity int32, seatIDs []string, holdToken string) (*model.Booking, error)
 	GetBooking(ctx context.Context, bookingID string, userID string) (*model.Booking, error)
 	ListBookings(ctx context.Context, userID string, page, pageSize int, status *model.BookingStatus) ([]*model.Booking, int64, error)
 	ProcessPayment(ctx context.Context, bookingID string, userID string, paymentMethod string) (string, error)
+	// CancelBooking cancels a booking that has not been paid yet and queues the release of its seats.
+	CancelBooking(ctx context.Context, bookingID string, userID string) (*model.Booking, error)
+	// RefundBooking requests the refund of a paid booking and returns it refund_pending. The provider is
+	// asked for the money in the background; once it agrees the booking is refunded, its tickets voided and
+	// its seats released. A nil amount refunds the maximum the refund policy allows for the session's start
+	// time; a smaller amount makes a partial refund.
+	RefundBooking(ctx context.Context, bookingID string, userID string, amount *decimal.Decimal) (*model.Booking, *model.Payment, error)
+	GetBookingHistory(ctx context.Context, bookingID string, userID string) ([]*model.BookingStatusHistory, error)
+	// RunOrderTask runs an order task from the outbox (see model.IsOrderTask). The relay retries a task
+	// until it returns nil, so every task is safe to repeat.
+	RunOrderTask(ctx context.Context, task *model.OutboxEvent) error
+	// HandlePaymentNotification applies a payment result reported asynchronously by a provider. Each provider
+	// event is applied at most once, so redelivered notifications are safe and reported as duplicates.
+	HandlePaymentNotification(ctx context.Context, n *model.PaymentNotification) (*model.PaymentNotificationResult, error)
+	// ExpireOverdueBookings expires up to limit unpaid bookings whose payment deadline has passed, one
+	// transaction each, and queues the release of their seats. It reports how many bookings were expired
+	// and how many could not be; the latter stay pending and are picked up again on the next call.
+	ExpireOverdueBookings(ctx context.Context, limit int) (expired int, failed int, err error)
+	// RecoverSagas resumes or compensates up to limit booking sagas that were left unfinished
+	// for longer than staleAfter, e.g. because the replica running them crashed.
+	RecoverSagas(ctx context.Context, staleAfter time.Duration, limit int) (int, error)
+	// HandleShowChanged tells the holders of active orders for the show about changes to its title, artist
+	// or status by publishing an OrderEventShowChanged event per order. Redelivered events are safe.
+	HandleShowChanged(ctx context.Context, event *catalogv1.ShowEvent) error
 }
 
 type bookingService struct {
-	repo               repository.BookingRepository
-	catalogClient      catalogv1.CatalogService
-	notificationClient notificationv1.NotificationService
+	repo              repository.BookingRepository
+	sagaRepo          repository.SagaRepository
+	paymentRepo       repository.PaymentRepository
+	paymentProviders  *paymentpkg.Registry
+	refundPolicy      *RefundPolicy
+	tickets           TicketService
+	catalogClient     catalogv1.CatalogService
+	identityClient    identityv1.IdentityService
+	requireVerified   bool
+	microAuth         auth.Auth
+	serviceName       string
+	logger            *zap.Logger
+	createBookingSaga *saga.Orchestrator[createBookingData]
 }
 
-func NewBookingService(repo repository.BookingRepository, catalogClient catalogv1.CatalogService, notificationClient notificationv1.NotificationService) BookingService {
-	return &bookingService{
-		repo:               repo,
-		catalogClient:      catalogClient,
-		notificationClient: notificationClient,
+func NewBookingService(
+	repo repository.BookingRepository,
+	sagaRepo repository.SagaRepository,
+	paymentRepo repository.PaymentRepository,
+	paymentProviders *paymentpkg.Registry,
+	refundPolicy *RefundPolicy,
+	tickets TicketService,
+	catalogClient catalogv1.CatalogService,
+	identityClient identityv1.IdentityService,
+	microAuth auth.Auth,
+	cfg *config.Config,
+	logger *zap.Logger,
+) BookingService {
+	svc := &bookingService{
+		repo:             repo,
+		sagaRepo:         sagaRepo,
+		paymentRepo:      paymentRepo,
+		paymentProviders: paymentProviders,
+		refundPolicy:     refundPolicy,
+		tickets:          tickets,
+		catalogClient:    catalogClient,
+		identityClient:   identityClient,
+		requireVerified:  cfg.Booking.RequireVerifiedEmail,
+		microAuth:        microAuth,
+		serviceName:      cfg.Service.Name,
+		logger:           logger,
 	}
+	svc.createBookingSaga = svc.newCreateBookingSaga()
+	return svc
 }
 
-func (s *bookingService) CreateBooking(ctx context.Context, userID, sessionID, seatAreaID string, quantity int32) (*model.Booking, error) {
-	// 1. Check availability via Catalog Service
-	checkResp, err := s.catalogClient.CheckAvailability(ctx, &catalogv1.CheckAvailabilityRequest{
-		SessionId:  sessionID,
-		SeatAreaId: seatAreaID,
-		Quantity:   quantity,
-	})
-	if err != nil {
-		return nil, fmt.Errorf("failed to check availability: %w", err)
+// CreateBooking books quantity seats anywhere in the seat area, or exactly seatIDs when given,
+// in which case quantity may be zero. A non-empty holdToken converts the caller's seat hold.
+func (s *bookingService) CreateBooking(ctx context.Context, userID, sessionID, seatAreaID string, quantity int32, seatIDs []string, holdToken string) (*model.Booking, error) {
+	if len(seatIDs) > 0 {
+		if quantity != 0 && quantity != int32(len(seatIDs)) {
+			return nil, ErrInvalidQuantity
+		}
+		quantity = int32(len(seatIDs))
 	}
-
-	if !checkResp.Available {
-		return nil, ErrNotEnoughSeats
+	if quantity <= 0 {
+		return nil, ErrInvalidQuantity
 	}
 
-	// 2. Calculate Unit Price and Total Amount
-	unitPrice, err := decimal.NewFromString(checkResp.Price)
-	if err != nil {
-		return nil, fmt.Errorf("invalid price format from catalog: %w", err)
+	if s.requireVerified {
+		if verifyErr := s.checkEmailVerified(ctx, userID); verifyErr != nil {
+			return nil, verifyErr
+		}
 	}
-	totalAmount := unitPrice.Mul(decimal.NewFromInt32(quantity))
 
-	// 3. Generate Order Number
 	orderNo, err := generateOrderNo()
 	if err != nil {
 		return nil, fmt.Errorf("failed to generate order number: %w", err)
 	}
 
-	// 4. Set expiration time (15 minutes from now)
-	expiresAt := time.Now().Add(15 * time.Minute)
-
-	// 5. Create Booking Record (Pending Payment)
-	booking := &model.Booking{
-		OrderNo:     orderNo,
-		UserID:      userID,
-		SessionID:   sessionID,
-		SeatAreaID:  seatAreaID,
-		Quantity:    quantity,
-		UnitPrice:   unitPrice,
-		TotalAmount: totalAmount,
-		Status:      model.BookingStatusPendingPayment,
-		ExpiresAt:   &expiresAt,
+	data := &createBookingData{
+		OrderID:    uuid.NewString(),
+		OrderNo:    orderNo,
+		UserID:     userID,
+		SessionID:  sessionID,
+		SeatAreaID: seatAreaID,
+		Quantity:   quantity,
+		SeatIDs:    seatIDs,
+		HoldToken:  holdToken,
+		ExpiresAt:  time.Now().Add(15 * time.Minute), // Payment deadline
 	}
 
-	if err := s.repo.Create(ctx, booking); err != nil {
-		return nil, fmt.Errorf("failed to create booking record: %w", err)
+	if err := s.createBookingSaga.Execute(ctx, data); err != nil {
+		return nil, err
 	}
 
-	// 6. Reserve Seats
-	// Note: In a distributed system, we might want to do this before creating the booking record or use a saga.
-	// For simplicity, we do it here. If reservation fails, we should technically fail the booking or mark it as failed.
-	reserveResp, err := s.catalogClient.ReserveSeats(ctx, &catalogv1.ReserveSeatsRequest{
-		SessionId:  sessionID,
-		SeatAreaId: seatAreaID,
-		Quantity:   quantity,
-		OrderId:    booking.ID, // Using BookingID as OrderID
-	})
+	return data.booking, nil
+}
 
+// checkEmailVerified asks the identity service whether the user verified their email address.
+func (s *bookingService) checkEmailVerified(ctx context.Context, userID string) error {
+	resp, err := s.identityClient.GetProfile(ctx, &identityv1.GetProfileRequest{UserId: userID})
 	if err != nil {
-		// Attempt to mark booking as cancelled
-		_ = s.repo.UpdateStatus(ctx, booking.ID, model.BookingStatusCancelled)
-		return nil, fmt.Errorf("failed to reserve seats: %w", err)
+		return fmt.Errorf("failed to look up user: %w", err)
 	}
-
-	if !reserveResp.Success {
-		_ = s.repo.UpdateStatus(ctx, booking.ID, model.BookingStatusCancelled)
-		return nil, errors.New(reserveResp.Message)
+	if !resp.User.EmailVerified {
+		return ErrEmailNotVerified
 	}
-
-	return booking, nil
+	return nil
 }
 
 func (s *bookingService) GetBooking(ctx context.Context, bookingID string, userID string) (*model.Booking, error) {
@@ -130,6 +185,9 @@ func (s *bookingService) ListBookings(ctx context.Context, userID string, page,
 	return s.repo.List(ctx, page, pageSize, userID, status)
 }
 
+// ProcessPayment pays a pending booking through the provider registered for paymentMethod and returns
+// the provider's transaction ID. Every attempt is recorded in booking.payments; a declined attempt leaves
+// the booking pending so the user can try again until it expires.
 func (s *bookingService) ProcessPayment(ctx context.Context, bookingID string, userID string, paymentMethod string) (string, error) {
 	booking, err := s.repo.GetByID(ctx, bookingID)
 	if err != nil {
@@ -143,39 +201,347 @@ func (s *bookingService) ProcessPayment(ctx context.Context, bookingID string, u
 		return "", ErrInvalidBookingState
 	}
 
-	// Simulate payment processing
-	time.Sleep(500 * time.Millisecond)
+	provider, err := s.paymentProviders.Get(paymentMethod)
+	if err != nil {
+		return "", err
+	}
+
+	payment := &model.Payment{
+		OrderID:       booking.ID,
+		PaymentMethod: paymentMethod,
+		Amount:        booking.TotalAmount,
+		Status:        model.PaymentStatusPending,
+	}
+	if createErr := s.paymentRepo.Create(ctx, payment); createErr != nil {
+		return "", fmt.Errorf("failed to record payment: %w", createErr)
+	}
+
+	authResult, err := provider.Authorize(ctx, &paymentpkg.AuthorizeRequest{
+		PaymentID: payment.ID,
+		OrderID:   booking.ID,
+		Amount:    payment.Amount,
+	})
+	if err != nil {
+		s.failPayment(ctx, payment, "", err.Error())
+		return "", fmt.Errorf("failed to authorize payment: %w", err)
+	}
+	if authResult.Status != paymentpkg.StatusAuthorized {
+		s.failPayment(ctx, payment, authResult.TransactionID, authResult.Message)
+		return "", ErrPaymentDeclined
+	}
+
+	captureResult, err := provider.Capture(ctx, authResult.TransactionID, payment.Amount)
+	if err != nil {
+		s.failPayment(ctx, payment, authResult.TransactionID, err.Error())
+		return "", fmt.Errorf("failed to capture payment: %w", err)
+	}
+	if captureResult.Status != paymentpkg.StatusCaptured {
+		s.failPayment(ctx, payment, authResult.TransactionID, captureResult.Message)
+		return "", ErrPaymentDeclined
+	}
+
+	payment.TransactionID = &captureResult.TransactionID
+	completed, err := s.paymentRepo.Complete(ctx, payment, model.StatusChange{
+		ActorType: model.StatusActorUser,
+		ActorID:   userID,
+		Reason:    "payment " + payment.ID + " captured",
+	})
+	if err != nil {
+		// The money is taken but the order is not marked paid; leave the pending attempt for reconciliation.
+		s.logger.Error("failed to complete captured payment",
+			zap.String("booking_id", booking.ID),
+			zap.String("payment_id", payment.ID),
+			zap.String("transaction_id", captureResult.TransactionID),
+			zap.Error(err),
+		)
+		return "", fmt.Errorf("failed to complete payment: %w", err)
+	}
+	if !completed {
+		// The booking left pending_payment while we were charging, e.g. it expired; give the money back.
+		s.refundOrphanedPayment(ctx, provider, payment)
+		return "", ErrInvalidBookingState
+	}
+
+	s.logger.Info("Booking paid",
+		zap.String("booking_id", booking.ID),
+		zap.String("payment_id", payment.ID),
+		zap.String("transaction_id", captureResult.TransactionID),
+	)
+	s.issueTickets(ctx, booking)
+
+	return captureResult.TransactionID, nil
+}
+
+// issueTickets issues the tickets of a booking that was just paid. Failures are only logged: the payment
+// stands, and the ticket issuance worker picks up paid bookings without tickets.
+func (s *bookingService) issueTickets(ctx context.Context, booking *model.Booking) {
+	if _, err := s.tickets.IssueTickets(ctx, booking); err != nil {
+		s.logger.Error("failed to issue tickets", zap.String("booking_id", booking.ID), zap.Error(err))
+	}
+}
+
+// failPayment records a failed attempt. Failing to record it is only logged: the attempt stays pending,
+// which is still accurate enough for reconciliation, and the caller already has an error to return.
+func (s *bookingService) failPayment(ctx context.Context, payment *model.Payment, transactionID, reason string) {
+	payment.Status = model.PaymentStatusFailed
+	if transactionID != "" {
+		payment.TransactionID = &transactionID
+	}
+	if reason != "" {
+		payment.FailureReason = &reason
+	}
+
+	if err := s.paymentRepo.UpdateStatus(ctx, payment); err != nil {
+		s.logger.Error("failed to record failed payment", zap.String("payment_id", payment.ID), zap.Error(err))
+	}
+}
+
+func (s *bookingService) refundOrphanedPayment(ctx context.Context, provider paymentpkg.Provider, payment *model.Payment) {
+	result, err := provider.Refund(ctx, &paymentpkg.RefundRequest{
+		RefundID:      payment.ID,
+		TransactionID: *payment.TransactionID,
+		Amount:        payment.Amount,
+	})
+	if err == nil && result.Status != paymentpkg.StatusRefunded {
+		err = errors.New(result.Message)
+	}
+	if err != nil {
+		s.failPayment(ctx, payment, *payment.TransactionID, "order no longer payable; refund failed: "+err.Error())
+		s.logger.Error("failed to refund payment for unpayable booking",
+			zap.String("booking_id", payment.OrderID),
+			zap.String("payment_id", payment.ID),
+			zap.Error(err),
+		)
+		return
+	}
+
+	reason := "order no longer payable"
+	payment.FailureReason = &reason
+	payment.RefundedAmount = payment.Amount
+	if updateErr := s.paymentRepo.RecordRefund(ctx, payment); updateErr != nil {
+		s.logger.Error("failed to record refunded payment", zap.String("payment_id", payment.ID), zap.Error(updateErr))
+	}
+}
+
+func (s *bookingService) CancelBooking(ctx context.Context, bookingID string, userID string) (*model.Booking, error) {
+	booking, err := s.GetBooking(ctx, bookingID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if booking.Status != model.BookingStatusPendingPayment {
+		return nil, ErrInvalidBookingState
+	}
+
+	cancelled, err := s.repo.Transition(ctx, booking.ID, model.BookingStatusCancelled, model.StatusChange{
+		ActorType: model.StatusActorUser,
+		ActorID:   userID,
+		Reason:    "cancelled by user",
+	})
+	if errors.Is(err, model.ErrInvalidTransition) {
+		return nil, ErrInvalidBookingState // Paid or expired meanwhile
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to cancel booking: %w", err)
+	}
+	if cancelled == nil {
+		return nil, ErrBookingNotFound
+	}
+
+	s.logger.Info("Booking cancelled", zap.String("booking_id", booking.ID), zap.String("order_no", booking.OrderNo))
 
-	// Simulate success (we could add logic to fail based on paymentMethod == "fail")
-	if paymentMethod == "fail" {
-		_ = s.repo.UpdateStatus(ctx, bookingID, model.BookingStatusCancelled)
+	return cancelled, nil
+}
 
-		// Release seats
-		_, _ = s.catalogClient.ReleaseSeats(ctx, &catalogv1.ReleaseSeatsRequest{
-			SessionId:  booking.SessionID,
-			SeatAreaId: booking.SeatAreaID,
-			Quantity:   booking.Quantity,
-			OrderId:    booking.ID,
-		})
+// RefundBooking only records the refund request: the order becomes refund_pending in the same transaction
+// that queues the provider call, so a crash can neither pay out twice nor lose the request. The outbox relay
+// then asks the provider for the money and completes or declines the refund (see refundPayment).
+func (s *bookingService) RefundBooking(ctx context.Context, bookingID string, userID string, amount *decimal.Decimal) (*model.Booking, *model.Payment, error) {
+	booking, err := s.GetBooking(ctx, bookingID, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if booking.Status != model.BookingStatusPaid {
+		return nil, nil, ErrInvalidBookingState
+	}
 
-		return "", errors.New("payment failed")
+	sessionResp, err := s.catalogClient.GetSession(ctx, &catalogv1.GetSessionRequest{SessionId: booking.SessionID})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get session: %w", err)
+	}
+
+	maxRefund := s.refundPolicy.MaxRefund(booking.TotalAmount, sessionResp.Session.StartTime.AsTime(), time.Now())
+	if !maxRefund.IsPositive() {
+		return nil, nil, ErrRefundNotAllowed
+	}
+	refundAmount := maxRefund
+	if amount != nil {
+		refundAmount = *amount
+	}
+	if !refundAmount.IsPositive() || refundAmount.GreaterThan(maxRefund) {
+		return nil, nil, ErrInvalidRefundAmount
+	}
+
+	payments, err := s.paymentRepo.ListByOrderID(ctx, booking.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+	payment, found := lo.Find(payments, func(p *model.Payment) bool {
+		return p.Status == model.PaymentStatusSuccess
+	})
+	if !found || payment.TransactionID == nil {
+		return nil, nil, ErrInvalidBookingState
 	}
 
-	// Payment Success
-	if err := s.repo.UpdateStatus(ctx, bookingID, model.BookingStatusPaid); err != nil {
-		return "", fmt.Errorf("failed to update booking status: %w", err)
+	// Fail now rather than in the relay if the payment's provider is gone
+	if _, providerErr := s.paymentProviders.Get(payment.PaymentMethod); providerErr != nil {
+		return nil, nil, providerErr
 	}
 
-	// Send Notification
-	// We do this asynchronously or synchronously. Based on plan, we just call it.
-	// We don't block the response on email failure, just log it.
-	_, _ = s.notificationClient.SendEmail(ctx, &notificationv1.SendEmailRequest{
-		To:      "user@example.com", // In real app, fetch user email from Identity Service
-		Subject: "Booking Confirmed",
-		Body:    fmt.Sprintf("Your booking %s has been confirmed. Total paid: %s", booking.ID, booking.TotalAmount.String()),
+	pending, err := s.paymentRepo.RequestRefund(ctx, payment, refundAmount, model.StatusChange{
+		ActorType: model.StatusActorUser,
+		ActorID:   userID,
+		Reason:    "refund of " + refundAmount.String() + " requested by user",
 	})
+	if errors.Is(err, model.ErrInvalidTransition) {
+		return nil, nil, ErrInvalidBookingState
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to request refund: %w", err)
+	}
+	if pending == nil {
+		return nil, nil, ErrBookingNotFound
+	}
+
+	s.logger.Info("Refund requested",
+		zap.String("booking_id", booking.ID),
+		zap.String("payment_id", payment.ID),
+		zap.String("amount", refundAmount.String()),
+	)
+
+	return pending, payment, nil
+}
+
+func (s *bookingService) GetBookingHistory(ctx context.Context, bookingID string, userID string) ([]*model.BookingStatusHistory, error) {
+	booking, err := s.GetBooking(ctx, bookingID, userID)
+	if err != nil {
+		return nil, err
+	}
+	return s.repo.ListStatusHistory(ctx, booking.ID)
+}
+
+func (s *bookingService) HandlePaymentNotification(ctx context.Context, n *model.PaymentNotification) (*model.PaymentNotificationResult, error) {
+	if n.PaymentID != "" {
+		if _, parseErr := uuid.Parse(n.PaymentID); parseErr != nil {
+			return nil, ErrPaymentMismatch
+		}
+	}
+
+	booking, err := s.repo.GetByID(ctx, n.OrderID)
+	if err != nil {
+		return nil, err
+	}
+	if booking == nil {
+		return nil, ErrBookingNotFound
+	}
+	if n.Status == model.PaymentEventSucceeded && !n.Amount.Equal(booking.TotalAmount) {
+		return nil, ErrPaymentMismatch
+	}
+
+	result, err := s.paymentRepo.ApplyNotification(ctx, n)
+	if err != nil {
+		return nil, fmt.Errorf("failed to apply payment notification: %w", err)
+	}
+	if result == nil {
+		return nil, ErrBookingNotFound
+	}
+
+	fields := []zap.Field{
+		zap.String("booking_id", result.Booking.ID),
+		zap.String("provider", n.Provider),
+		zap.String("event_id", n.EventID),
+		zap.String("status", string(n.Status)),
+		zap.String("booking_status", string(result.Booking.Status)),
+	}
+	if result.Duplicate {
+		s.logger.Info("Duplicate payment notification ignored", fields...)
+		return result, nil
+	}
+	s.logger.Info("Payment notification applied", append(fields, zap.String("payment_id", result.Payment.ID))...)
+
+	if n.Status == model.PaymentEventSucceeded && !result.Orphaned {
+		s.issueTickets(ctx, result.Booking)
+	}
+
+	if result.Orphaned {
+		// The provider took the money for an order that can no longer be paid; give it back.
+		provider, providerErr := s.paymentProviders.Get(n.Provider)
+		if providerErr != nil {
+			s.logger.Error("cannot refund payment for unpayable booking",
+				zap.String("booking_id", result.Booking.ID),
+				zap.String("payment_id", result.Payment.ID),
+				zap.Error(providerErr),
+			)
+			return result, nil
+		}
+		s.refundOrphanedPayment(ctx, provider, result.Payment)
+	}
+
+	return result, nil
+}
+
+// releaseSeats returns a booking's seats to the catalog. The catalog ledger makes repeated releases of
+// the same order safe.
+func (s *bookingService) releaseSeats(ctx context.Context, booking *model.Booking) error {
+	callCtx, err := middleware.ServiceContext(ctx, s.microAuth, s.serviceName)
+	if err != nil {
+		return err
+	}
+
+	resp, err := s.catalogClient.ReleaseSeats(callCtx, &catalogv1.ReleaseSeatsRequest{
+		SessionId:  booking.SessionID,
+		SeatAreaId: booking.SeatAreaID,
+		Quantity:   booking.Quantity,
+		OrderId:    booking.ID,
+	})
+	if err == nil && !resp.Success {
+		err = errors.New(resp.Message)
+	}
+	return err
+}
+
+func (s *bookingService) ExpireOverdueBookings(ctx context.Context, limit int) (int, int, error) {
+	now := time.Now()
+	ids, err := s.repo.ListOverdue(ctx, now, limit)
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to list overdue bookings: %w", err)
+	}
+
+	expired, failed := 0, 0
+	change := model.StatusChange{ActorType: model.StatusActorSystem, ActorID: "expiry_worker", Reason: "payment deadline passed"}
+	for _, id := range ids {
+		booking, expireErr := s.repo.Expire(ctx, id, now, change)
+		if expireErr != nil {
+			failed++
+			s.logger.Warn("failed to expire overdue booking", zap.String("booking_id", id), zap.Error(expireErr))
+			continue
+		}
+		if booking == nil {
+			continue
+		}
+
+		expired++
+		s.logger.Info("Booking expired",
+			zap.String("booking_id", booking.ID),
+			zap.String("order_no", booking.OrderNo),
+			zap.Int32("quantity", booking.Quantity),
+		)
+	}
+
+	return expired, failed, nil
+}
 
-	return "txn_" + booking.ID, nil
+func (s *bookingService) RecoverSagas(ctx context.Context, staleAfter time.Duration, limit int) (int, error) {
+	return s.createBookingSaga.Recover(ctx, staleAfter, limit)
 }
 
 // generateOrderNo generates a unique order number
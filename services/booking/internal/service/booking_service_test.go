@@ -0,0 +1,461 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"go-micro.dev/v4/auth"
+	"go-micro.dev/v4/client"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	catalogv1 "github.com/wylu1037/go-micro-boilerplate/gen/go/catalog/v1"
+
+	"github.com/wylu1037/go-micro-boilerplate/services/booking/internal/model"
+	paymentpkg "github.com/wylu1037/go-micro-boilerplate/services/booking/internal/payment"
+	"github.com/wylu1037/go-micro-boilerplate/services/booking/internal/repository"
+)
+
+type fakeBookingRepository struct {
+	repository.BookingRepository
+	bookings  map[string]*model.Booking
+	overdue   []string
+	expireErr map[string]error
+}
+
+func (r *fakeBookingRepository) GetByID(_ context.Context, id string) (*model.Booking, error) {
+	return r.bookings[id], nil
+}
+
+func (r *fakeBookingRepository) ListOverdue(_ context.Context, _ time.Time, limit int) ([]string, error) {
+	return r.overdue[:min(limit, len(r.overdue))], nil
+}
+
+// Expire expires pending bookings; bookings that are no longer pending are skipped like in the repository.
+func (r *fakeBookingRepository) Expire(_ context.Context, id string, _ time.Time, _ model.StatusChange) (*model.Booking, error) {
+	if err := r.expireErr[id]; err != nil {
+		return nil, err
+	}
+	booking := r.bookings[id]
+	if booking == nil || booking.Status != model.BookingStatusPendingPayment {
+		return nil, nil
+	}
+	booking.Status = model.BookingStatusExpired
+	return booking, nil
+}
+
+type fakePaymentRepository struct {
+	repository.PaymentRepository
+	payments           []*model.Payment
+	notificationResult *model.PaymentNotificationResult
+	applied            []*model.PaymentNotification
+	refunded           []*model.Payment
+	failed             []*model.Payment
+	requested          []decimal.Decimal
+	completed          []*model.Payment
+	declined           []string
+}
+
+func (r *fakePaymentRepository) ListByOrderID(_ context.Context, orderID string) ([]*model.Payment, error) {
+	var payments []*model.Payment
+	for _, p := range r.payments {
+		if p.OrderID == orderID {
+			payments = append(payments, p)
+		}
+	}
+	return payments, nil
+}
+
+func (r *fakePaymentRepository) RequestRefund(_ context.Context, payment *model.Payment, amount decimal.Decimal, _ model.StatusChange) (*model.Booking, error) {
+	r.requested = append(r.requested, amount)
+	return &model.Booking{ID: payment.OrderID, Status: model.BookingStatusRefundPending}, nil
+}
+
+func (r *fakePaymentRepository) CompleteRefund(_ context.Context, payment *model.Payment, _ model.StatusChange) (*model.Booking, error) {
+	r.completed = append(r.completed, payment)
+	return &model.Booking{ID: payment.OrderID, Status: model.BookingStatusRefunded}, nil
+}
+
+func (r *fakePaymentRepository) DeclineRefund(_ context.Context, payment *model.Payment, reason string, _ model.StatusChange) (*model.Booking, error) {
+	r.declined = append(r.declined, reason)
+	return &model.Booking{ID: payment.OrderID, Status: model.BookingStatusPaid}, nil
+}
+
+func (r *fakePaymentRepository) ApplyNotification(_ context.Context, n *model.PaymentNotification) (*model.PaymentNotificationResult, error) {
+	r.applied = append(r.applied, n)
+	return r.notificationResult, nil
+}
+
+func (r *fakePaymentRepository) RecordRefund(_ context.Context, payment *model.Payment) error {
+	r.refunded = append(r.refunded, payment)
+	return nil
+}
+
+func (r *fakePaymentRepository) UpdateStatus(_ context.Context, payment *model.Payment) error {
+	r.failed = append(r.failed, payment)
+	return nil
+}
+
+type fakeTicketService struct {
+	TicketService
+	issued []string
+}
+
+func (s *fakeTicketService) IssueTickets(_ context.Context, booking *model.Booking) ([]*model.Ticket, error) {
+	s.issued = append(s.issued, booking.ID)
+	return nil, nil
+}
+
+// fakeProvider answers every refund with result, or err if set.
+type fakeProvider struct {
+	paymentpkg.Provider
+	result  *paymentpkg.Result
+	err     error
+	refunds []*paymentpkg.RefundRequest
+}
+
+func (p *fakeProvider) Refund(_ context.Context, req *paymentpkg.RefundRequest) (*paymentpkg.Result, error) {
+	p.refunds = append(p.refunds, req)
+	return p.result, p.err
+}
+
+// fakeCatalogService serves the session of every booking, starting at startTime.
+type fakeCatalogService struct {
+	catalogv1.CatalogService
+	startTime time.Time
+}
+
+func (c *fakeCatalogService) GetSession(_ context.Context, req *catalogv1.GetSessionRequest, _ ...client.CallOption) (*catalogv1.GetSessionResponse, error) {
+	return &catalogv1.GetSessionResponse{Session: &catalogv1.Session{SessionId: req.SessionId, StartTime: timestamppb.New(c.startTime)}}, nil
+}
+
+type testBookingService struct {
+	*bookingService
+	bookings *fakeBookingRepository
+	payments *fakePaymentRepository
+	tickets  *fakeTicketService
+	provider *fakeProvider
+	catalog  *fakeCatalogService
+}
+
+func newTestBookingService(bookings ...*model.Booking) *testBookingService {
+	t := &testBookingService{
+		bookings: &fakeBookingRepository{bookings: map[string]*model.Booking{}},
+		payments: &fakePaymentRepository{},
+		tickets:  &fakeTicketService{},
+		provider: &fakeProvider{result: &paymentpkg.Result{Status: paymentpkg.StatusRefunded}},
+		catalog:  &fakeCatalogService{startTime: time.Now().Add(7 * 24 * time.Hour)},
+	}
+	for _, b := range bookings {
+		t.bookings.bookings[b.ID] = b
+	}
+	t.bookingService = &bookingService{
+		repo:             t.bookings,
+		paymentRepo:      t.payments,
+		paymentProviders: paymentpkg.NewRegistry().Register(t.provider, "card"),
+		refundPolicy:     &RefundPolicy{rules: defaultRefundPolicy},
+		tickets:          t.tickets,
+		catalogClient:    t.catalog,
+		microAuth:        auth.NewAuth(),
+		serviceName:      "booking",
+		logger:           zap.NewNop(),
+	}
+	return t
+}
+
+const (
+	testBookingID = "6f1c2a5e-0d7b-4c1a-9f3e-2b8d4e6a1c00"
+	testPaymentID = "0b5e8f2a-4c3d-4e6f-8a1b-9c7d2e5f3a00"
+	testUserID    = "3d2c1b0a-9f8e-4d7c-8b6a-5f4e3d2c1b00"
+)
+
+func pendingBooking() *model.Booking {
+	return &model.Booking{
+		ID:          testBookingID,
+		UserID:      testUserID,
+		Quantity:    2,
+		TotalAmount: decimal.RequireFromString("200.00"),
+		Status:      model.BookingStatusPendingPayment,
+	}
+}
+
+func succeeded(amount string) *model.PaymentNotification {
+	return &model.PaymentNotification{
+		Provider:      "card",
+		EventID:       "evt_1",
+		OrderID:       testBookingID,
+		PaymentID:     testPaymentID,
+		TransactionID: "txn_1",
+		Status:        model.PaymentEventSucceeded,
+		Amount:        decimal.RequireFromString(amount),
+	}
+}
+
+func appliedResult(booking *model.Booking) *model.PaymentNotificationResult {
+	transactionID := "txn_1"
+	return &model.PaymentNotificationResult{
+		Booking: booking,
+		Payment: &model.Payment{
+			ID:            testPaymentID,
+			OrderID:       booking.ID,
+			PaymentMethod: "card",
+			TransactionID: &transactionID,
+			Amount:        booking.TotalAmount,
+		},
+	}
+}
+
+func TestPaymentNotificationRejectsMismatches(t *testing.T) {
+	cases := []struct {
+		name string
+		n    *model.PaymentNotification
+		want error
+	}{
+		{"malformed payment id", func() *model.PaymentNotification {
+			n := succeeded("200.00")
+			n.PaymentID = "not-a-uuid"
+			return n
+		}(), ErrPaymentMismatch},
+		{"unknown order", func() *model.PaymentNotification {
+			n := succeeded("200.00")
+			n.OrderID = "00000000-0000-0000-0000-000000000000"
+			return n
+		}(), ErrBookingNotFound},
+		{"wrong amount", succeeded("199.99"), ErrPaymentMismatch},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			svc := newTestBookingService(pendingBooking())
+
+			_, err := svc.HandlePaymentNotification(context.Background(), c.n)
+			if !errors.Is(err, c.want) {
+				t.Fatalf("err = %v, want %v", err, c.want)
+			}
+			if len(svc.payments.applied) != 0 {
+				t.Fatal("a rejected notification must not be applied")
+			}
+		})
+	}
+}
+
+func TestPaymentNotificationIssuesTickets(t *testing.T) {
+	booking := pendingBooking()
+	svc := newTestBookingService(booking)
+	paid := *booking
+	paid.Status = model.BookingStatusPaid
+	svc.payments.notificationResult = appliedResult(&paid)
+
+	result, err := svc.HandlePaymentNotification(context.Background(), succeeded("200.00"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if result.Booking.Status != model.BookingStatusPaid {
+		t.Fatalf("booking status = %s, want paid", result.Booking.Status)
+	}
+	if len(svc.tickets.issued) != 1 {
+		t.Fatalf("want tickets issued once, got %d", len(svc.tickets.issued))
+	}
+	if len(svc.provider.refunds) != 0 {
+		t.Fatal("a paid order must not be refunded")
+	}
+}
+
+func TestDuplicatePaymentNotificationChangesNothing(t *testing.T) {
+	booking := pendingBooking()
+	svc := newTestBookingService(booking)
+	result := appliedResult(booking)
+	result.Duplicate = true
+	result.Orphaned = true // Handled the first time the event was applied
+	svc.payments.notificationResult = result
+
+	if _, err := svc.HandlePaymentNotification(context.Background(), succeeded("200.00")); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(svc.tickets.issued) != 0 || len(svc.provider.refunds) != 0 {
+		t.Fatal("a redelivered notification must not issue tickets or refund again")
+	}
+}
+
+func TestFailedPaymentNotificationIssuesNothing(t *testing.T) {
+	booking := pendingBooking()
+	svc := newTestBookingService(booking)
+	failed := *booking
+	failed.Status = model.BookingStatusFailed
+	svc.payments.notificationResult = appliedResult(&failed)
+
+	n := succeeded("0")
+	n.Status = model.PaymentEventFailed
+	n.FailureReason = "card expired"
+	if _, err := svc.HandlePaymentNotification(context.Background(), n); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(svc.tickets.issued) != 0 {
+		t.Fatal("a failed payment must not issue tickets")
+	}
+}
+
+func TestOrphanedPaymentIsRefunded(t *testing.T) {
+	booking := pendingBooking()
+	svc := newTestBookingService(booking)
+	expired := *booking
+	expired.Status = model.BookingStatusExpired
+	result := appliedResult(&expired)
+	result.Orphaned = true
+	svc.payments.notificationResult = result
+
+	if _, err := svc.HandlePaymentNotification(context.Background(), succeeded("200.00")); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(svc.tickets.issued) != 0 {
+		t.Fatal("an order that can no longer be paid must not get tickets")
+	}
+	if len(svc.provider.refunds) != 1 {
+		t.Fatalf("want one refund, got %d", len(svc.provider.refunds))
+	}
+	refund := svc.provider.refunds[0]
+	if refund.RefundID != testPaymentID || refund.TransactionID != "txn_1" || !refund.Amount.Equal(booking.TotalAmount) {
+		t.Fatalf("unexpected refund request %+v", refund)
+	}
+	if len(svc.payments.refunded) != 1 || !svc.payments.refunded[0].RefundedAmount.Equal(booking.TotalAmount) {
+		t.Fatal("want the full refund recorded on the payment")
+	}
+}
+
+func TestOrphanedPaymentRefundFailureIsRecorded(t *testing.T) {
+	booking := pendingBooking()
+	svc := newTestBookingService(booking)
+	result := appliedResult(booking)
+	result.Orphaned = true
+	svc.payments.notificationResult = result
+	svc.provider.err = errors.New("provider unavailable")
+
+	if _, err := svc.HandlePaymentNotification(context.Background(), succeeded("200.00")); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(svc.payments.refunded) != 0 {
+		t.Fatal("a failed refund must not be recorded as refunded")
+	}
+	if len(svc.payments.failed) != 1 || svc.payments.failed[0].Status != model.PaymentStatusFailed {
+		t.Fatal("want the payment marked failed with the refund error for reconciliation")
+	}
+}
+
+func paidBooking() (*model.Booking, *model.Payment) {
+	booking := pendingBooking()
+	booking.Status = model.BookingStatusPaid
+	transactionID := "txn_1"
+	return booking, &model.Payment{
+		ID:            testPaymentID,
+		OrderID:       booking.ID,
+		PaymentMethod: "card",
+		TransactionID: &transactionID,
+		Amount:        booking.TotalAmount,
+		Status:        model.PaymentStatusSuccess,
+	}
+}
+
+func TestRefundBookingQueuesTheRefund(t *testing.T) {
+	booking, payment := paidBooking()
+	svc := newTestBookingService(booking)
+	svc.payments.payments = []*model.Payment{payment}
+
+	pending, _, err := svc.RefundBooking(context.Background(), booking.ID, testUserID, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if pending.Status != model.BookingStatusRefundPending {
+		t.Fatalf("booking status = %s, want refund_pending", pending.Status)
+	}
+	if len(svc.payments.requested) != 1 || !svc.payments.requested[0].Equal(booking.TotalAmount) {
+		t.Fatalf("want the full amount requested a week ahead, got %v", svc.payments.requested)
+	}
+	if len(svc.provider.refunds) != 0 {
+		t.Fatal("the provider is called by the refund task, not the request")
+	}
+}
+
+func TestRefundBookingFollowsThePolicy(t *testing.T) {
+	half := decimal.RequireFromString("100.00")
+	tooMuch := decimal.RequireFromString("100.01")
+	cases := []struct {
+		name   string
+		notice time.Duration
+		amount *decimal.Decimal
+		want   error
+	}{
+		{"partial refund within the policy", 48 * time.Hour, &half, nil},
+		{"more than the policy allows", 48 * time.Hour, &tooMuch, ErrInvalidRefundAmount},
+		{"too close to the session", time.Hour, nil, ErrRefundNotAllowed},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			booking, payment := paidBooking()
+			svc := newTestBookingService(booking)
+			svc.payments.payments = []*model.Payment{payment}
+			svc.catalog.startTime = time.Now().Add(c.notice)
+
+			_, _, err := svc.RefundBooking(context.Background(), booking.ID, testUserID, c.amount)
+			if !errors.Is(err, c.want) {
+				t.Fatalf("err = %v, want %v", err, c.want)
+			}
+			if c.want != nil && len(svc.payments.requested) != 0 {
+				t.Fatal("a rejected refund must not be requested")
+			}
+		})
+	}
+}
+
+func TestRefundBookingRejectsOtherOrders(t *testing.T) {
+	booking, payment := paidBooking()
+	svc := newTestBookingService(booking)
+	svc.payments.payments = []*model.Payment{payment}
+
+	if _, _, err := svc.RefundBooking(context.Background(), booking.ID, "someone-else", nil); !errors.Is(err, ErrBookingNotFound) {
+		t.Fatalf("err = %v, want %v", err, ErrBookingNotFound)
+	}
+
+	booking.Status = model.BookingStatusRefundPending
+	if _, _, err := svc.RefundBooking(context.Background(), booking.ID, testUserID, nil); !errors.Is(err, ErrInvalidBookingState) {
+		t.Fatalf("err = %v, want %v", err, ErrInvalidBookingState)
+	}
+}
+
+func TestExpireOverdueBookings(t *testing.T) {
+	ids := []string{"order-1", "order-2", "order-3", "order-4"}
+	svc := newTestBookingService()
+	for _, id := range ids {
+		svc.bookings.bookings[id] = &model.Booking{ID: id, Status: model.BookingStatusPendingPayment}
+	}
+	svc.bookings.bookings["order-3"].Status = model.BookingStatusPaid // Paid while the worker was listing
+	svc.bookings.overdue = ids
+	svc.bookings.expireErr = map[string]error{"order-2": errors.New("deadlock detected")}
+
+	expired, failed, err := svc.ExpireOverdueBookings(context.Background(), 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if expired != 2 || failed != 1 {
+		t.Fatalf("expired, failed = %d, %d, want 2, 1", expired, failed)
+	}
+	want := map[string]model.BookingStatus{
+		"order-1": model.BookingStatusExpired,
+		"order-2": model.BookingStatusPendingPayment, // Left for the next run
+		"order-3": model.BookingStatusPaid,
+		"order-4": model.BookingStatusExpired,
+	}
+	for id, status := range want {
+		if got := svc.bookings.bookings[id].Status; got != status {
+			t.Errorf("%s status = %s, want %s", id, got, status)
+		}
+	}
+}
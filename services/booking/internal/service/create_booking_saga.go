@@ -0,0 +1,179 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	catalogv1 "github.com/wylu1037/go-micro-boilerplate/gen/go/catalog/v1"
+	"github.com/wylu1037/go-micro-boilerplate/pkg/middleware"
+	"github.com/wylu1037/go-micro-boilerplate/services/booking/internal/model"
+	"github.com/wylu1037/go-micro-boilerplate/services/booking/internal/saga"
+)
+
+const createBookingSagaName = "create_booking"
+
+// createBookingData is the payload shared by the steps of the create booking saga.
+// It is persisted as JSON after every step, so anything a later step or compensation
+// needs must be stored here rather than in local variables.
+type createBookingData struct {
+	OrderID    string          `json:"orderId"`
+	OrderNo    string          `json:"orderNo"`
+	UserID     string          `json:"userId"`
+	SessionID  string          `json:"sessionId"`
+	SeatAreaID string          `json:"seatAreaId"`
+	Quantity   int32           `json:"quantity"`
+	SeatIDs    []string        `json:"seatIds,omitempty"`
+	HoldToken  string          `json:"holdToken,omitempty"`
+	UnitPrice  decimal.Decimal `json:"unitPrice"`
+	ExpiresAt  time.Time       `json:"expiresAt"`
+
+	booking *model.Booking
+}
+
+// newCreateBookingSaga wires the create booking workflow:
+// check availability -> create order -> reserve seats (pivot) -> announce order. The order created
+// event, which the notification service turns into a confirmation, is only written once the seats are
+// reserved, so a compensated order is never announced.
+func (s *bookingService) newCreateBookingSaga() *saga.Orchestrator[createBookingData] {
+	return saga.New(saga.Definition[createBookingData]{
+		Name: createBookingSagaName,
+		Steps: []saga.Step[createBookingData]{
+			{Name: "check_availability", Action: s.checkAvailabilityStep},
+			{Name: "create_order", Action: s.createOrderStep, Compensate: s.cancelOrderStep},
+			{Name: "reserve_seats", Action: s.reserveSeatsStep, Compensate: s.releaseSeatsStep},
+			{Name: "announce_order", Action: s.announceOrderStep},
+		},
+		Pivot: 2,
+	}, s.sagaRepo, s.logger)
+}
+
+func (s *bookingService) checkAvailabilityStep(ctx context.Context, data *createBookingData) error {
+	checkResp, err := s.catalogClient.CheckAvailability(ctx, &catalogv1.CheckAvailabilityRequest{
+		SessionId:  data.SessionID,
+		SeatAreaId: data.SeatAreaID,
+		Quantity:   data.Quantity,
+		HoldToken:  data.HoldToken,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to check availability: %w", err)
+	}
+
+	if !checkResp.Available {
+		return ErrNotEnoughSeats
+	}
+
+	unitPrice, err := decimal.NewFromString(checkResp.Price)
+	if err != nil {
+		return fmt.Errorf("invalid price format from catalog: %w", err)
+	}
+	data.UnitPrice = unitPrice
+	return nil
+}
+
+func (s *bookingService) createOrderStep(ctx context.Context, data *createBookingData) error {
+	booking := &model.Booking{
+		ID:          data.OrderID,
+		OrderNo:     data.OrderNo,
+		UserID:      data.UserID,
+		SessionID:   data.SessionID,
+		SeatAreaID:  data.SeatAreaID,
+		Quantity:    data.Quantity,
+		SeatIDs:     data.SeatIDs,
+		UnitPrice:   data.UnitPrice,
+		TotalAmount: data.UnitPrice.Mul(decimal.NewFromInt32(data.Quantity)),
+		Status:      model.BookingStatusPendingPayment,
+		ExpiresAt:   &data.ExpiresAt,
+	}
+
+	change := model.StatusChange{ActorType: model.StatusActorUser, ActorID: data.UserID, Reason: "order created"}
+	if err := s.repo.Create(ctx, booking, change); err != nil {
+		return fmt.Errorf("failed to create booking record: %w", err)
+	}
+	data.booking = booking
+	return nil
+}
+
+// cancelOrderStep may run again during recovery, so an order that is already cancelled counts as done.
+func (s *bookingService) cancelOrderStep(ctx context.Context, data *createBookingData) error {
+	_, err := s.repo.Transition(ctx, data.OrderID, model.BookingStatusCancelled, model.StatusChange{
+		ActorType: model.StatusActorSystem,
+		ActorID:   "create_booking_saga",
+		Reason:    "booking saga compensated",
+	})
+	if !errors.Is(err, model.ErrInvalidTransition) {
+		return err
+	}
+
+	booking, getErr := s.repo.GetByID(ctx, data.OrderID)
+	if getErr != nil {
+		return getErr
+	}
+	if booking != nil && booking.Status == model.BookingStatusCancelled {
+		return nil
+	}
+	return err
+}
+
+// reserveSeatsStep and releaseSeatsStep call the catalog as the booking service: the seat inventory is
+// closed to users, whose token the incoming context would otherwise forward.
+func (s *bookingService) reserveSeatsStep(ctx context.Context, data *createBookingData) error {
+	callCtx, err := middleware.ServiceContext(ctx, s.microAuth, s.serviceName)
+	if err != nil {
+		return err
+	}
+
+	reserveResp, err := s.catalogClient.ReserveSeats(callCtx, &catalogv1.ReserveSeatsRequest{
+		SessionId:  data.SessionID,
+		SeatAreaId: data.SeatAreaID,
+		Quantity:   data.Quantity,
+		OrderId:    data.OrderID, // Using BookingID as OrderID
+		SeatIds:    data.SeatIDs,
+		HoldToken:  data.HoldToken,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to reserve seats: %w", err)
+	}
+
+	if !reserveResp.Success {
+		return errors.New(reserveResp.Message)
+	}
+	return nil
+}
+
+func (s *bookingService) releaseSeatsStep(ctx context.Context, data *createBookingData) error {
+	callCtx, err := middleware.ServiceContext(ctx, s.microAuth, s.serviceName)
+	if err != nil {
+		return err
+	}
+
+	releaseResp, err := s.catalogClient.ReleaseSeats(callCtx, &catalogv1.ReleaseSeatsRequest{
+		SessionId:  data.SessionID,
+		SeatAreaId: data.SeatAreaID,
+		Quantity:   data.Quantity,
+		OrderId:    data.OrderID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to release seats: %w", err)
+	}
+
+	if !releaseResp.Success {
+		return errors.New(releaseResp.Message)
+	}
+	return nil
+}
+
+func (s *bookingService) announceOrderStep(ctx context.Context, data *createBookingData) error {
+	booking, err := s.repo.Announce(ctx, data.OrderID)
+	if err != nil {
+		return fmt.Errorf("failed to announce order: %w", err)
+	}
+	if booking == nil {
+		return ErrBookingNotFound
+	}
+	data.booking = booking
+	return nil
+}
@@ -0,0 +1,103 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+
+	"github.com/wylu1037/go-micro-boilerplate/services/booking/internal/model"
+	paymentpkg "github.com/wylu1037/go-micro-boilerplate/services/booking/internal/payment"
+)
+
+func (s *bookingService) RunOrderTask(ctx context.Context, task *model.OutboxEvent) error {
+	switch task.EventType {
+	case model.OrderTaskReleaseSeats:
+		return s.releaseOrderSeats(ctx, task.AggregateID)
+	case model.OrderTaskRefundPayment:
+		return s.refundPayment(ctx, task)
+	default:
+		return fmt.Errorf("unknown order task %q", task.EventType)
+	}
+}
+
+func (s *bookingService) releaseOrderSeats(ctx context.Context, bookingID string) error {
+	booking, err := s.repo.GetByID(ctx, bookingID)
+	if err != nil {
+		return fmt.Errorf("failed to load booking: %w", err)
+	}
+	if booking == nil {
+		return nil
+	}
+
+	if releaseErr := s.releaseSeats(ctx, booking); releaseErr != nil {
+		return fmt.Errorf("failed to release seats: %w", releaseErr)
+	}
+
+	s.logger.Info("Seats released", zap.String("booking_id", booking.ID), zap.String("status", string(booking.Status)))
+	return nil
+}
+
+// refundPayment asks the provider for a refund RefundBooking requested. The task ID is the idempotency key,
+// so a retry after an unknown outcome (an error, or a crash before the result was stored) returns the first
+// outcome instead of paying out again. Errors are returned for the relay to retry; a refusal is final.
+func (s *bookingService) refundPayment(ctx context.Context, task *model.OutboxEvent) error {
+	var refund model.RefundTask
+	if err := json.Unmarshal(task.Payload, &refund); err != nil {
+		return fmt.Errorf("invalid refund task: %w", err)
+	}
+
+	payments, err := s.paymentRepo.ListByOrderID(ctx, task.AggregateID)
+	if err != nil {
+		return fmt.Errorf("failed to load payments: %w", err)
+	}
+	payment, found := lo.Find(payments, func(p *model.Payment) bool {
+		return p.ID == refund.PaymentID
+	})
+	if !found || payment.Status != model.PaymentStatusRefundPending {
+		return nil // Completed or declined by an earlier run
+	}
+
+	provider, err := s.paymentProviders.Get(payment.PaymentMethod)
+	if err != nil {
+		return err
+	}
+
+	result, err := provider.Refund(ctx, &paymentpkg.RefundRequest{
+		RefundID:      task.ID,
+		TransactionID: lo.FromPtr(payment.TransactionID),
+		Amount:        payment.RefundedAmount,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to refund payment: %w", err)
+	}
+
+	change := model.StatusChange{
+		ActorType: model.StatusActorProvider,
+		ActorID:   payment.PaymentMethod,
+		Reason:    "refund " + task.ID,
+	}
+	fields := []zap.Field{
+		zap.String("booking_id", payment.OrderID),
+		zap.String("payment_id", payment.ID),
+		zap.String("refund_id", task.ID),
+		zap.String("amount", payment.RefundedAmount.String()),
+	}
+
+	if result.Status != paymentpkg.StatusRefunded {
+		change.Reason += " declined: " + result.Message
+		if _, declineErr := s.paymentRepo.DeclineRefund(ctx, payment, "refund declined: "+result.Message, change); declineErr != nil {
+			return fmt.Errorf("failed to record declined refund: %w", declineErr)
+		}
+		s.logger.Warn("Refund declined by provider", append(fields, zap.String("message", result.Message))...)
+		return nil
+	}
+
+	if _, completeErr := s.paymentRepo.CompleteRefund(ctx, payment, change); completeErr != nil {
+		return fmt.Errorf("failed to record refund: %w", completeErr)
+	}
+	s.logger.Info("Booking refunded", fields...)
+	return nil
+}
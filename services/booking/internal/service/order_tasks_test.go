@@ -0,0 +1,109 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/wylu1037/go-micro-boilerplate/services/booking/internal/model"
+	paymentpkg "github.com/wylu1037/go-micro-boilerplate/services/booking/internal/payment"
+)
+
+const testRefundTaskID = "9a8b7c6d-5e4f-4a3b-8c2d-1e0f9a8b7c00"
+
+// refundPendingPayment returns a payment with a refund of 150.00 requested, and the task that refunds it.
+func refundPendingPayment(t *testing.T) (*model.Payment, *model.OutboxEvent) {
+	t.Helper()
+	_, payment := paidBooking()
+	payment.Status = model.PaymentStatusRefundPending
+	payment.RefundedAmount = decimal.RequireFromString("150.00")
+
+	payload, err := json.Marshal(model.RefundTask{PaymentID: payment.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return payment, &model.OutboxEvent{
+		ID:          testRefundTaskID,
+		AggregateID: payment.OrderID,
+		EventType:   model.OrderTaskRefundPayment,
+		Payload:     payload,
+	}
+}
+
+func TestRefundTaskCompletesTheRefund(t *testing.T) {
+	payment, task := refundPendingPayment(t)
+	svc := newTestBookingService()
+	svc.payments.payments = []*model.Payment{payment}
+
+	if err := svc.RunOrderTask(context.Background(), task); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(svc.provider.refunds) != 1 {
+		t.Fatalf("want one refund, got %d", len(svc.provider.refunds))
+	}
+	refund := svc.provider.refunds[0]
+	if refund.RefundID != testRefundTaskID {
+		t.Fatalf("refund ID = %q, want the task ID as idempotency key", refund.RefundID)
+	}
+	if refund.TransactionID != "txn_1" || !refund.Amount.Equal(payment.RefundedAmount) {
+		t.Fatalf("unexpected refund request %+v", refund)
+	}
+	if len(svc.payments.completed) != 1 || len(svc.payments.declined) != 0 {
+		t.Fatal("want the refund completed")
+	}
+}
+
+func TestRefundTaskRecordsDeclines(t *testing.T) {
+	payment, task := refundPendingPayment(t)
+	svc := newTestBookingService()
+	svc.payments.payments = []*model.Payment{payment}
+	svc.provider.result = &paymentpkg.Result{Status: paymentpkg.StatusDeclined, Message: "card closed"}
+
+	if err := svc.RunOrderTask(context.Background(), task); err != nil {
+		t.Fatalf("a declined refund is final and must not be retried, got %v", err)
+	}
+
+	if len(svc.payments.completed) != 0 {
+		t.Fatal("a declined refund must not be completed")
+	}
+	if len(svc.payments.declined) != 1 || svc.payments.declined[0] != "refund declined: card closed" {
+		t.Fatalf("want the decline recorded, got %v", svc.payments.declined)
+	}
+}
+
+func TestRefundTaskRetriesUnknownOutcomes(t *testing.T) {
+	payment, task := refundPendingPayment(t)
+	svc := newTestBookingService()
+	svc.payments.payments = []*model.Payment{payment}
+	providerErr := errors.New("provider timed out")
+	svc.provider.err = providerErr
+
+	if err := svc.RunOrderTask(context.Background(), task); !errors.Is(err, providerErr) {
+		t.Fatalf("err = %v, want the provider error for the relay to retry", err)
+	}
+	if len(svc.payments.completed) != 0 || len(svc.payments.declined) != 0 {
+		t.Fatal("an unknown outcome must not be recorded")
+	}
+}
+
+func TestRefundTaskSkipsSettledRefunds(t *testing.T) {
+	for _, status := range []model.PaymentStatus{model.PaymentStatusRefunded, model.PaymentStatusSuccess} {
+		t.Run(string(status), func(t *testing.T) {
+			payment, task := refundPendingPayment(t)
+			payment.Status = status
+			svc := newTestBookingService()
+			svc.payments.payments = []*model.Payment{payment}
+
+			if err := svc.RunOrderTask(context.Background(), task); err != nil {
+				t.Fatal(err)
+			}
+			if len(svc.provider.refunds) != 0 {
+				t.Fatal("a refund settled by an earlier run must not be sent again")
+			}
+		})
+	}
+}
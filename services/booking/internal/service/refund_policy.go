@@ -0,0 +1,46 @@
+package service
+
+import (
+	"cmp"
+	"slices"
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/wylu1037/go-micro-boilerplate/pkg/config"
+)
+
+// defaultRefundPolicy applies when booking.refund_policy is not configured.
+var defaultRefundPolicy = []config.RefundRule{
+	{MinNotice: 72 * time.Hour, Percent: 100},
+	{MinNotice: 24 * time.Hour, Percent: 50},
+}
+
+// RefundPolicy decides how much of a paid booking may be refunded, based on how long before the
+// session starts the refund is requested.
+type RefundPolicy struct {
+	rules []config.RefundRule // Sorted by MinNotice, longest first
+}
+
+func NewRefundPolicy(cfg *config.Config) *RefundPolicy {
+	rules := slices.Clone(cfg.Booking.RefundPolicy)
+	if len(rules) == 0 {
+		rules = slices.Clone(defaultRefundPolicy)
+	}
+	slices.SortFunc(rules, func(a, b config.RefundRule) int {
+		return cmp.Compare(b.MinNotice, a.MinNotice)
+	})
+	return &RefundPolicy{rules: rules}
+}
+
+// MaxRefund returns the largest amount of paid that may be refunded at now for a session starting at
+// startTime; zero means the booking is no longer refundable.
+func (p *RefundPolicy) MaxRefund(paid decimal.Decimal, startTime, now time.Time) decimal.Decimal {
+	notice := startTime.Sub(now)
+	for _, rule := range p.rules {
+		if notice >= rule.MinNotice {
+			return paid.Mul(decimal.NewFromInt(int64(min(max(rule.Percent, 0), 100)))).Div(decimal.NewFromInt(100)).Round(2)
+		}
+	}
+	return decimal.Zero
+}
@@ -0,0 +1,53 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"go.uber.org/zap"
+
+	catalogv1 "github.com/wylu1037/go-micro-boilerplate/gen/go/catalog/v1"
+)
+
+// showChangesToNotify are the show fields ticket holders are told about when they change.
+var showChangesToNotify = []string{"title", "artist", "status"}
+
+func (s *bookingService) HandleShowChanged(ctx context.Context, event *catalogv1.ShowEvent) error {
+	var changed []string
+	for _, field := range event.ChangedFields {
+		if slices.Contains(showChangesToNotify, field) {
+			changed = append(changed, field)
+		}
+	}
+	if len(changed) == 0 {
+		return nil
+	}
+
+	resp, err := s.catalogClient.ListSessions(ctx, &catalogv1.ListSessionsRequest{ShowId: event.ShowId})
+	if err != nil {
+		return fmt.Errorf("failed to list sessions of show: %w", err)
+	}
+	if len(resp.Sessions) == 0 {
+		return nil
+	}
+
+	sessionIDs := make([]string, len(resp.Sessions))
+	for i, session := range resp.Sessions {
+		sessionIDs[i] = session.SessionId
+	}
+
+	reason := fmt.Sprintf("show %s updated: %s", event.Title, strings.Join(changed, ", "))
+	written, err := s.repo.RecordShowChanged(ctx, sessionIDs, event.EventId, reason)
+	if err != nil {
+		return fmt.Errorf("failed to record show change: %w", err)
+	}
+
+	s.logger.Info("Show change recorded for active orders",
+		zap.String("show_id", event.ShowId),
+		zap.Strings("changed_fields", changed),
+		zap.Int("orders", written),
+	)
+	return nil
+}
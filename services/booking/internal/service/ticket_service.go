@@ -0,0 +1,291 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"go-micro.dev/v4/auth"
+	"go.uber.org/zap"
+
+	catalogv1 "github.com/wylu1037/go-micro-boilerplate/gen/go/catalog/v1"
+	"github.com/wylu1037/go-micro-boilerplate/pkg/config"
+	"github.com/wylu1037/go-micro-boilerplate/pkg/middleware"
+	"github.com/wylu1037/go-micro-boilerplate/services/booking/internal/model"
+	"github.com/wylu1037/go-micro-boilerplate/services/booking/internal/repository"
+	"github.com/wylu1037/go-micro-boilerplate/services/booking/internal/ticket"
+)
+
+var ErrTicketNotFound = errors.New("ticket not found")
+
+type TicketService interface {
+	// IssueTickets issues one ticket per seat of a paid booking. It is idempotent: tickets that already
+	// exist are kept, so it can be retried after a partial failure.
+	IssueTickets(ctx context.Context, booking *model.Booking) ([]*model.Ticket, error)
+	// IssueMissingTickets issues tickets for up to limit paid bookings that have none yet, e.g. because
+	// issuing right after payment failed, and returns how many bookings it handled.
+	IssueMissingTickets(ctx context.Context, limit int) (int, error)
+	ListTickets(ctx context.Context, userID string, page, pageSize int) ([]*model.Ticket, int64, error)
+	GetTicket(ctx context.Context, ticketID string, userID string) (*model.Ticket, error)
+	// VerificationKey returns the public key gate devices use to verify ticket QR codes offline.
+	VerificationKey() string
+	// ValidateTicket reports what checking the scanned payload in at sessionID would do, without doing it.
+	ValidateTicket(ctx context.Context, payload string, sessionID string) (*model.CheckInOutcome, error)
+	// CheckInTicket marks the scanned ticket used if it is valid for sessionID. A ticket is accepted once;
+	// later scans report a duplicate together with the original check-in.
+	CheckInTicket(ctx context.Context, sessionID, gateID, staffID string, scan model.CheckInScan) (*model.CheckInOutcome, error)
+	// SyncCheckIns checks in scans recorded by a gate while it was offline, in the order they were made.
+	SyncCheckIns(ctx context.Context, sessionID, gateID, staffID string, scans []model.CheckInScan) ([]*model.CheckInOutcome, error)
+}
+
+type ticketService struct {
+	repo          repository.TicketRepository
+	bookingRepo   repository.BookingRepository
+	signer        *ticket.Signer
+	catalogClient catalogv1.CatalogService
+	microAuth     auth.Auth
+	serviceName   string
+	logger        *zap.Logger
+}
+
+func NewTicketService(
+	repo repository.TicketRepository,
+	bookingRepo repository.BookingRepository,
+	signer *ticket.Signer,
+	catalogClient catalogv1.CatalogService,
+	microAuth auth.Auth,
+	cfg *config.Config,
+	logger *zap.Logger,
+) TicketService {
+	return &ticketService{
+		repo:          repo,
+		bookingRepo:   bookingRepo,
+		signer:        signer,
+		catalogClient: catalogClient,
+		microAuth:     microAuth,
+		serviceName:   cfg.Service.Name,
+		logger:        logger,
+	}
+}
+
+func (s *ticketService) IssueTickets(ctx context.Context, booking *model.Booking) ([]*model.Ticket, error) {
+	existing, err := s.repo.ListByOrderID(ctx, booking.ID)
+	if err != nil {
+		return nil, err
+	}
+	if len(existing) >= int(booking.Quantity) {
+		return existing, nil
+	}
+
+	seatIDs, err := s.bookedSeats(ctx, booking)
+	if err != nil {
+		return nil, err
+	}
+
+	issuedAt := time.Now().Unix()
+	tickets := make([]*model.Ticket, booking.Quantity)
+	for i := range tickets {
+		t := &model.Ticket{
+			ID:        uuid.NewString(),
+			OrderID:   booking.ID,
+			SessionID: booking.SessionID,
+			TicketNo:  ticketNo(booking.OrderNo, i+1),
+			Status:    model.TicketStatusValid,
+		}
+		claims := &ticket.Claims{
+			TicketID:  t.ID,
+			TicketNo:  t.TicketNo,
+			OrderID:   t.OrderID,
+			SessionID: t.SessionID,
+			IssuedAt:  issuedAt,
+		}
+		if i < len(seatIDs) {
+			t.SeatID = &seatIDs[i]
+			claims.SeatID = seatIDs[i]
+		}
+
+		qrCode, signErr := s.signer.Sign(claims)
+		if signErr != nil {
+			return nil, fmt.Errorf("failed to sign ticket: %w", signErr)
+		}
+		t.QRCode = qrCode
+		tickets[i] = t
+	}
+
+	if createErr := s.repo.CreateBatch(ctx, tickets); createErr != nil {
+		return nil, fmt.Errorf("failed to store tickets: %w", createErr)
+	}
+
+	s.logger.Info("Tickets issued", zap.String("booking_id", booking.ID), zap.Int32("quantity", booking.Quantity))
+
+	return s.repo.ListByOrderID(ctx, booking.ID)
+}
+
+// bookedSeats returns the seats of a booking. Bookings made by quantity get their seats picked by the
+// catalog, so those are read back from its reservation ledger; areas without a seat map have none.
+func (s *ticketService) bookedSeats(ctx context.Context, booking *model.Booking) ([]string, error) {
+	if len(booking.SeatIDs) > 0 {
+		return booking.SeatIDs, nil
+	}
+
+	callCtx, err := middleware.ServiceContext(ctx, s.microAuth, s.serviceName)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.catalogClient.ListReservations(callCtx, &catalogv1.ListReservationsRequest{OrderId: booking.ID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list seat reservations: %w", err)
+	}
+
+	seatIDs := []string{}
+	for _, reservation := range resp.Reservations {
+		if reservation.Status == catalogv1.ReservationStatus_RESERVATION_STATUS_RESERVED {
+			seatIDs = append(seatIDs, reservation.SeatIds...)
+		}
+	}
+	return seatIDs, nil
+}
+
+func (s *ticketService) IssueMissingTickets(ctx context.Context, limit int) (int, error) {
+	orderIDs, err := s.repo.ListPaidOrdersWithoutTickets(ctx, limit)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list bookings without tickets: %w", err)
+	}
+
+	for _, orderID := range orderIDs {
+		booking, getErr := s.bookingRepo.GetByID(ctx, orderID)
+		if getErr != nil {
+			return 0, getErr
+		}
+		if booking == nil {
+			continue
+		}
+		if _, issueErr := s.IssueTickets(ctx, booking); issueErr != nil {
+			s.logger.Warn("failed to issue tickets", zap.String("booking_id", orderID), zap.Error(issueErr))
+		}
+	}
+
+	return len(orderIDs), nil
+}
+
+func (s *ticketService) ListTickets(ctx context.Context, userID string, page, pageSize int) ([]*model.Ticket, int64, error) {
+	return s.repo.ListByUserID(ctx, userID, page, pageSize)
+}
+
+func (s *ticketService) GetTicket(ctx context.Context, ticketID string, userID string) (*model.Ticket, error) {
+	t, err := s.repo.GetByID(ctx, ticketID)
+	if err != nil {
+		return nil, err
+	}
+	if t == nil {
+		return nil, ErrTicketNotFound
+	}
+
+	booking, err := s.bookingRepo.GetByID(ctx, t.OrderID)
+	if err != nil {
+		return nil, err
+	}
+	if booking == nil || booking.UserID != userID {
+		return nil, ErrTicketNotFound
+	}
+	return t, nil
+}
+
+func (s *ticketService) VerificationKey() string {
+	return s.signer.PublicKey()
+}
+
+func (s *ticketService) ValidateTicket(ctx context.Context, payload string, sessionID string) (*model.CheckInOutcome, error) {
+	return s.inspect(ctx, payload, sessionID)
+}
+
+func (s *ticketService) CheckInTicket(ctx context.Context, sessionID, gateID, staffID string, scan model.CheckInScan) (*model.CheckInOutcome, error) {
+	outcome, err := s.inspect(ctx, scan.Payload, sessionID)
+	if err != nil || outcome.Result != model.CheckInAccepted {
+		return outcome, err
+	}
+
+	// Offline gates report when they scanned; never trust a time in the future.
+	usedAt := scan.ScannedAt
+	if usedAt.IsZero() || usedAt.After(time.Now()) {
+		usedAt = time.Now()
+	}
+
+	marked, err := s.repo.MarkUsed(ctx, outcome.Ticket, usedAt, gateID, staffID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check ticket in: %w", err)
+	}
+	if !marked {
+		// Another gate won the race; report what it recorded.
+		return s.inspect(ctx, scan.Payload, sessionID)
+	}
+
+	s.logger.Info("Ticket checked in",
+		zap.String("ticket_id", outcome.Ticket.ID),
+		zap.String("session_id", sessionID),
+		zap.String("gate_id", gateID),
+	)
+	return outcome, nil
+}
+
+func (s *ticketService) SyncCheckIns(ctx context.Context, sessionID, gateID, staffID string, scans []model.CheckInScan) ([]*model.CheckInOutcome, error) {
+	// Replay the scans in the order they were made, so the first scan of a ticket wins,
+	// but answer in the order the gate sent them.
+	order := make([]int, len(scans))
+	for i := range order {
+		order[i] = i
+	}
+	slices.SortStableFunc(order, func(a, b int) int {
+		return scans[a].ScannedAt.Compare(scans[b].ScannedAt)
+	})
+
+	results := make([]*model.CheckInOutcome, len(scans))
+	for _, i := range order {
+		outcome, err := s.CheckInTicket(ctx, sessionID, gateID, staffID, scans[i])
+		if err != nil {
+			return nil, err
+		}
+		results[i] = outcome
+	}
+	return results, nil
+}
+
+// inspect verifies the payload and matches it against the stored ticket and the session being scanned.
+func (s *ticketService) inspect(ctx context.Context, payload string, sessionID string) (*model.CheckInOutcome, error) {
+	claims, err := s.signer.Verify(payload)
+	if err != nil {
+		return &model.CheckInOutcome{Result: model.CheckInInvalid}, nil
+	}
+
+	t, err := s.repo.GetByID(ctx, claims.TicketID)
+	if err != nil {
+		return nil, err
+	}
+	if t == nil || t.TicketNo != claims.TicketNo || t.SessionID != claims.SessionID {
+		return &model.CheckInOutcome{Result: model.CheckInInvalid}, nil
+	}
+
+	outcome := &model.CheckInOutcome{Ticket: t}
+	switch {
+	case t.SessionID != sessionID:
+		outcome.Result = model.CheckInWrongSession
+	case t.Status == model.TicketStatusRefunded:
+		outcome.Result = model.CheckInRevoked
+	case t.Status == model.TicketStatusUsed:
+		outcome.Result = model.CheckInDuplicate
+	default:
+		outcome.Result = model.CheckInAccepted
+	}
+	return outcome, nil
+}
+
+// ticketNo derives the n-th ticket number from the order number (ORD<unix><hex>), so re-issuing an
+// order's tickets yields the same numbers.
+func ticketNo(orderNo string, n int) string {
+	return fmt.Sprintf("TKT%s%02d", strings.TrimPrefix(orderNo, "ORD"), n)
+}
@@ -0,0 +1,55 @@
+package subscriber
+
+import (
+	"context"
+
+	"go-micro.dev/v4/auth"
+	"go.uber.org/zap"
+
+	catalogv1 "github.com/wylu1037/go-micro-boilerplate/gen/go/catalog/v1"
+	"github.com/wylu1037/go-micro-boilerplate/pkg/config"
+	"github.com/wylu1037/go-micro-boilerplate/pkg/middleware"
+	"github.com/wylu1037/go-micro-boilerplate/services/booking/internal/service"
+)
+
+// ShowEventSubscriber consumes the show events the catalog service publishes and tells the holders of
+// active orders about the change through order events.
+type ShowEventSubscriber struct {
+	svc         service.BookingService
+	microAuth   auth.Auth
+	serviceName string
+	logger      *zap.Logger
+}
+
+func NewShowEventSubscriber(
+	cfg *config.Config,
+	svc service.BookingService,
+	microAuth auth.Auth,
+	logger *zap.Logger,
+) *ShowEventSubscriber {
+	return &ShowEventSubscriber{
+		svc:         svc,
+		microAuth:   microAuth,
+		serviceName: cfg.Service.Name,
+		logger:      logger,
+	}
+}
+
+// Handle is registered for the show changed topic. Returning an error leaves redelivery to the broker.
+func (s *ShowEventSubscriber) Handle(ctx context.Context, event *catalogv1.ShowEvent) error {
+	// Events carry no user token; catalog requires one to list the show's sessions.
+	callCtx, err := middleware.ServiceContext(ctx, s.microAuth, s.serviceName)
+	if err != nil {
+		return err
+	}
+
+	if handleErr := s.svc.HandleShowChanged(callCtx, event); handleErr != nil {
+		s.logger.Error("failed to handle show event",
+			zap.String("event_id", event.EventId),
+			zap.String("show_id", event.ShowId),
+			zap.Error(handleErr),
+		)
+		return handleErr
+	}
+	return nil
+}
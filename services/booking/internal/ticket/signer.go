@@ -0,0 +1,101 @@
+package ticket
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"github.com/wylu1037/go-micro-boilerplate/pkg/config"
+)
+
+// payloadPrefix versions the QR payload format: "<prefix>.<base64url claims>.<base64url signature>".
+const payloadPrefix = "TKT1"
+
+var ErrInvalidPayload = errors.New("invalid ticket payload")
+
+// Claims is what a ticket QR code asserts. Gate devices can check it offline with the public key.
+type Claims struct {
+	TicketID  string `json:"ticketId"`
+	TicketNo  string `json:"ticketNo"`
+	OrderID   string `json:"orderId"`
+	SessionID string `json:"sessionId"`
+	SeatID    string `json:"seatId,omitempty"`
+	IssuedAt  int64  `json:"issuedAt"`
+}
+
+// Signer signs and verifies ticket QR payloads with Ed25519, so anyone holding the public key can verify
+// a ticket without calling the booking service, but only the booking service can issue one.
+type Signer struct {
+	privateKey ed25519.PrivateKey
+	publicKey  ed25519.PublicKey
+}
+
+func NewSigner(cfg *config.Config, logger *zap.Logger) (*Signer, error) {
+	if cfg.Booking.TicketKey == "" {
+		logger.Warn("booking.ticket_key is not set; signing tickets with a throwaway key")
+		publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+		if err != nil {
+			return nil, fmt.Errorf("failed to generate ticket key: %w", err)
+		}
+		return &Signer{privateKey: privateKey, publicKey: publicKey}, nil
+	}
+
+	seed, err := base64.StdEncoding.DecodeString(cfg.Booking.TicketKey)
+	if err != nil {
+		return nil, fmt.Errorf("invalid booking.ticket_key: %w", err)
+	}
+	if len(seed) != ed25519.SeedSize {
+		return nil, fmt.Errorf("invalid booking.ticket_key: want %d bytes, got %d", ed25519.SeedSize, len(seed))
+	}
+
+	privateKey := ed25519.NewKeyFromSeed(seed)
+	return &Signer{privateKey: privateKey, publicKey: privateKey.Public().(ed25519.PublicKey)}, nil
+}
+
+// PublicKey returns the base64 public key gate devices need to verify tickets offline.
+func (s *Signer) PublicKey() string {
+	return base64.StdEncoding.EncodeToString(s.publicKey)
+}
+
+func (s *Signer) Sign(claims *Claims) (string, error) {
+	body, err := json.Marshal(claims)
+	if err != nil {
+		return "", err
+	}
+
+	signed := payloadPrefix + "." + base64.RawURLEncoding.EncodeToString(body)
+	signature := ed25519.Sign(s.privateKey, []byte(signed))
+	return signed + "." + base64.RawURLEncoding.EncodeToString(signature), nil
+}
+
+// Verify checks the payload's signature and returns its claims.
+func (s *Signer) Verify(payload string) (*Claims, error) {
+	parts := strings.Split(payload, ".")
+	if len(parts) != 3 || parts[0] != payloadPrefix {
+		return nil, ErrInvalidPayload
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, ErrInvalidPayload
+	}
+	if !ed25519.Verify(s.publicKey, []byte(parts[0]+"."+parts[1]), signature) {
+		return nil, ErrInvalidPayload
+	}
+
+	body, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return nil, ErrInvalidPayload
+	}
+	claims := &Claims{}
+	if unmarshalErr := json.Unmarshal(body, claims); unmarshalErr != nil {
+		return nil, ErrInvalidPayload
+	}
+	return claims, nil
+}
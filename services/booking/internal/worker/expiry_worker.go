@@ -0,0 +1,113 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/metric"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+
+	"github.com/wylu1037/go-micro-boilerplate/pkg/config"
+	"github.com/wylu1037/go-micro-boilerplate/services/booking/internal/service"
+)
+
+const (
+	defaultExpiryInterval  = 30 * time.Second
+	defaultExpiryBatchSize = 100
+)
+
+// ExpiryWorker periodically expires unpaid bookings; the outbox relay then returns their seats to the
+// catalog. It is safe to run on several replicas at once: each order is locked with
+// SELECT ... FOR UPDATE SKIP LOCKED while it is expired, so it is processed by exactly one replica.
+type ExpiryWorker struct {
+	svc       service.BookingService
+	batchSize int
+	logger    *zap.Logger
+
+	expiredCounter metric.Int64Counter
+	failedCounter  metric.Int64Counter
+
+	task *periodicTask
+}
+
+func NewExpiryWorker(
+	cfg *config.Config,
+	logger *zap.Logger,
+	svc service.BookingService,
+) *ExpiryWorker {
+	meter := otel.GetMeterProvider().Meter("booking_expiry")
+
+	expiredCounter, _ := meter.Int64Counter(
+		"booking_orders_expired_total",
+		metric.WithDescription("Total number of unpaid bookings expired by the reaper"),
+		metric.WithUnit("1"),
+	)
+
+	failedCounter, _ := meter.Int64Counter(
+		"booking_orders_expiry_failed_total",
+		metric.WithDescription("Total number of overdue bookings that could not be expired"),
+		metric.WithUnit("1"),
+	)
+
+	interval := cfg.Booking.ExpiryInterval
+	if interval <= 0 {
+		interval = defaultExpiryInterval
+	}
+	batchSize := cfg.Booking.ExpiryBatchSize
+	if batchSize <= 0 {
+		batchSize = defaultExpiryBatchSize
+	}
+
+	w := &ExpiryWorker{
+		svc:            svc,
+		batchSize:      batchSize,
+		logger:         logger,
+		expiredCounter: expiredCounter,
+		failedCounter:  failedCounter,
+	}
+	w.task = &periodicTask{
+		name:     "booking expiry worker",
+		interval: interval,
+		fn:       w.runOnce,
+		logger:   logger,
+	}
+	return w
+}
+
+// RunExpiryWorker ties the worker to the application lifecycle.
+func RunExpiryWorker(lc fx.Lifecycle, w *ExpiryWorker) {
+	lc.Append(fx.Hook{
+		OnStart: func(_ context.Context) error {
+			w.task.start()
+			return nil
+		},
+		OnStop: func(ctx context.Context) error {
+			return w.task.stop(ctx)
+		},
+	})
+}
+
+// runOnce drains overdue bookings in batches until a scan comes back short.
+func (w *ExpiryWorker) runOnce(ctx context.Context) {
+	for {
+		expired, failed, err := w.svc.ExpireOverdueBookings(ctx, w.batchSize)
+		if err != nil {
+			w.logger.Error("failed to expire overdue bookings", zap.Error(err))
+			return
+		}
+
+		if expired > 0 {
+			w.expiredCounter.Add(ctx, int64(expired))
+		}
+		if failed > 0 {
+			w.failedCounter.Add(ctx, int64(failed))
+		}
+
+		// Stop when the batch was not full, or when nothing could be expired to avoid spinning on failures.
+		if expired+failed < w.batchSize || expired == 0 {
+			return
+		}
+	}
+}
@@ -0,0 +1,180 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	"go-micro.dev/v4"
+	"go-micro.dev/v4/auth"
+	"go-micro.dev/v4/broker"
+	"go-micro.dev/v4/transport/headers"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/metric"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+
+	"github.com/wylu1037/go-micro-boilerplate/pkg/config"
+	"github.com/wylu1037/go-micro-boilerplate/pkg/middleware"
+	"github.com/wylu1037/go-micro-boilerplate/services/booking/internal/model"
+	"github.com/wylu1037/go-micro-boilerplate/services/booking/internal/repository"
+	"github.com/wylu1037/go-micro-boilerplate/services/booking/internal/service"
+)
+
+const (
+	outboxRelayInterval    = time.Second
+	outboxRelayBatchSize   = 100
+	outboxMaxAttempts      = 10
+	outboxBaseRetryBackoff = 2 * time.Second
+	outboxMaxRetryBackoff  = 10 * time.Minute
+)
+
+// OutboxRelay publishes booking events from the outbox to the message broker. An event is marked published
+// only after the broker accepted it; events that keep failing are retried with exponential backoff and
+// finally marked dead. Delivery is at least once only with a broker that persists a message before
+// accepting it: the default http broker accepts messages into memory and delivers them afterwards, so
+// events it holds are lost if the process stops. Order tasks are run in-process instead of published and
+// retried until they succeed.
+type OutboxRelay struct {
+	repo        repository.OutboxRepository
+	broker      broker.Broker
+	bookings    service.BookingService
+	microAuth   auth.Auth
+	serviceName string
+	logger      *zap.Logger
+
+	deadCounter metric.Int64Counter
+
+	task *periodicTask
+}
+
+func NewOutboxRelay(
+	cfg *config.Config,
+	logger *zap.Logger,
+	microService micro.Service,
+	microAuth auth.Auth,
+	repo repository.OutboxRepository,
+	bookings service.BookingService,
+) *OutboxRelay {
+	meter := otel.GetMeterProvider().Meter("booking_outbox")
+	deadCounter, _ := meter.Int64Counter(
+		"booking_outbox_dead_total",
+		metric.WithDescription("Total number of outbox events given up on after too many failed attempts"),
+		metric.WithUnit("1"),
+	)
+
+	r := &OutboxRelay{
+		repo:        repo,
+		broker:      microService.Options().Broker,
+		bookings:    bookings,
+		microAuth:   microAuth,
+		serviceName: cfg.Service.Name,
+		logger:      logger,
+		deadCounter: deadCounter,
+	}
+	if r.broker.String() == "http" {
+		logger.Warn("the http broker acknowledges events before delivering them, so events can be lost; use a persistent broker in production")
+	}
+	r.task = &periodicTask{
+		name:     "outbox relay",
+		interval: outboxRelayInterval,
+		fn:       r.runOnce,
+		logger:   logger,
+	}
+	return r
+}
+
+// RunOutboxRelay ties the relay to the application lifecycle.
+func RunOutboxRelay(lc fx.Lifecycle, r *OutboxRelay) {
+	lc.Append(fx.Hook{
+		OnStart: func(_ context.Context) error {
+			r.task.start()
+			return nil
+		},
+		OnStop: func(ctx context.Context) error {
+			return r.task.stop(ctx)
+		},
+	})
+}
+
+func (r *OutboxRelay) runOnce(ctx context.Context) {
+	events, err := r.repo.Relay(ctx, outboxRelayBatchSize, outboxMaxAttempts, outboxBackoff, r.publish)
+	if err != nil {
+		r.logger.Error("failed to relay outbox events", zap.Error(err))
+		return
+	}
+
+	published := 0
+	for _, e := range events {
+		switch e.Status {
+		case model.OutboxStatusPublished:
+			published++
+		case model.OutboxStatusDead:
+			r.deadCounter.Add(ctx, 1)
+			r.logger.Error("outbox event dead-lettered",
+				zap.String("event_id", e.ID),
+				zap.String("event_type", e.EventType),
+				zap.Int("attempts", e.Attempts),
+				zap.Stringp("last_error", e.LastError),
+			)
+		default:
+			if model.IsOrderTask(e.EventType) && e.Attempts >= outboxMaxAttempts {
+				// Tasks are never dead-lettered, so make a task that keeps failing as loud as a dead letter
+				r.logger.Error("outbox task keeps failing",
+					zap.String("event_id", e.ID),
+					zap.String("event_type", e.EventType),
+					zap.String("aggregate_id", e.AggregateID),
+					zap.Int("attempts", e.Attempts),
+					zap.Time("next_attempt_at", e.NextAttemptAt),
+					zap.Stringp("last_error", e.LastError),
+				)
+				continue
+			}
+			r.logger.Warn("failed to publish outbox event",
+				zap.String("event_id", e.ID),
+				zap.String("event_type", e.EventType),
+				zap.Int("attempts", e.Attempts),
+				zap.Time("next_attempt_at", e.NextAttemptAt),
+				zap.Stringp("last_error", e.LastError),
+			)
+		}
+	}
+	if published > 0 {
+		r.logger.Debug("Published outbox events", zap.Int("count", published))
+	}
+}
+
+func (r *OutboxRelay) publish(ctx context.Context, e *model.OutboxEvent) error {
+	if model.IsOrderTask(e.EventType) {
+		return r.runTask(ctx, e)
+	}
+
+	return r.broker.Publish(e.EventType, &broker.Message{
+		Header: map[string]string{
+			// Micro-Topic lets go-micro servers route the message to the subscriber of the topic.
+			headers.Message: e.EventType,
+			"Content-Type":  "application/json",
+			"Event-Id":      e.ID,
+			"Event-Type":    e.EventType,
+			"Aggregate-Id":  e.AggregateID,
+		},
+		Body: e.Payload,
+	})
+}
+
+// runTask runs an order task; calls to other services need the service's own identity.
+func (r *OutboxRelay) runTask(ctx context.Context, e *model.OutboxEvent) error {
+	callCtx, ctxErr := middleware.ServiceContext(ctx, r.microAuth, r.serviceName)
+	if ctxErr != nil {
+		return ctxErr
+	}
+	return r.bookings.RunOrderTask(callCtx, e)
+}
+
+// outboxBackoff doubles the retry delay with every failed attempt, up to outboxMaxRetryBackoff.
+func outboxBackoff(attempts int) time.Duration {
+	delay := outboxBaseRetryBackoff
+	for i := 1; i < attempts && delay < outboxMaxRetryBackoff; i++ {
+		delay *= 2
+	}
+	return min(delay, outboxMaxRetryBackoff)
+}
@@ -0,0 +1,153 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"go-micro.dev/v4/auth"
+	"go-micro.dev/v4/broker"
+	"go-micro.dev/v4/metadata"
+	"go-micro.dev/v4/transport/headers"
+	"go.opentelemetry.io/otel/metric/noop"
+	"go.uber.org/zap"
+
+	"github.com/wylu1037/go-micro-boilerplate/services/booking/internal/model"
+	"github.com/wylu1037/go-micro-boilerplate/services/booking/internal/repository"
+	"github.com/wylu1037/go-micro-boilerplate/services/booking/internal/service"
+)
+
+// fakeOutboxRepository hands its events to publish and records the outcome like the repository does,
+// leaving dead-lettering to the repository tests.
+type fakeOutboxRepository struct {
+	repository.OutboxRepository
+	events      []*model.OutboxEvent
+	maxAttempts int
+}
+
+func (r *fakeOutboxRepository) Relay(ctx context.Context, _, maxAttempts int, backoff func(int) time.Duration, publish func(context.Context, *model.OutboxEvent) error) ([]*model.OutboxEvent, error) {
+	r.maxAttempts = maxAttempts
+	for _, e := range r.events {
+		if err := publish(ctx, e); err != nil {
+			e.Attempts++
+			msg := err.Error()
+			e.LastError = &msg
+			e.NextAttemptAt = time.Now().Add(backoff(e.Attempts))
+			continue
+		}
+		e.Status = model.OutboxStatusPublished
+	}
+	return r.events, nil
+}
+
+// fakeBookingService records the order tasks it runs.
+type fakeBookingService struct {
+	service.BookingService
+	tasks   []*model.OutboxEvent
+	authz   []string
+	taskErr error
+}
+
+func (s *fakeBookingService) RunOrderTask(ctx context.Context, e *model.OutboxEvent) error {
+	s.tasks = append(s.tasks, e)
+	token, _ := metadata.Get(ctx, "Authorization")
+	s.authz = append(s.authz, token)
+	return s.taskErr
+}
+
+func newTestRelay(t *testing.T, repo repository.OutboxRepository, b broker.Broker, bookings service.BookingService) *OutboxRelay {
+	t.Helper()
+	deadCounter, _ := noop.NewMeterProvider().Meter("test").Int64Counter("dead")
+	return &OutboxRelay{
+		repo:        repo,
+		broker:      b,
+		bookings:    bookings,
+		microAuth:   auth.NewAuth(),
+		serviceName: "booking",
+		logger:      zap.NewNop(),
+		deadCounter: deadCounter,
+	}
+}
+
+func TestOutboxBackoff(t *testing.T) {
+	cases := []struct {
+		attempts int
+		want     time.Duration
+	}{
+		{1, 2 * time.Second},
+		{2, 4 * time.Second},
+		{3, 8 * time.Second},
+		{9, 512 * time.Second},
+		{10, outboxMaxRetryBackoff},
+		{1000, outboxMaxRetryBackoff},
+	}
+	for _, c := range cases {
+		if got := outboxBackoff(c.attempts); got != c.want {
+			t.Errorf("outboxBackoff(%d) = %s, want %s", c.attempts, got, c.want)
+		}
+	}
+}
+
+func TestRelayPublishesEventsAndRunsTasks(t *testing.T) {
+	b := broker.NewMemoryBroker()
+	if err := b.Connect(); err != nil {
+		t.Fatal(err)
+	}
+	var received []*broker.Message
+	if _, err := b.Subscribe(model.OrderEventPaid, func(e broker.Event) error {
+		received = append(received, e.Message())
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	event := &model.OutboxEvent{ID: "e1", AggregateID: "o1", EventType: model.OrderEventPaid, Payload: []byte(`{}`), Status: model.OutboxStatusPending}
+	task := &model.OutboxEvent{ID: "e2", AggregateID: "o1", EventType: model.OrderTaskReleaseSeats, Payload: []byte(`{}`), Status: model.OutboxStatusPending}
+	repo := &fakeOutboxRepository{events: []*model.OutboxEvent{event, task}}
+	bookings := &fakeBookingService{}
+
+	newTestRelay(t, repo, b, bookings).runOnce(context.Background())
+
+	if len(received) != 1 {
+		t.Fatalf("want the event on the broker, got %d messages", len(received))
+	}
+	if got := received[0].Header[headers.Message]; got != model.OrderEventPaid {
+		t.Fatalf("%s header = %q, want the topic", headers.Message, got)
+	}
+	if got := received[0].Header["Event-Id"]; got != "e1" {
+		t.Fatalf("Event-Id = %q, want e1", got)
+	}
+	if len(bookings.tasks) != 1 || bookings.tasks[0] != task {
+		t.Fatalf("want the task run in-process, got %v", bookings.tasks)
+	}
+	if bookings.authz[0] == "" {
+		t.Fatal("tasks must run with the service's own identity")
+	}
+	if event.Status != model.OutboxStatusPublished || task.Status != model.OutboxStatusPublished {
+		t.Fatalf("statuses = %s, %s, want both published", event.Status, task.Status)
+	}
+}
+
+func TestRelayRetriesFailures(t *testing.T) {
+	b := broker.NewMemoryBroker() // not connected, so every publish fails
+	event := &model.OutboxEvent{ID: "e1", EventType: model.OrderEventPaid, Status: model.OutboxStatusPending}
+	task := &model.OutboxEvent{ID: "e2", EventType: model.OrderTaskRefundPayment, Status: model.OutboxStatusPending, Attempts: outboxMaxAttempts}
+	repo := &fakeOutboxRepository{events: []*model.OutboxEvent{event, task}}
+	bookings := &fakeBookingService{taskErr: errors.New("provider unavailable")}
+
+	newTestRelay(t, repo, b, bookings).runOnce(context.Background())
+
+	if repo.maxAttempts != outboxMaxAttempts {
+		t.Fatalf("maxAttempts = %d, want %d", repo.maxAttempts, outboxMaxAttempts)
+	}
+	if event.Status != model.OutboxStatusPending || event.LastError == nil {
+		t.Fatalf("want the event left pending with its error, got %s", event.Status)
+	}
+	if task.LastError == nil || *task.LastError != "provider unavailable" {
+		t.Fatalf("want the task error recorded, got %v", task.LastError)
+	}
+	if wait := time.Until(task.NextAttemptAt); wait < outboxMaxRetryBackoff-time.Minute {
+		t.Fatalf("task retried in %s, want the capped backoff", wait)
+	}
+}
@@ -0,0 +1,70 @@
+package worker
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// periodicTask runs fn in a background goroutine every interval until stopped.
+type periodicTask struct {
+	name      string
+	interval  time.Duration
+	immediate bool // Run once right after start instead of waiting for the first tick
+	fn        func(ctx context.Context)
+	logger    *zap.Logger
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+func (t *periodicTask) start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.cancel = cancel
+
+	t.wg.Add(1)
+	go func() {
+		defer t.wg.Done()
+
+		t.logger.Info("Starting "+t.name, zap.Duration("interval", t.interval))
+
+		if t.immediate {
+			t.fn(ctx)
+		}
+
+		ticker := time.NewTicker(t.interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				t.fn(ctx)
+			}
+		}
+	}()
+}
+
+func (t *periodicTask) stop(ctx context.Context) error {
+	if t.cancel == nil {
+		return nil
+	}
+	t.cancel()
+
+	done := make(chan struct{})
+	go func() {
+		t.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		t.logger.Info("Stopped " + t.name)
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
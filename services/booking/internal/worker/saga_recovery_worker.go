@@ -0,0 +1,95 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	"go-micro.dev/v4/auth"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+
+	"github.com/wylu1037/go-micro-boilerplate/pkg/config"
+	"github.com/wylu1037/go-micro-boilerplate/pkg/middleware"
+	"github.com/wylu1037/go-micro-boilerplate/services/booking/internal/service"
+)
+
+const (
+	defaultSagaStaleAfter    = time.Minute
+	defaultSagaRecoveryBatch = 50
+)
+
+// SagaRecoveryWorker resumes or compensates booking sagas left unfinished by a crashed replica.
+// It runs once on startup and then periodically; a saga is only picked up after it has not been
+// touched for SagaStaleAfter, so sagas still being driven by a live replica are left alone.
+type SagaRecoveryWorker struct {
+	svc         service.BookingService
+	microAuth   auth.Auth
+	serviceName string
+	staleAfter  time.Duration
+	logger      *zap.Logger
+
+	task *periodicTask
+}
+
+func NewSagaRecoveryWorker(
+	cfg *config.Config,
+	logger *zap.Logger,
+	microAuth auth.Auth,
+	svc service.BookingService,
+) *SagaRecoveryWorker {
+	staleAfter := cfg.Booking.SagaStaleAfter
+	if staleAfter <= 0 {
+		staleAfter = defaultSagaStaleAfter
+	}
+
+	w := &SagaRecoveryWorker{
+		svc:         svc,
+		microAuth:   microAuth,
+		serviceName: cfg.Service.Name,
+		staleAfter:  staleAfter,
+		logger:      logger,
+	}
+	w.task = &periodicTask{
+		name:      "booking saga recovery worker",
+		interval:  staleAfter,
+		immediate: true,
+		fn:        w.runOnce,
+		logger:    logger,
+	}
+	return w
+}
+
+// RunSagaRecoveryWorker ties the worker to the application lifecycle.
+func RunSagaRecoveryWorker(lc fx.Lifecycle, w *SagaRecoveryWorker) {
+	lc.Append(fx.Hook{
+		OnStart: func(_ context.Context) error {
+			w.task.start()
+			return nil
+		},
+		OnStop: func(ctx context.Context) error {
+			return w.task.stop(ctx)
+		},
+	})
+}
+
+func (w *SagaRecoveryWorker) runOnce(ctx context.Context) {
+	callCtx, ctxErr := middleware.ServiceContext(ctx, w.microAuth, w.serviceName)
+	if ctxErr != nil {
+		w.logger.Error("failed to create service context for saga recovery", zap.Error(ctxErr))
+		return
+	}
+
+	for {
+		recovered, err := w.svc.RecoverSagas(callCtx, w.staleAfter, defaultSagaRecoveryBatch)
+		if err != nil {
+			w.logger.Error("failed to recover booking sagas", zap.Error(err))
+			return
+		}
+		if recovered > 0 {
+			w.logger.Info("Recovered booking sagas", zap.Int("count", recovered))
+		}
+		if recovered < defaultSagaRecoveryBatch {
+			return
+		}
+	}
+}
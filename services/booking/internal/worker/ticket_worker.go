@@ -0,0 +1,81 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	"go-micro.dev/v4/auth"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+
+	"github.com/wylu1037/go-micro-boilerplate/pkg/config"
+	"github.com/wylu1037/go-micro-boilerplate/pkg/middleware"
+	"github.com/wylu1037/go-micro-boilerplate/services/booking/internal/service"
+)
+
+const (
+	ticketIssueInterval  = time.Minute
+	ticketIssueBatchSize = 50
+)
+
+// TicketWorker issues tickets for paid bookings that have none, which happens when issuing them right
+// after the payment failed, e.g. because the catalog was unreachable.
+type TicketWorker struct {
+	tickets     service.TicketService
+	microAuth   auth.Auth
+	serviceName string
+	logger      *zap.Logger
+
+	task *periodicTask
+}
+
+func NewTicketWorker(
+	cfg *config.Config,
+	logger *zap.Logger,
+	microAuth auth.Auth,
+	tickets service.TicketService,
+) *TicketWorker {
+	w := &TicketWorker{
+		tickets:     tickets,
+		microAuth:   microAuth,
+		serviceName: cfg.Service.Name,
+		logger:      logger,
+	}
+	w.task = &periodicTask{
+		name:     "ticket issuance worker",
+		interval: ticketIssueInterval,
+		fn:       w.runOnce,
+		logger:   logger,
+	}
+	return w
+}
+
+// RunTicketWorker ties the worker to the application lifecycle.
+func RunTicketWorker(lc fx.Lifecycle, w *TicketWorker) {
+	lc.Append(fx.Hook{
+		OnStart: func(_ context.Context) error {
+			w.task.start()
+			return nil
+		},
+		OnStop: func(ctx context.Context) error {
+			return w.task.stop(ctx)
+		},
+	})
+}
+
+func (w *TicketWorker) runOnce(ctx context.Context) {
+	callCtx, ctxErr := middleware.ServiceContext(ctx, w.microAuth, w.serviceName)
+	if ctxErr != nil {
+		w.logger.Error("failed to create service context for ticket issuance", zap.Error(ctxErr))
+		return
+	}
+
+	handled, err := w.tickets.IssueMissingTickets(callCtx, ticketIssueBatchSize)
+	if err != nil {
+		w.logger.Error("failed to issue missing tickets", zap.Error(err))
+		return
+	}
+	if handled > 0 {
+		w.logger.Info("Issued missing tickets", zap.Int("bookings", handled))
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/go-micro/plugins/v4/wrapper/trace/opentelemetry"
 	"go-micro.dev/v4"
 	"go-micro.dev/v4/auth"
+	"go-micro.dev/v4/broker"
 	"go.uber.org/fx"
 	"go.uber.org/zap"
 
@@ -14,21 +15,41 @@ import (
 	"github.com/wylu1037/go-micro-boilerplate/services/catalog/internal/router"
 )
 
+// permissions restricts catalog administration to organizers and admins, and the seat inventory to the
+// booking service, which calls it with its service token. Reads stay open.
+var permissions = middleware.Permissions{
+	"CatalogService.CreateShow":     {middleware.RoleOrganizer, middleware.RoleAdmin},
+	"CatalogService.UpdateShow":     {middleware.RoleOrganizer, middleware.RoleAdmin},
+	"CatalogService.DeleteShow":     {middleware.RoleAdmin},
+	"CatalogService.CreateVenue":    {middleware.RoleAdmin},
+	"CatalogService.CreateSession":  {middleware.RoleOrganizer, middleware.RoleAdmin},
+	"CatalogService.CreateSeatArea": {middleware.RoleOrganizer, middleware.RoleAdmin},
+	"CatalogService.CreateSeatMap":  {middleware.RoleOrganizer, middleware.RoleAdmin},
+
+	"CatalogService.ReserveSeats":     {},
+	"CatalogService.ReleaseSeats":     {},
+	"CatalogService.ListReservations": {},
+}
+
 func NewMicroService(
 	logger *zap.Logger,
 	cfg *config.Config,
 	microAuth auth.Auth,
+	revocations middleware.RevocationStore,
+	eventBroker broker.Broker,
 ) micro.Service {
 	service := micro.NewService(
 		micro.Name(cfg.Service.Name),
 		micro.Version(cfg.Service.Version),
 		micro.Address(cfg.Service.Address),
 		micro.Auth(microAuth),
+		micro.Broker(eventBroker),
 		micro.WrapHandler(
 			opentelemetry.NewHandlerWrapper(), // Add Tracing
 			middleware.NewMetricsMiddleware(), // Add Metrics
 			middleware.NewRecoveryMiddleware(logger),
-			middleware.AuthWrapper(microAuth, []string{}),
+			middleware.AuthWrapper(microAuth, []string{}, middleware.WithRevocationStore(revocations)),
+			middleware.PermissionWrapper(permissions),
 			middleware.NewLoggingMiddleware(logger),
 			middleware.NewValidatorMiddleware(logger),
 		),
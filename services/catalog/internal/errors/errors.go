@@ -9,13 +9,22 @@ import (
 const serviceName = "catalog"
 
 var (
-	ErrShowNotFound      = stderrors.New("show not found")
-	ErrVenueNotFound     = stderrors.New("venue not found")
-	ErrSessionNotFound   = stderrors.New("session not found")
-	ErrSeatAreaNotFound  = stderrors.New("seat area not found")
-	ErrInsufficientSeats = stderrors.New("insufficient seats available")
-	ErrInvalidSeatArea   = stderrors.New("seat area does not belong to session")
-	ErrInvalidPrice      = stderrors.New("invalid price format")
+	ErrShowNotFound        = stderrors.New("show not found")
+	ErrVenueNotFound       = stderrors.New("venue not found")
+	ErrSessionNotFound     = stderrors.New("session not found")
+	ErrSeatAreaNotFound    = stderrors.New("seat area not found")
+	ErrInsufficientSeats   = stderrors.New("insufficient seats available")
+	ErrInvalidSeatArea     = stderrors.New("seat area does not belong to session")
+	ErrInvalidPrice        = stderrors.New("invalid price format")
+	ErrReservationReleased = stderrors.New("seat reservation already released for order")
+	ErrSeatNotFound        = stderrors.New("seat not found in seat area")
+	ErrSeatsUnavailable    = stderrors.New("selected seats are not available")
+	ErrSeatAreaInUse       = stderrors.New("seat area already has a seat map or reserved seats")
+	ErrInvalidQuantity     = stderrors.New("quantity does not match selected seats")
+	ErrInvalidSeatMap      = stderrors.New("invalid seat map")
+	ErrHoldNotFound        = stderrors.New("seat hold not found or expired")
+	ErrReservationNotFound = stderrors.New("seat reservation not found")
+	ErrHoldMismatch        = stderrors.New("seat hold does not match the requested seats")
 )
 
 func ToMicroError(err error) error {
@@ -38,6 +47,24 @@ func ToMicroError(err error) error {
 		return microerrors.BadRequest(serviceName, "seat area does not belong to session")
 	case stderrors.Is(err, ErrInvalidPrice):
 		return microerrors.BadRequest(serviceName, "invalid price format")
+	case stderrors.Is(err, ErrReservationReleased):
+		return microerrors.Conflict(serviceName, "seat reservation already released for order")
+	case stderrors.Is(err, ErrSeatNotFound):
+		return microerrors.BadRequest(serviceName, "seat not found in seat area")
+	case stderrors.Is(err, ErrSeatsUnavailable):
+		return microerrors.Conflict(serviceName, "selected seats are not available")
+	case stderrors.Is(err, ErrSeatAreaInUse):
+		return microerrors.Conflict(serviceName, "seat area already has a seat map or reserved seats")
+	case stderrors.Is(err, ErrInvalidQuantity):
+		return microerrors.BadRequest(serviceName, "quantity does not match selected seats")
+	case stderrors.Is(err, ErrInvalidSeatMap):
+		return microerrors.BadRequest(serviceName, "%s", err.Error())
+	case stderrors.Is(err, ErrHoldNotFound):
+		return microerrors.NotFound(serviceName, "seat hold not found or expired")
+	case stderrors.Is(err, ErrReservationNotFound):
+		return microerrors.NotFound(serviceName, "seat reservation not found")
+	case stderrors.Is(err, ErrHoldMismatch):
+		return microerrors.BadRequest(serviceName, "seat hold does not match the requested seats")
 	default:
 		return microerrors.InternalServerError(serviceName, "internal server error")
 	}
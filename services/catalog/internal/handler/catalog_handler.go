@@ -338,8 +338,80 @@ func (_ *CatalogHandler) convertSeatArea(sa *model.SeatArea) *catalogv1.SeatArea
 	}
 }
 
+func (h *CatalogHandler) CreateSeatMap(ctx context.Context, req *catalogv1.CreateSeatMapRequest, rsp *catalogv1.CreateSeatMapResponse) error {
+	seats := make([]*model.Seat, len(req.Seats))
+	for i, spec := range req.Seats {
+		status := model.SeatStatusAvailable
+		if spec.Blocked {
+			status = model.SeatStatusBlocked
+		}
+		seats[i] = &model.Seat{
+			SeatAreaID: spec.SeatAreaId,
+			Row:        spec.Row,
+			Number:     spec.Number,
+			X:          spec.X,
+			Y:          spec.Y,
+			Status:     status,
+		}
+	}
+
+	if err := h.svc.CreateSeatMap(ctx, req.SessionId, seats); err != nil {
+		return errors.ToMicroError(err)
+	}
+
+	rsp.Seats = make([]*catalogv1.Seat, len(seats))
+	for i, seat := range seats {
+		rsp.Seats[i] = h.convertSeat(seat)
+	}
+
+	return nil
+}
+
+func (h *CatalogHandler) ListSeats(ctx context.Context, req *catalogv1.ListSeatsRequest, rsp *catalogv1.ListSeatsResponse) error {
+	seats, err := h.svc.ListSeats(ctx, req.SeatAreaId)
+	if err != nil {
+		return errors.ToMicroError(err)
+	}
+
+	rsp.Seats = make([]*catalogv1.Seat, len(seats))
+	for i, seat := range seats {
+		rsp.Seats[i] = h.convertSeat(seat)
+	}
+
+	return nil
+}
+
+func (_ *CatalogHandler) convertSeat(seat *model.Seat) *catalogv1.Seat {
+	return &catalogv1.Seat{
+		SeatId:     seat.ID,
+		SessionId:  seat.SessionID,
+		SeatAreaId: seat.SeatAreaID,
+		Row:        seat.Row,
+		Number:     seat.Number,
+		X:          seat.X,
+		Y:          seat.Y,
+		Status:     toProtoSeatStatus(seat.Status),
+		CreatedAt:  tools.ToProtoTimestamp(seat.CreatedAt),
+	}
+}
+
+func toProtoSeatStatus(status model.SeatStatus) catalogv1.SeatStatus {
+	switch status {
+	case model.SeatStatusAvailable:
+		return catalogv1.SeatStatus_SEAT_STATUS_AVAILABLE
+	case model.SeatStatusReserved:
+		return catalogv1.SeatStatus_SEAT_STATUS_RESERVED
+	case model.SeatStatusBlocked:
+		return catalogv1.SeatStatus_SEAT_STATUS_BLOCKED
+	case model.SeatStatusHeld:
+		return catalogv1.SeatStatus_SEAT_STATUS_HELD
+	default:
+		return catalogv1.SeatStatus_SEAT_STATUS_UNSPECIFIED
+	}
+}
+
 func (h *CatalogHandler) CheckAvailability(ctx context.Context, req *catalogv1.CheckAvailabilityRequest, rsp *catalogv1.CheckAvailabilityResponse) error {
-	available, count, price, err := h.svc.CheckAvailability(ctx, req.SessionId, req.SeatAreaId, req.Quantity)
+	available, count, price, err := h.svc.CheckAvailability(ctx, req.SessionId, req.SeatAreaId, req.Quantity, req.HoldToken)
 	if err != nil {
 		return errors.ToMicroError(err)
 	}
@@ -350,8 +422,21 @@ func (h *CatalogHandler) CheckAvailability(ctx context.Context, req *catalogv1.C
 	return nil
 }
 
+func (h *CatalogHandler) HoldSeats(ctx context.Context, req *catalogv1.HoldSeatsRequest, rsp *catalogv1.HoldSeatsResponse) error {
+	hold, err := h.svc.HoldSeats(ctx, req.SessionId, req.SeatAreaId, req.Quantity, req.SeatIds)
+	if err != nil {
+		return errors.ToMicroError(err)
+	}
+
+	rsp.HoldToken = hold.Token
+	rsp.Quantity = hold.Quantity
+	rsp.SeatIds = hold.SeatIDs
+	rsp.ExpiresAt = tools.ToProtoTimestamp(hold.ExpiresAt)
+	return nil
+}
+
 func (h *CatalogHandler) ReserveSeats(ctx context.Context, req *catalogv1.ReserveSeatsRequest, rsp *catalogv1.ReserveSeatsResponse) error {
-	err := h.svc.ReserveSeats(ctx, req.SessionId, req.SeatAreaId, req.Quantity, req.OrderId)
+	err := h.svc.ReserveSeats(ctx, req.SessionId, req.SeatAreaId, req.Quantity, req.SeatIds, req.HoldToken, req.OrderId)
 	if err != nil {
 		return errors.ToMicroError(err)
 	}
@@ -371,3 +456,44 @@ func (h *CatalogHandler) ReleaseSeats(ctx context.Context, req *catalogv1.Releas
 	rsp.Message = "Seats released"
 	return nil
 }
+
+func (h *CatalogHandler) ListReservations(ctx context.Context, req *catalogv1.ListReservationsRequest, rsp *catalogv1.ListReservationsResponse) error {
+	reservations, err := h.svc.ListReservations(ctx, req.OrderId)
+	if err != nil {
+		return errors.ToMicroError(err)
+	}
+
+	rsp.Reservations = make([]*catalogv1.SeatReservation, len(reservations))
+	for i, r := range reservations {
+		rsp.Reservations[i] = h.convertSeatReservation(r)
+	}
+
+	return nil
+}
+
+func (_ *CatalogHandler) convertSeatReservation(r *model.SeatReservation) *catalogv1.SeatReservation {
+	return &catalogv1.SeatReservation{
+		ReservationId: r.ID,
+		OrderId:       r.OrderID,
+		SessionId:     r.SessionID,
+		SeatAreaId:    r.SeatAreaID,
+		Quantity:      r.Quantity,
+		Status:        toProtoReservationStatus(r.Status),
+		CreatedAt:     tools.ToProtoTimestamp(r.CreatedAt),
+		UpdatedAt:     tools.ToProtoTimestamp(r.UpdatedAt),
+		SeatIds:       r.SeatIDs,
+	}
+}
+
+func toProtoReservationStatus(status model.SeatReservationStatus) catalogv1.ReservationStatus {
+	switch status {
+	case model.SeatReservationStatusReserved:
+		return catalogv1.ReservationStatus_RESERVATION_STATUS_RESERVED
+	case model.SeatReservationStatusReleased:
+		return catalogv1.ReservationStatus_RESERVATION_STATUS_RELEASED
+	case model.SeatReservationStatusRejected:
+		return catalogv1.ReservationStatus_RESERVATION_STATUS_REJECTED
+	default:
+		return catalogv1.ReservationStatus_RESERVATION_STATUS_UNSPECIFIED
+	}
+}
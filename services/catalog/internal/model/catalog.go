@@ -18,6 +18,14 @@ type Show struct {
 	UpdatedAt   time.Time
 }
 
+// Catalog event types, also used as broker topics.
+const (
+	// ShowEventChanged carries a catalogv1.ShowEvent when a show is updated.
+	ShowEventChanged = "ticketing.catalog.show.changed"
+	// InventoryEventChanged carries a catalogv1.InventoryEvent when the availability of a seat area changes.
+	InventoryEventChanged = "ticketing.catalog.inventory.changed"
+)
+
 type Venue struct {
 	ID        string
 	Name      string
@@ -49,3 +57,65 @@ type SeatArea struct {
 	AvailableSeats int32
 	CreatedAt      time.Time
 }
+
+type SeatReservationStatus string
+
+const (
+	SeatReservationStatusReserved SeatReservationStatus = "reserved"
+	SeatReservationStatusReleased SeatReservationStatus = "released"
+	SeatReservationStatusRejected SeatReservationStatus = "rejected" // Not enough seats when the reservation was attempted
+)
+
+// SeatReservation is the ledger entry for the seats an order holds in one seat area.
+type SeatReservation struct {
+	ID         string
+	OrderID    string
+	SessionID  string
+	SeatAreaID string
+	Quantity   int32
+	SeatIDs    []string // Specific seats held; empty for quantity-only reservations in areas without a seat map
+	Status     SeatReservationStatus
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+type SeatStatus string
+
+const (
+	SeatStatusAvailable SeatStatus = "available"
+	SeatStatusReserved  SeatStatus = "reserved"
+	SeatStatusBlocked   SeatStatus = "blocked" // Not for sale, e.g. obstructed view or held back by the organizer
+	SeatStatusHeld      SeatStatus = "held"    // Never stored; reported for available seats under an active hold
+)
+
+// Seat is a single numbered seat on the seat map of a seat area.
+type Seat struct {
+	ID         string
+	SessionID  string
+	SeatAreaID string
+	Row        string
+	Number     int32
+	X          float64
+	Y          float64
+	Status     SeatStatus
+	OrderID    *string
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+// SeatHold is a short-lived claim on seats that is subtracted from availability until it is
+// converted into a reservation or lapses at ExpiresAt.
+type SeatHold struct {
+	Token      string    `json:"token"`
+	SessionID  string    `json:"sessionId"`
+	SeatAreaID string    `json:"seatAreaId"`
+	Quantity   int32     `json:"quantity"`
+	SeatIDs    []string  `json:"seatIds,omitempty"`
+	ExpiresAt  time.Time `json:"expiresAt"`
+}
+
+// HeldSeats summarises the active holds of a seat area.
+type HeldSeats struct {
+	Quantity int32
+	SeatIDs  []string
+}
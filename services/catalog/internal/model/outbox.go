@@ -0,0 +1,25 @@
+package model
+
+import "time"
+
+type OutboxStatus string
+
+const (
+	OutboxStatusPending   OutboxStatus = "pending"
+	OutboxStatusPublished OutboxStatus = "published"
+	OutboxStatusDead      OutboxStatus = "dead" // Gave up after too many failed attempts, needs manual attention
+)
+
+// OutboxEvent is a catalog event written in the transaction that caused it and published by the relay.
+type OutboxEvent struct {
+	ID            string
+	AggregateID   string
+	EventType     string
+	Payload       []byte
+	Status        OutboxStatus
+	Attempts      int
+	NextAttemptAt time.Time
+	LastError     *string
+	CreatedAt     time.Time
+	PublishedAt   *time.Time
+}
@@ -5,6 +5,7 @@ import (
 	"github.com/wylu1037/go-micro-boilerplate/services/catalog/internal/repository"
 	"github.com/wylu1037/go-micro-boilerplate/services/catalog/internal/rpc"
 	"github.com/wylu1037/go-micro-boilerplate/services/catalog/internal/service"
+	"github.com/wylu1037/go-micro-boilerplate/services/catalog/internal/worker"
 	"go.uber.org/fx"
 )
 
@@ -15,8 +16,15 @@ var Module = fx.Module(
 		repository.NewVenueRepository,
 		repository.NewSessionRepository,
 		repository.NewSeatAreaRepository,
+		repository.NewSeatRepository,
+		repository.NewSeatReservationRepository,
+		repository.NewSeatHoldRepository,
+		repository.NewOutboxRepository,
 		service.NewCatalogService,
 		handler.NewCatalogHandler,
 		rpc.NewIdentityService,
+		rpc.NewEventPublisher,
+		worker.NewOutboxRelay,
 	),
+	fx.Invoke(worker.RunOutboxRelay),
 )
@@ -0,0 +1,144 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	catalogv1 "github.com/wylu1037/go-micro-boilerplate/gen/go/catalog/v1"
+	"github.com/wylu1037/go-micro-boilerplate/pkg/db"
+	"github.com/wylu1037/go-micro-boilerplate/services/catalog/internal/model"
+)
+
+type OutboxRepository interface {
+	// Relay claims up to limit pending events that are due and calls publish for each of them. Published
+	// events are marked published; a failed event is retried after backoff(attempts), or marked dead once
+	// it has failed maxAttempts times. Rows are locked with FOR UPDATE SKIP LOCKED, so concurrent replicas
+	// never publish the same event at the same time. It returns the claimed events with their new status.
+	Relay(ctx context.Context, limit, maxAttempts int, backoff func(attempts int) time.Duration, publish func(ctx context.Context, event *model.OutboxEvent) error) ([]*model.OutboxEvent, error)
+}
+
+type outboxRepository struct {
+	db *db.Pool
+}
+
+func NewOutboxRepository(db *db.Pool) OutboxRepository {
+	return &outboxRepository{db: db}
+}
+
+func (repo *outboxRepository) Relay(ctx context.Context, limit, maxAttempts int, backoff func(attempts int) time.Duration, publish func(ctx context.Context, event *model.OutboxEvent) error) ([]*model.OutboxEvent, error) {
+	selectQuery := `
+		SELECT id, aggregate_id, event_type, payload, status, attempts, next_attempt_at, last_error, created_at, published_at
+		FROM catalog.outbox
+		WHERE status = $1 AND next_attempt_at <= NOW()
+		ORDER BY created_at
+		LIMIT $2
+		FOR UPDATE SKIP LOCKED
+	`
+
+	publishedQuery := `
+		UPDATE catalog.outbox
+		SET status = $1, attempts = attempts + 1, last_error = NULL, published_at = NOW()
+		WHERE id = $2
+		RETURNING attempts, published_at
+	`
+
+	failedQuery := `
+		UPDATE catalog.outbox
+		SET status = $1, attempts = $2, next_attempt_at = $3, last_error = $4
+		WHERE id = $5
+	`
+
+	events := []*model.OutboxEvent{}
+	err := repo.db.Transaction(ctx, func(tx pgx.Tx) error {
+		rows, queryErr := tx.Query(ctx, selectQuery, model.OutboxStatusPending, limit)
+		if queryErr != nil {
+			return queryErr
+		}
+
+		for rows.Next() {
+			e := &model.OutboxEvent{}
+			if scanErr := rows.Scan(
+				&e.ID,
+				&e.AggregateID,
+				&e.EventType,
+				&e.Payload,
+				&e.Status,
+				&e.Attempts,
+				&e.NextAttemptAt,
+				&e.LastError,
+				&e.CreatedAt,
+				&e.PublishedAt,
+			); scanErr != nil {
+				rows.Close()
+				return scanErr
+			}
+			events = append(events, e)
+		}
+		rows.Close()
+		if rowsErr := rows.Err(); rowsErr != nil {
+			return rowsErr
+		}
+
+		for _, e := range events {
+			publishErr := publish(ctx, e)
+			if publishErr == nil {
+				if updateErr := tx.QueryRow(ctx, publishedQuery, model.OutboxStatusPublished, e.ID).Scan(&e.Attempts, &e.PublishedAt); updateErr != nil {
+					return updateErr
+				}
+				e.Status = model.OutboxStatusPublished
+				e.LastError = nil
+				continue
+			}
+
+			e.Attempts++
+			lastError := publishErr.Error()
+			e.LastError = &lastError
+			if e.Attempts >= maxAttempts {
+				e.Status = model.OutboxStatusDead
+			} else {
+				e.NextAttemptAt = time.Now().Add(backoff(e.Attempts))
+			}
+			if _, updateErr := tx.Exec(ctx, failedQuery, e.Status, e.Attempts, e.NextAttemptAt, e.LastError, e.ID); updateErr != nil {
+				return updateErr
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return events, nil
+}
+
+// writeShowEvent writes a model.ShowEventChanged event for the show into the outbox, inside the
+// transaction that changed it, so the event is published if and only if the change commits.
+func writeShowEvent(ctx context.Context, tx pgx.Tx, eventID string, show *model.Show, changedFields []string) error {
+	query := `
+		INSERT INTO catalog.outbox (id, aggregate_id, event_type, payload)
+		VALUES ($1, $2, $3, $4)
+	`
+
+	event := &catalogv1.ShowEvent{
+		EventId:       eventID,
+		EventType:     model.ShowEventChanged,
+		OccurredAt:    timestamppb.Now(),
+		ShowId:        show.ID,
+		Title:         show.Title,
+		Status:        show.Status,
+		ChangedFields: changedFields,
+	}
+
+	payload, err := protojson.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(ctx, query, eventID, show.ID, model.ShowEventChanged, payload)
+	return err
+}
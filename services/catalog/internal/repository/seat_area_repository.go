@@ -16,8 +16,6 @@ type SeatAreaRepository interface {
 	GetByID(ctx context.Context, id string) (*model.SeatArea, error)
 	ListBySessionID(ctx context.Context, sessionID string) ([]*model.SeatArea, error)
 	UpdateAvailableSeats(ctx context.Context, id string, delta int32) error
-	CheckAndReserve(ctx context.Context, id string, quantity int32) (bool, error)
-	ReleaseSeats(ctx context.Context, id string, quantity int32) error
 }
 
 type seatAreaRepository struct {
@@ -123,44 +121,3 @@ func (repo *seatAreaRepository) UpdateAvailableSeats(ctx context.Context, id str
 
 	return nil
 }
-
-func (repo *seatAreaRepository) CheckAndReserve(ctx context.Context, id string, quantity int32) (bool, error) {
-	// Use optimistic locking with CAS operation
-	query := `
-		UPDATE catalog.seat_areas
-		SET available_seats = available_seats - $1
-		WHERE id = $2 AND available_seats >= $1
-		RETURNING available_seats
-	`
-
-	var newAvailable int32
-	err := repo.db.QueryRow(ctx, query, quantity, id).Scan(&newAvailable)
-
-	if stderrors.Is(err, pgx.ErrNoRows) {
-		return false, nil
-	}
-	if err != nil {
-		return false, err
-	}
-
-	return true, nil
-}
-
-func (repo *seatAreaRepository) ReleaseSeats(ctx context.Context, id string, quantity int32) error {
-	query := `
-		UPDATE catalog.seat_areas
-		SET available_seats = available_seats + $1
-		WHERE id = $2 AND available_seats + $1 <= total_seats
-	`
-
-	result, err := repo.db.Exec(ctx, query, quantity, id)
-	if err != nil {
-		return err
-	}
-
-	if result.RowsAffected() == 0 {
-		return errors.ErrSeatAreaNotFound
-	}
-
-	return nil
-}
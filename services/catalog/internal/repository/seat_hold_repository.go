@@ -0,0 +1,142 @@
+package repository
+
+import (
+	"context"
+	stderrors "errors"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/wylu1037/go-micro-boilerplate/pkg/db"
+	"github.com/wylu1037/go-micro-boilerplate/services/catalog/internal/errors"
+	"github.com/wylu1037/go-micro-boilerplate/services/catalog/internal/model"
+)
+
+// SeatHoldRepository stores short-lived seat holds. Holds lapse on their own at ExpiresAt; nothing has to
+// clean them up for them to stop counting against availability.
+type SeatHoldRepository interface {
+	// Create stores the hold if available minus the seats already held in the area covers its quantity
+	// and none of its seats is held by someone else. It returns ErrInsufficientSeats or ErrSeatsUnavailable otherwise.
+	Create(ctx context.Context, hold *model.SeatHold, available int32) error
+	// Get returns ErrHoldNotFound for unknown and lapsed holds.
+	Get(ctx context.Context, token string) (*model.SeatHold, error)
+	// Held sums up the active holds of a seat area, leaving out the hold identified by excludeToken.
+	Held(ctx context.Context, seatAreaID, excludeToken string) (*model.HeldSeats, error)
+}
+
+// NewSeatHoldRepository keeps holds in Postgres, next to the inventory, so reservations can check them
+// in their own transaction.
+func NewSeatHoldRepository(db *db.Pool) SeatHoldRepository {
+	return &pgSeatHoldRepository{db: db}
+}
+
+type pgSeatHoldRepository struct {
+	db *db.Pool
+}
+
+// Create serialises with reservations and other holds on the seat area row, so the available count it
+// checks against is re-read under that lock rather than taken from the caller.
+func (repo *pgSeatHoldRepository) Create(ctx context.Context, hold *model.SeatHold, _ int32) error {
+	return repo.db.Transaction(ctx, func(tx pgx.Tx) error {
+		var available int32
+		lockQuery := `SELECT available_seats FROM catalog.seat_areas WHERE id = $1 FOR UPDATE`
+		err := tx.QueryRow(ctx, lockQuery, hold.SeatAreaID).Scan(&available)
+		if stderrors.Is(err, pgx.ErrNoRows) {
+			return errors.ErrSeatAreaNotFound
+		}
+		if err != nil {
+			return err
+		}
+
+		purgeQuery := `DELETE FROM catalog.seat_holds WHERE seat_area_id = $1 AND expires_at <= NOW()`
+		if _, purgeErr := tx.Exec(ctx, purgeQuery, hold.SeatAreaID); purgeErr != nil {
+			return purgeErr
+		}
+
+		var (
+			held       int32
+			seatsTaken bool
+		)
+		heldQuery := `
+			SELECT COALESCE(SUM(quantity), 0), COALESCE(BOOL_OR(seat_ids && $2), FALSE)
+			FROM catalog.seat_holds
+			WHERE seat_area_id = $1
+		`
+		if heldErr := tx.QueryRow(ctx, heldQuery, hold.SeatAreaID, seatIDsOrEmpty(hold.SeatIDs)).Scan(&held, &seatsTaken); heldErr != nil {
+			return heldErr
+		}
+		if seatsTaken {
+			return errors.ErrSeatsUnavailable
+		}
+		if available-held < hold.Quantity {
+			return errors.ErrInsufficientSeats
+		}
+
+		insertQuery := `
+			INSERT INTO catalog.seat_holds (token, session_id, seat_area_id, quantity, seat_ids, expires_at)
+			VALUES ($1, $2, $3, $4, $5, $6)
+		`
+		_, insertErr := tx.Exec(ctx, insertQuery,
+			hold.Token,
+			hold.SessionID,
+			hold.SeatAreaID,
+			hold.Quantity,
+			seatIDsOrEmpty(hold.SeatIDs),
+			hold.ExpiresAt,
+		)
+		return insertErr
+	})
+}
+
+func (repo *pgSeatHoldRepository) Get(ctx context.Context, token string) (*model.SeatHold, error) {
+	query := `
+		SELECT token, session_id, seat_area_id, quantity, seat_ids, expires_at
+		FROM catalog.seat_holds
+		WHERE token = $1 AND expires_at > NOW()
+	`
+
+	hold := &model.SeatHold{}
+	err := repo.db.QueryRow(ctx, query, token).Scan(
+		&hold.Token,
+		&hold.SessionID,
+		&hold.SeatAreaID,
+		&hold.Quantity,
+		&hold.SeatIDs,
+		&hold.ExpiresAt,
+	)
+	if stderrors.Is(err, pgx.ErrNoRows) {
+		return nil, errors.ErrHoldNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return hold, nil
+}
+
+func (repo *pgSeatHoldRepository) Held(ctx context.Context, seatAreaID, excludeToken string) (*model.HeldSeats, error) {
+	return heldSeats(ctx, repo.db, seatAreaID, excludeToken)
+}
+
+// rowQuerier is satisfied by both the pool and a transaction.
+type rowQuerier interface {
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+}
+
+func heldSeats(ctx context.Context, q rowQuerier, seatAreaID, excludeToken string) (*model.HeldSeats, error) {
+	query := `
+		SELECT
+			(SELECT COALESCE(SUM(quantity), 0)
+			 FROM catalog.seat_holds
+			 WHERE seat_area_id = $1 AND expires_at > NOW() AND token::text <> $2),
+			(SELECT COALESCE(array_agg(seat_id), '{}')
+			 FROM catalog.seat_holds, unnest(seat_ids) AS seat_id
+			 WHERE seat_area_id = $1 AND expires_at > NOW() AND token::text <> $2)
+	`
+
+	held := &model.HeldSeats{}
+	if err := q.QueryRow(ctx, query, seatAreaID, excludeToken).Scan(&held.Quantity, &held.SeatIDs); err != nil {
+		return nil, err
+	}
+
+	return held, nil
+}
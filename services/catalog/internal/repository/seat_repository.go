@@ -0,0 +1,170 @@
+package repository
+
+import (
+	"context"
+	stderrors "errors"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/wylu1037/go-micro-boilerplate/pkg/db"
+	"github.com/wylu1037/go-micro-boilerplate/services/catalog/internal/errors"
+	"github.com/wylu1037/go-micro-boilerplate/services/catalog/internal/model"
+)
+
+type SeatRepository interface {
+	CreateSeatMap(ctx context.Context, sessionID string, seats []*model.Seat) error
+	ListBySeatAreaID(ctx context.Context, seatAreaID string) ([]*model.Seat, error)
+	ListByIDs(ctx context.Context, seatAreaID string, ids []string) ([]*model.Seat, error)
+}
+
+type seatRepository struct {
+	db *db.Pool
+}
+
+func NewSeatRepository(db *db.Pool) SeatRepository {
+	return &seatRepository{db: db}
+}
+
+// CreateSeatMap inserts the seats of a session in one transaction. Every seat area touched must belong to the
+// session, must not have a seat map yet and must have no seats reserved; its total and available counters are
+// reset to match the new map so that area-level and seat-level inventory never disagree.
+func (repo *seatRepository) CreateSeatMap(ctx context.Context, sessionID string, seats []*model.Seat) error {
+	lockAreaQuery := `
+		SELECT session_id, total_seats, available_seats,
+		       EXISTS (SELECT 1 FROM catalog.seats WHERE seat_area_id = $1)
+		FROM catalog.seat_areas
+		WHERE id = $1
+		FOR UPDATE
+	`
+
+	insertQuery := `
+		INSERT INTO catalog.seats (session_id, seat_area_id, row_label, seat_number, pos_x, pos_y, status)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING id, created_at, updated_at
+	`
+
+	resetCountersQuery := `
+		UPDATE catalog.seat_areas
+		SET total_seats = $1, available_seats = $2
+		WHERE id = $3
+	`
+
+	type areaCount struct {
+		total     int32
+		available int32
+	}
+
+	counts := map[string]*areaCount{}
+	areaIDs := []string{}
+	for _, seat := range seats {
+		count, ok := counts[seat.SeatAreaID]
+		if !ok {
+			count = &areaCount{}
+			counts[seat.SeatAreaID] = count
+			areaIDs = append(areaIDs, seat.SeatAreaID)
+		}
+		count.total++
+		if seat.Status == model.SeatStatusAvailable {
+			count.available++
+		}
+	}
+
+	return repo.db.Transaction(ctx, func(tx pgx.Tx) error {
+		for _, areaID := range areaIDs {
+			var (
+				areaSessionID string
+				total         int32
+				available     int32
+				hasSeatMap    bool
+			)
+			err := tx.QueryRow(ctx, lockAreaQuery, areaID).Scan(&areaSessionID, &total, &available, &hasSeatMap)
+			if stderrors.Is(err, pgx.ErrNoRows) {
+				return errors.ErrSeatAreaNotFound
+			}
+			if err != nil {
+				return err
+			}
+			if areaSessionID != sessionID {
+				return errors.ErrInvalidSeatArea
+			}
+			if hasSeatMap || available != total {
+				return errors.ErrSeatAreaInUse
+			}
+		}
+
+		batch := &pgx.Batch{}
+		for _, seat := range seats {
+			seat.SessionID = sessionID
+			batch.Queue(insertQuery,
+				seat.SessionID,
+				seat.SeatAreaID,
+				seat.Row,
+				seat.Number,
+				seat.X,
+				seat.Y,
+				seat.Status,
+			).QueryRow(func(row pgx.Row) error {
+				return row.Scan(&seat.ID, &seat.CreatedAt, &seat.UpdatedAt)
+			})
+		}
+		for _, areaID := range areaIDs {
+			batch.Queue(resetCountersQuery, counts[areaID].total, counts[areaID].available, areaID)
+		}
+
+		return tx.SendBatch(ctx, batch).Close()
+	})
+}
+
+func (repo *seatRepository) ListBySeatAreaID(ctx context.Context, seatAreaID string) ([]*model.Seat, error) {
+	query := `
+		SELECT id, session_id, seat_area_id, row_label, seat_number, pos_x, pos_y, status, order_id, created_at, updated_at
+		FROM catalog.seats
+		WHERE seat_area_id = $1
+		ORDER BY row_label, seat_number
+	`
+
+	return repo.list(ctx, query, seatAreaID)
+}
+
+// ListByIDs returns the seats among ids that belong to the seat area.
+func (repo *seatRepository) ListByIDs(ctx context.Context, seatAreaID string, ids []string) ([]*model.Seat, error) {
+	query := `
+		SELECT id, session_id, seat_area_id, row_label, seat_number, pos_x, pos_y, status, order_id, created_at, updated_at
+		FROM catalog.seats
+		WHERE seat_area_id = $1 AND id = ANY($2)
+		ORDER BY row_label, seat_number
+	`
+
+	return repo.list(ctx, query, seatAreaID, ids)
+}
+
+func (repo *seatRepository) list(ctx context.Context, query string, args ...any) ([]*model.Seat, error) {
+	rows, err := repo.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	seats := []*model.Seat{}
+	for rows.Next() {
+		seat := &model.Seat{}
+		if scanErr := rows.Scan(
+			&seat.ID,
+			&seat.SessionID,
+			&seat.SeatAreaID,
+			&seat.Row,
+			&seat.Number,
+			&seat.X,
+			&seat.Y,
+			&seat.Status,
+			&seat.OrderID,
+			&seat.CreatedAt,
+			&seat.UpdatedAt,
+		); scanErr != nil {
+			return nil, scanErr
+		}
+		seats = append(seats, seat)
+	}
+
+	return seats, rows.Err()
+}
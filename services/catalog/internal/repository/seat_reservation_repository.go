@@ -0,0 +1,394 @@
+package repository
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/wylu1037/go-micro-boilerplate/pkg/db"
+	"github.com/wylu1037/go-micro-boilerplate/services/catalog/internal/errors"
+	"github.com/wylu1037/go-micro-boilerplate/services/catalog/internal/model"
+)
+
+// SeatReservationRepository keeps seat inventory and the per-order reservation ledger in sync.
+// For seat areas with a seat map the individual seats are reserved as well: either the seats
+// requested in SeatIDs or, for quantity-only requests, the first available ones.
+// The ledger is keyed by (order_id, seat_area_id), which makes Reserve and Release idempotent:
+// repeating a call for the same order never touches the inventory twice and returns the ledger
+// entry recorded by the first call.
+type SeatReservationRepository interface {
+	Reserve(ctx context.Context, reservation *model.SeatReservation, holdToken string) error
+	Get(ctx context.Context, orderID, seatAreaID string) (*model.SeatReservation, error)
+	Release(ctx context.Context, reservation *model.SeatReservation) error
+	ListByOrderID(ctx context.Context, orderID string) ([]*model.SeatReservation, error)
+}
+
+type seatReservationRepository struct {
+	db *db.Pool
+}
+
+func NewSeatReservationRepository(db *db.Pool) SeatReservationRepository {
+	return &seatReservationRepository{db: db}
+}
+
+const insertReservationQuery = `
+	INSERT INTO catalog.seat_reservations (order_id, session_id, seat_area_id, quantity, seat_ids, status)
+	VALUES ($1, $2, $3, $4, $5, $6)
+	ON CONFLICT (order_id, seat_area_id) DO NOTHING
+	RETURNING id, status, created_at, updated_at
+`
+
+// Reserve takes seats for the order. On return reservation holds the ledger entry: status reserved on
+// success, rejected if there were not enough seats, or whatever an earlier call for the same order recorded.
+// Seats covered by holds other than holdToken are treated as taken: their quantity is kept free in the area
+// counters and their seats are neither reservable nor picked for quantity-only reservations. The holds are
+// read under the lock on the seat area row that creating a hold takes too, so a hold and a reservation
+// never both count on the same seats. A successful reservation deletes the hold it converts.
+func (repo *seatReservationRepository) Reserve(ctx context.Context, reservation *model.SeatReservation, holdToken string) error {
+	return repo.db.Transaction(ctx, func(tx pgx.Tx) error {
+		inserted, err := insertReservation(ctx, tx, reservation, model.SeatReservationStatusReserved)
+		if err != nil {
+			return err
+		}
+		if !inserted {
+			return getReservationForUpdate(ctx, tx, reservation)
+		}
+
+		lockAreaQuery := `SELECT id FROM catalog.seat_areas WHERE id = $1 FOR UPDATE`
+		var areaID string
+		if lockErr := tx.QueryRow(ctx, lockAreaQuery, reservation.SeatAreaID).Scan(&areaID); lockErr != nil {
+			if stderrors.Is(lockErr, pgx.ErrNoRows) {
+				return errors.ErrSeatAreaNotFound
+			}
+			return lockErr
+		}
+
+		held, heldErr := heldSeats(ctx, tx, reservation.SeatAreaID, holdToken)
+		if heldErr != nil {
+			return heldErr
+		}
+
+		// Lock the seats first so that nothing is written before we know the whole reservation fits.
+		seatsOK, seatErr := lockSeats(ctx, tx, reservation, held.SeatIDs)
+		if seatErr != nil {
+			return seatErr
+		}
+		if !seatsOK {
+			return setReservationStatus(ctx, tx, reservation, model.SeatReservationStatusRejected)
+		}
+
+		decrementQuery := `
+			UPDATE catalog.seat_areas
+			SET available_seats = available_seats - $1
+			WHERE id = $2 AND available_seats - $3 >= $1
+		`
+		result, execErr := tx.Exec(ctx, decrementQuery, reservation.Quantity, reservation.SeatAreaID, held.Quantity)
+		if execErr != nil {
+			return execErr
+		}
+		if result.RowsAffected() == 0 {
+			// Record the rejection so a retry of the same order gets the same answer.
+			return setReservationStatus(ctx, tx, reservation, model.SeatReservationStatusRejected)
+		}
+
+		if holdToken != "" {
+			// The hold would lapse by itself; deleting it frees the capacity it double counts right away.
+			deleteHoldQuery := `DELETE FROM catalog.seat_holds WHERE token::text = $1`
+			if _, deleteErr := tx.Exec(ctx, deleteHoldQuery, holdToken); deleteErr != nil {
+				return deleteErr
+			}
+		}
+
+		if len(reservation.SeatIDs) == 0 {
+			return nil
+		}
+
+		takeSeatsQuery := `
+			UPDATE catalog.seats
+			SET status = $1, order_id = $2, updated_at = NOW()
+			WHERE id = ANY($3)
+		`
+		if _, takeErr := tx.Exec(ctx, takeSeatsQuery, model.SeatStatusReserved, reservation.OrderID, reservation.SeatIDs); takeErr != nil {
+			return takeErr
+		}
+
+		recordSeatsQuery := `
+			UPDATE catalog.seat_reservations
+			SET seat_ids = $1
+			WHERE id = $2
+		`
+		_, recordErr := tx.Exec(ctx, recordSeatsQuery, reservation.SeatIDs, reservation.ID)
+		return recordErr
+	})
+}
+
+// Release returns the order's seats to the inventory. Releasing an order that was never reserved records
+// a released entry without touching the inventory, so a reserve call arriving late for that order is ignored.
+func (repo *seatReservationRepository) Release(ctx context.Context, reservation *model.SeatReservation) error {
+	return repo.db.Transaction(ctx, func(tx pgx.Tx) error {
+		inserted, err := insertReservation(ctx, tx, reservation, model.SeatReservationStatusReleased)
+		if err != nil {
+			return err
+		}
+		if inserted {
+			return nil
+		}
+
+		if getErr := getReservationForUpdate(ctx, tx, reservation); getErr != nil {
+			return getErr
+		}
+		if reservation.Status != model.SeatReservationStatusReserved {
+			return nil
+		}
+
+		if len(reservation.SeatIDs) > 0 {
+			freeSeatsQuery := `
+				UPDATE catalog.seats
+				SET status = $1, order_id = NULL, updated_at = NOW()
+				WHERE seat_area_id = $2 AND order_id = $3
+			`
+			if _, freeErr := tx.Exec(ctx, freeSeatsQuery, model.SeatStatusAvailable, reservation.SeatAreaID, reservation.OrderID); freeErr != nil {
+				return freeErr
+			}
+		}
+
+		// Release exactly what the ledger says was taken, regardless of the quantity in the request.
+		incrementQuery := `
+			UPDATE catalog.seat_areas
+			SET available_seats = available_seats + $1
+			WHERE id = $2 AND available_seats + $1 <= total_seats
+		`
+		result, execErr := tx.Exec(ctx, incrementQuery, reservation.Quantity, reservation.SeatAreaID)
+		if execErr != nil {
+			return execErr
+		}
+		if result.RowsAffected() == 0 {
+			return fmt.Errorf("releasing %d seats would exceed total seats of seat area %s", reservation.Quantity, reservation.SeatAreaID)
+		}
+
+		return setReservationStatus(ctx, tx, reservation, model.SeatReservationStatusReleased)
+	})
+}
+
+// Get returns the ledger entry of the order for the seat area, or ErrReservationNotFound.
+func (repo *seatReservationRepository) Get(ctx context.Context, orderID, seatAreaID string) (*model.SeatReservation, error) {
+	query := `
+		SELECT id, order_id, session_id, seat_area_id, quantity, seat_ids, status, created_at, updated_at
+		FROM catalog.seat_reservations
+		WHERE order_id = $1 AND seat_area_id = $2
+	`
+
+	reservation := &model.SeatReservation{}
+	err := repo.db.QueryRow(ctx, query, orderID, seatAreaID).Scan(
+		&reservation.ID,
+		&reservation.OrderID,
+		&reservation.SessionID,
+		&reservation.SeatAreaID,
+		&reservation.Quantity,
+		&reservation.SeatIDs,
+		&reservation.Status,
+		&reservation.CreatedAt,
+		&reservation.UpdatedAt,
+	)
+	if stderrors.Is(err, pgx.ErrNoRows) {
+		return nil, errors.ErrReservationNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return reservation, nil
+}
+
+func (repo *seatReservationRepository) ListByOrderID(ctx context.Context, orderID string) ([]*model.SeatReservation, error) {
+	query := `
+		SELECT id, order_id, session_id, seat_area_id, quantity, seat_ids, status, created_at, updated_at
+		FROM catalog.seat_reservations
+		WHERE order_id = $1
+		ORDER BY created_at
+	`
+
+	rows, err := repo.db.Query(ctx, query, orderID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	reservations := []*model.SeatReservation{}
+	for rows.Next() {
+		reservation := &model.SeatReservation{}
+		if scanErr := rows.Scan(
+			&reservation.ID,
+			&reservation.OrderID,
+			&reservation.SessionID,
+			&reservation.SeatAreaID,
+			&reservation.Quantity,
+			&reservation.SeatIDs,
+			&reservation.Status,
+			&reservation.CreatedAt,
+			&reservation.UpdatedAt,
+		); scanErr != nil {
+			return nil, scanErr
+		}
+		reservations = append(reservations, reservation)
+	}
+
+	return reservations, rows.Err()
+}
+
+// insertReservation creates the ledger entry with the given status. It reports false if the order already
+// has an entry for the seat area; concurrent inserts for the same key wait for each other, so exactly one wins.
+func insertReservation(ctx context.Context, tx pgx.Tx, reservation *model.SeatReservation, status model.SeatReservationStatus) (bool, error) {
+	err := tx.QueryRow(ctx, insertReservationQuery,
+		reservation.OrderID,
+		reservation.SessionID,
+		reservation.SeatAreaID,
+		reservation.Quantity,
+		seatIDsOrEmpty(reservation.SeatIDs),
+		status,
+	).Scan(&reservation.ID, &reservation.Status, &reservation.CreatedAt, &reservation.UpdatedAt)
+
+	if stderrors.Is(err, pgx.ErrNoRows) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func getReservationForUpdate(ctx context.Context, tx pgx.Tx, reservation *model.SeatReservation) error {
+	query := `
+		SELECT id, session_id, quantity, seat_ids, status, created_at, updated_at
+		FROM catalog.seat_reservations
+		WHERE order_id = $1 AND seat_area_id = $2
+		FOR UPDATE
+	`
+
+	return tx.QueryRow(ctx, query, reservation.OrderID, reservation.SeatAreaID).Scan(
+		&reservation.ID,
+		&reservation.SessionID,
+		&reservation.Quantity,
+		&reservation.SeatIDs,
+		&reservation.Status,
+		&reservation.CreatedAt,
+		&reservation.UpdatedAt,
+	)
+}
+
+func setReservationStatus(ctx context.Context, tx pgx.Tx, reservation *model.SeatReservation, status model.SeatReservationStatus) error {
+	query := `
+		UPDATE catalog.seat_reservations
+		SET status = $1, updated_at = NOW()
+		WHERE id = $2
+		RETURNING updated_at
+	`
+
+	if err := tx.QueryRow(ctx, query, status, reservation.ID).Scan(&reservation.UpdatedAt); err != nil {
+		return err
+	}
+	reservation.Status = status
+	return nil
+}
+
+// lockSeats locks the seats the reservation will take and reports whether they are all available and not
+// among heldSeatIDs. Explicitly requested seats must all belong to the seat area. For quantity-only reservations
+// in an area with a seat map, the first free seats in row order are picked and stored in reservation.SeatIDs;
+// areas without a seat map have nothing to lock.
+func lockSeats(ctx context.Context, tx pgx.Tx, reservation *model.SeatReservation, heldSeatIDs []string) (bool, error) {
+	if len(reservation.SeatIDs) > 0 {
+		held := make(map[string]struct{}, len(heldSeatIDs))
+		for _, id := range heldSeatIDs {
+			held[id] = struct{}{}
+		}
+
+		query := `
+			SELECT id, status
+			FROM catalog.seats
+			WHERE id = ANY($1) AND seat_area_id = $2
+			FOR UPDATE
+		`
+
+		rows, err := tx.Query(ctx, query, reservation.SeatIDs, reservation.SeatAreaID)
+		if err != nil {
+			return false, err
+		}
+		defer rows.Close()
+
+		found := 0
+		allAvailable := true
+		for rows.Next() {
+			var (
+				id     string
+				status model.SeatStatus
+			)
+			if scanErr := rows.Scan(&id, &status); scanErr != nil {
+				return false, scanErr
+			}
+			found++
+			if _, isHeld := held[id]; isHeld || status != model.SeatStatusAvailable {
+				allAvailable = false
+			}
+		}
+		if rowsErr := rows.Err(); rowsErr != nil {
+			return false, rowsErr
+		}
+		if found != len(reservation.SeatIDs) {
+			return false, errors.ErrSeatNotFound
+		}
+		return allAvailable, nil
+	}
+
+	query := `
+		SELECT id
+		FROM catalog.seats
+		WHERE seat_area_id = $1 AND status = $2 AND NOT (id = ANY($4))
+		ORDER BY row_label, seat_number
+		LIMIT $3
+		FOR UPDATE SKIP LOCKED
+	`
+
+	rows, err := tx.Query(ctx, query, reservation.SeatAreaID, model.SeatStatusAvailable, reservation.Quantity, seatIDsOrEmpty(heldSeatIDs))
+	if err != nil {
+		return false, err
+	}
+	defer rows.Close()
+
+	seatIDs := []string{}
+	for rows.Next() {
+		var id string
+		if scanErr := rows.Scan(&id); scanErr != nil {
+			return false, scanErr
+		}
+		seatIDs = append(seatIDs, id)
+	}
+	if rowsErr := rows.Err(); rowsErr != nil {
+		return false, rowsErr
+	}
+
+	if len(seatIDs) == 0 {
+		var hasSeatMap bool
+		existsQuery := `SELECT EXISTS (SELECT 1 FROM catalog.seats WHERE seat_area_id = $1)`
+		if existsErr := tx.QueryRow(ctx, existsQuery, reservation.SeatAreaID).Scan(&hasSeatMap); existsErr != nil {
+			return false, existsErr
+		}
+		// Without a seat map only the area counters apply.
+		return !hasSeatMap, nil
+	}
+	if int32(len(seatIDs)) < reservation.Quantity {
+		return false, nil
+	}
+
+	reservation.SeatIDs = seatIDs
+	return true, nil
+}
+
+// seatIDsOrEmpty keeps a nil slice from being written as NULL into the NOT NULL seat_ids column.
+func seatIDsOrEmpty(seatIDs []string) []string {
+	if seatIDs == nil {
+		return []string{}
+	}
+	return seatIDs
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	stderrors "errors"
 
+	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
 
 	"github.com/wylu1037/go-micro-boilerplate/pkg/db"
@@ -15,7 +16,9 @@ type ShowRepository interface {
 	Create(ctx context.Context, show *model.Show) error
 	GetByID(ctx context.Context, id string) (*model.Show, error)
 	List(ctx context.Context, category, status, city *string, offset, limit int) ([]*model.Show, int64, error)
-	Update(ctx context.Context, show *model.Show) error
+	// Update saves show and, in the same transaction, writes a model.ShowEventChanged event listing
+	// changedFields into the outbox.
+	Update(ctx context.Context, show *model.Show, changedFields []string) error
 	Delete(ctx context.Context, id string) error
 }
 
@@ -157,7 +160,7 @@ func (repo *showRepository) List(ctx context.Context, category, status, city *st
 	return shows, total, nil
 }
 
-func (repo *showRepository) Update(ctx context.Context, show *model.Show) error {
+func (repo *showRepository) Update(ctx context.Context, show *model.Show, changedFields []string) error {
 	query := `
 		UPDATE catalog.shows
 		SET title = $1, description = $2, artist = $3, category = $4, poster_url = $5, status = $6, updated_at = NOW()
@@ -165,21 +168,25 @@ func (repo *showRepository) Update(ctx context.Context, show *model.Show) error
 		RETURNING updated_at
 	`
 
-	err := repo.db.QueryRow(ctx, query,
-		show.Title,
-		show.Description,
-		show.Artist,
-		show.Category,
-		show.PosterURL,
-		show.Status,
-		show.ID,
-	).Scan(&show.UpdatedAt)
-
-	if stderrors.Is(err, pgx.ErrNoRows) {
-		return errors.ErrShowNotFound
-	}
+	return repo.db.Transaction(ctx, func(tx pgx.Tx) error {
+		scanErr := tx.QueryRow(ctx, query,
+			show.Title,
+			show.Description,
+			show.Artist,
+			show.Category,
+			show.PosterURL,
+			show.Status,
+			show.ID,
+		).Scan(&show.UpdatedAt)
+		if stderrors.Is(scanErr, pgx.ErrNoRows) {
+			return errors.ErrShowNotFound
+		}
+		if scanErr != nil {
+			return scanErr
+		}
 
-	return err
+		return writeShowEvent(ctx, tx, uuid.NewString(), show, changedFields)
+	})
 }
 
 func (repo *showRepository) Delete(ctx context.Context, id string) error {
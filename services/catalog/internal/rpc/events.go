@@ -0,0 +1,38 @@
+package rpc
+
+import (
+	"go-micro.dev/v4"
+	"go-micro.dev/v4/broker"
+	"go-micro.dev/v4/transport/headers"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// EventPublisher publishes catalog events (see catalogv1) through the service's broker. Like the booking
+// outbox, payloads are protojson so go-micro subscribers and the gateway's event stream can decode them.
+type EventPublisher struct {
+	broker broker.Broker
+}
+
+func NewEventPublisher(microService micro.Service) *EventPublisher {
+	return &EventPublisher{broker: microService.Options().Broker}
+}
+
+// Publish sends event on the topic named by its event type.
+func (p *EventPublisher) Publish(eventType, eventID string, event proto.Message) error {
+	payload, err := protojson.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	return p.broker.Publish(eventType, &broker.Message{
+		Header: map[string]string{
+			// Micro-Topic lets go-micro servers route the message to the subscriber of the topic.
+			headers.Message: eventType,
+			"Content-Type":  "application/json",
+			"Event-Id":      eventID,
+			"Event-Type":    eventType,
+		},
+		Body: payload,
+	})
+}
@@ -2,15 +2,27 @@ package service
 
 import (
 	"context"
+	stderrors "errors"
+	"fmt"
+	"time"
 
+	"github.com/google/uuid"
+	"github.com/samber/lo"
 	"github.com/shopspring/decimal"
 	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
+	catalogv1 "github.com/wylu1037/go-micro-boilerplate/gen/go/catalog/v1"
+
+	"github.com/wylu1037/go-micro-boilerplate/pkg/config"
 	"github.com/wylu1037/go-micro-boilerplate/services/catalog/internal/errors"
 	"github.com/wylu1037/go-micro-boilerplate/services/catalog/internal/model"
 	"github.com/wylu1037/go-micro-boilerplate/services/catalog/internal/repository"
+	"github.com/wylu1037/go-micro-boilerplate/services/catalog/internal/rpc"
 )
 
+const defaultHoldTTL = 10 * time.Minute
+
 type CatalogService interface {
 	// Show
 	CreateShow(ctx context.Context, show *model.Show) error
@@ -33,18 +45,29 @@ type CatalogService interface {
 	CreateSeatArea(ctx context.Context, seatArea *model.SeatArea) error
 	ListSeatAreas(ctx context.Context, sessionID string) ([]*model.SeatArea, error)
 
+	// Seat
+	CreateSeatMap(ctx context.Context, sessionID string, seats []*model.Seat) error
+	ListSeats(ctx context.Context, seatAreaID string) ([]*model.Seat, error)
+
 	// Inventory
-	CheckAvailability(ctx context.Context, sessionID, seatAreaID string, quantity int32) (bool, int32, decimal.Decimal, error)
-	ReserveSeats(ctx context.Context, sessionID, seatAreaID string, quantity int32, orderID string) error
+	CheckAvailability(ctx context.Context, sessionID, seatAreaID string, quantity int32, holdToken string) (bool, int32, decimal.Decimal, error)
+	HoldSeats(ctx context.Context, sessionID, seatAreaID string, quantity int32, seatIDs []string) (*model.SeatHold, error)
+	ReserveSeats(ctx context.Context, sessionID, seatAreaID string, quantity int32, seatIDs []string, holdToken, orderID string) error
 	ReleaseSeats(ctx context.Context, sessionID, seatAreaID string, quantity int32, orderID string) error
+	ListReservations(ctx context.Context, orderID string) ([]*model.SeatReservation, error)
 }
 
 type catalogService struct {
-	showRepo     repository.ShowRepository
-	venueRepo    repository.VenueRepository
-	sessionRepo  repository.SessionRepository
-	seatAreaRepo repository.SeatAreaRepository
-	logger       *zap.Logger
+	showRepo        repository.ShowRepository
+	venueRepo       repository.VenueRepository
+	sessionRepo     repository.SessionRepository
+	seatAreaRepo    repository.SeatAreaRepository
+	seatRepo        repository.SeatRepository
+	reservationRepo repository.SeatReservationRepository
+	holdRepo        repository.SeatHoldRepository
+	events          *rpc.EventPublisher
+	holdTTL         time.Duration
+	logger          *zap.Logger
 }
 
 func NewCatalogService(
@@ -52,14 +75,29 @@ func NewCatalogService(
 	venueRepo repository.VenueRepository,
 	sessionRepo repository.SessionRepository,
 	seatAreaRepo repository.SeatAreaRepository,
+	seatRepo repository.SeatRepository,
+	reservationRepo repository.SeatReservationRepository,
+	holdRepo repository.SeatHoldRepository,
+	events *rpc.EventPublisher,
+	cfg *config.Config,
 	logger *zap.Logger,
 ) CatalogService {
+	holdTTL := cfg.Catalog.HoldTTL
+	if holdTTL <= 0 {
+		holdTTL = defaultHoldTTL
+	}
+
 	return &catalogService{
-		showRepo:     showRepo,
-		venueRepo:    venueRepo,
-		sessionRepo:  sessionRepo,
-		seatAreaRepo: seatAreaRepo,
-		logger:       logger,
+		showRepo:        showRepo,
+		venueRepo:       venueRepo,
+		sessionRepo:     sessionRepo,
+		seatAreaRepo:    seatAreaRepo,
+		seatRepo:        seatRepo,
+		reservationRepo: reservationRepo,
+		holdRepo:        holdRepo,
+		events:          events,
+		holdTTL:         holdTTL,
+		logger:          logger,
 	}
 }
 
@@ -79,8 +117,43 @@ func (svc *catalogService) ListShows(ctx context.Context, category, status, city
 	return svc.showRepo.List(ctx, category, status, city, offset, limit)
 }
 
+// UpdateShow applies the non-empty fields of show to the stored show, fills show in with the result and
+// records a ShowEvent listing the fields that changed in the outbox, together with the update.
 func (svc *catalogService) UpdateShow(ctx context.Context, show *model.Show) error {
-	return svc.showRepo.Update(ctx, show)
+	current, err := svc.showRepo.GetByID(ctx, show.ID)
+	if err != nil {
+		return err
+	}
+
+	changed := applyShowChanges(current, show)
+	if len(changed) > 0 {
+		if updateErr := svc.showRepo.Update(ctx, current, changed); updateErr != nil {
+			return updateErr
+		}
+		svc.logger.Info("Show updated", zap.String("show_id", current.ID), zap.Strings("changed_fields", changed))
+	}
+	*show = *current
+	return nil
+}
+
+// applyShowChanges copies the non-empty fields of update onto show and returns the names of those that
+// changed.
+func applyShowChanges(show, update *model.Show) []string {
+	var changed []string
+	apply := func(name string, dst *string, value string) {
+		if value != "" && value != *dst {
+			*dst = value
+			changed = append(changed, name)
+		}
+	}
+
+	apply("title", &show.Title, update.Title)
+	apply("description", &show.Description, update.Description)
+	apply("artist", &show.Artist, update.Artist)
+	apply("category", &show.Category, update.Category)
+	apply("poster_url", &show.PosterURL, update.PosterURL)
+	apply("status", &show.Status, update.Status)
+	return changed
 }
 
 func (svc *catalogService) DeleteShow(ctx context.Context, id string) error {
@@ -144,7 +217,62 @@ func (svc *catalogService) ListSeatAreas(ctx context.Context, sessionID string)
 	return svc.seatAreaRepo.ListBySessionID(ctx, sessionID)
 }
 
-func (svc *catalogService) CheckAvailability(ctx context.Context, sessionID, seatAreaID string, quantity int32) (bool, int32, decimal.Decimal, error) {
+// CreateSeatMap adds the individual seats of a session. Each seat must name one of the session's seat areas
+// and be unique by row and number within it.
+func (svc *catalogService) CreateSeatMap(ctx context.Context, sessionID string, seats []*model.Seat) error {
+	if _, err := svc.sessionRepo.GetByID(ctx, sessionID); err != nil {
+		return err
+	}
+
+	type seatKey struct {
+		seatAreaID string
+		row        string
+		number     int32
+	}
+	seen := make(map[seatKey]struct{}, len(seats))
+	for _, seat := range seats {
+		key := seatKey{seatAreaID: seat.SeatAreaID, row: seat.Row, number: seat.Number}
+		if _, ok := seen[key]; ok {
+			return fmt.Errorf("%w: duplicate seat %s-%d", errors.ErrInvalidSeatMap, seat.Row, seat.Number)
+		}
+		seen[key] = struct{}{}
+	}
+
+	if err := svc.seatRepo.CreateSeatMap(ctx, sessionID, seats); err != nil {
+		return err
+	}
+	svc.logger.Info("Seat map created", zap.String("session_id", sessionID), zap.Int("seat_count", len(seats)))
+	return nil
+}
+
+// ListSeats reports available seats under an active hold as held.
+func (svc *catalogService) ListSeats(ctx context.Context, seatAreaID string) ([]*model.Seat, error) {
+	if _, err := svc.seatAreaRepo.GetByID(ctx, seatAreaID); err != nil {
+		return nil, err
+	}
+
+	seats, err := svc.seatRepo.ListBySeatAreaID(ctx, seatAreaID)
+	if err != nil {
+		return nil, err
+	}
+
+	held, err := svc.holdRepo.Held(ctx, seatAreaID, "")
+	if err != nil {
+		return nil, err
+	}
+	heldSeats := lo.Keyify(held.SeatIDs)
+	for _, seat := range seats {
+		if _, ok := heldSeats[seat.ID]; ok && seat.Status == model.SeatStatusAvailable {
+			seat.Status = model.SeatStatusHeld
+		}
+	}
+
+	return seats, nil
+}
+
+// CheckAvailability subtracts seats held by others from the area's availability; seats under holdToken
+// count as available to its owner.
+func (svc *catalogService) CheckAvailability(ctx context.Context, sessionID, seatAreaID string, quantity int32, holdToken string) (bool, int32, decimal.Decimal, error) {
 	area, err := svc.seatAreaRepo.GetByID(ctx, seatAreaID)
 	if err != nil {
 		return false, 0, decimal.Zero, err
@@ -154,40 +282,230 @@ func (svc *catalogService) CheckAvailability(ctx context.Context, sessionID, sea
 		return false, 0, decimal.Zero, errors.ErrInvalidSeatArea
 	}
 
-	available := area.AvailableSeats >= quantity
-	return available, area.AvailableSeats, area.Price, nil
+	held, err := svc.holdRepo.Held(ctx, seatAreaID, holdToken)
+	if err != nil {
+		return false, 0, decimal.Zero, err
+	}
+
+	availableSeats := max(area.AvailableSeats-held.Quantity, 0)
+	return availableSeats >= quantity, availableSeats, area.Price, nil
 }
 
-func (svc *catalogService) ReserveSeats(ctx context.Context, sessionID, seatAreaID string, quantity int32, orderID string) error {
-	success, err := svc.seatAreaRepo.CheckAndReserve(ctx, seatAreaID, quantity)
+// HoldSeats puts a hold of holdTTL on quantity seats, or on exactly seatIDs when given. The hold counts
+// against availability until ReserveSeats converts it with the returned token or it lapses.
+func (svc *catalogService) HoldSeats(ctx context.Context, sessionID, seatAreaID string, quantity int32, seatIDs []string) (*model.SeatHold, error) {
+	quantity, err := seatQuantity(quantity, seatIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	area, err := svc.seatAreaRepo.GetByID(ctx, seatAreaID)
+	if err != nil {
+		return nil, err
+	}
+	if area.SessionID != sessionID {
+		return nil, errors.ErrInvalidSeatArea
+	}
+
+	if len(seatIDs) > 0 {
+		seats, listErr := svc.seatRepo.ListByIDs(ctx, seatAreaID, seatIDs)
+		if listErr != nil {
+			return nil, listErr
+		}
+		if len(seats) != len(seatIDs) {
+			return nil, errors.ErrSeatNotFound
+		}
+		for _, seat := range seats {
+			if seat.Status != model.SeatStatusAvailable {
+				return nil, errors.ErrSeatsUnavailable
+			}
+		}
+	}
+
+	hold := &model.SeatHold{
+		Token:      uuid.NewString(),
+		SessionID:  sessionID,
+		SeatAreaID: seatAreaID,
+		Quantity:   quantity,
+		SeatIDs:    seatIDs,
+		ExpiresAt:  time.Now().Add(svc.holdTTL),
+	}
+	if createErr := svc.holdRepo.Create(ctx, hold, area.AvailableSeats); createErr != nil {
+		return nil, createErr
+	}
+
+	svc.logger.Info("Seats held",
+		zap.String("session_id", sessionID),
+		zap.String("seat_area_id", seatAreaID),
+		zap.Int32("quantity", quantity),
+		zap.Strings("seat_ids", seatIDs),
+		zap.Time("expires_at", hold.ExpiresAt),
+	)
+	svc.publishInventoryChanged(ctx, sessionID, seatAreaID)
+
+	return hold, nil
+}
+
+// ReserveSeats is idempotent per (orderID, seatAreaID): a retried call returns the outcome of the first one
+// without touching the inventory again. When seatIDs is set exactly those seats are reserved, all or nothing,
+// and quantity may be left zero. With a holdToken the held seats are converted into the reservation; quantity
+// and seatIDs may then be omitted and default to those of the hold.
+func (svc *catalogService) ReserveSeats(ctx context.Context, sessionID, seatAreaID string, quantity int32, seatIDs []string, holdToken, orderID string) error {
+	var hold *model.SeatHold
+	if holdToken != "" {
+		var holdErr error
+		hold, holdErr = svc.holdRepo.Get(ctx, holdToken)
+		if stderrors.Is(holdErr, errors.ErrHoldNotFound) {
+			// A retry of a reserve that already converted the hold finds it gone; answer from the ledger.
+			existing, getErr := svc.reservationRepo.Get(ctx, orderID, seatAreaID)
+			if getErr != nil {
+				return holdErr
+			}
+			return reservationOutcome(existing)
+		}
+		if holdErr != nil {
+			return holdErr
+		}
+
+		if hold.SessionID != sessionID || hold.SeatAreaID != seatAreaID {
+			return errors.ErrHoldMismatch
+		}
+		if quantity == 0 && len(seatIDs) == 0 {
+			quantity, seatIDs = hold.Quantity, hold.SeatIDs
+		}
+		if !lo.ElementsMatch(seatIDs, hold.SeatIDs) || (quantity != 0 && quantity != hold.Quantity) {
+			return errors.ErrHoldMismatch
+		}
+	}
+
+	quantity, err := seatQuantity(quantity, seatIDs)
 	if err != nil {
 		return err
 	}
-	if !success {
-		return errors.ErrInsufficientSeats
+
+	if checkErr := svc.checkSeatArea(ctx, sessionID, seatAreaID); checkErr != nil {
+		return checkErr
+	}
+
+	reservation := &model.SeatReservation{
+		OrderID:    orderID,
+		SessionID:  sessionID,
+		SeatAreaID: seatAreaID,
+		Quantity:   quantity,
+		SeatIDs:    seatIDs,
+	}
+	if reserveErr := svc.reservationRepo.Reserve(ctx, reservation, holdToken); reserveErr != nil {
+		return reserveErr
+	}
+
+	if outcomeErr := reservationOutcome(reservation); outcomeErr != nil {
+		return outcomeErr
 	}
 
 	svc.logger.Info("Seats reserved",
 		zap.String("session_id", sessionID),
 		zap.String("seat_area_id", seatAreaID),
-		zap.Int32("quantity", quantity),
+		zap.Int32("quantity", reservation.Quantity),
+		zap.Strings("seat_ids", reservation.SeatIDs),
 		zap.String("order_id", orderID),
+		zap.String("reservation_id", reservation.ID),
 	)
+	svc.publishInventoryChanged(ctx, sessionID, seatAreaID)
 
 	return nil
 }
 
+// ReleaseSeats is idempotent per (orderID, seatAreaID) and releases the quantity recorded in the ledger.
+// Releasing an order that never reserved anything succeeds and blocks any later reserve for it.
 func (svc *catalogService) ReleaseSeats(ctx context.Context, sessionID, seatAreaID string, quantity int32, orderID string) error {
-	if err := svc.seatAreaRepo.ReleaseSeats(ctx, seatAreaID, quantity); err != nil {
+	if err := svc.checkSeatArea(ctx, sessionID, seatAreaID); err != nil {
+		return err
+	}
+
+	reservation := &model.SeatReservation{
+		OrderID:    orderID,
+		SessionID:  sessionID,
+		SeatAreaID: seatAreaID,
+		Quantity:   quantity,
+	}
+	if err := svc.reservationRepo.Release(ctx, reservation); err != nil {
 		return err
 	}
 
 	svc.logger.Info("Seats released",
 		zap.String("session_id", sessionID),
 		zap.String("seat_area_id", seatAreaID),
-		zap.Int32("quantity", quantity),
+		zap.Int32("quantity", reservation.Quantity),
 		zap.String("order_id", orderID),
+		zap.String("reservation_id", reservation.ID),
 	)
+	svc.publishInventoryChanged(ctx, sessionID, seatAreaID)
 
 	return nil
 }
+
+// publishInventoryChanged is best effort, like publishShowChanged. It reports the availability after the
+// change, so subscribers can simply keep the latest value per seat area.
+func (svc *catalogService) publishInventoryChanged(ctx context.Context, sessionID, seatAreaID string) {
+	_, available, _, err := svc.CheckAvailability(ctx, sessionID, seatAreaID, 0, "")
+	if err != nil {
+		svc.logger.Error("failed to load availability for inventory event", zap.String("seat_area_id", seatAreaID), zap.Error(err))
+		return
+	}
+
+	event := &catalogv1.InventoryEvent{
+		EventId:        uuid.NewString(),
+		EventType:      model.InventoryEventChanged,
+		OccurredAt:     timestamppb.Now(),
+		SessionId:      sessionID,
+		SeatAreaId:     seatAreaID,
+		AvailableSeats: available,
+	}
+	if publishErr := svc.events.Publish(event.EventType, event.EventId, event); publishErr != nil {
+		svc.logger.Error("failed to publish inventory event", zap.String("seat_area_id", seatAreaID), zap.Error(publishErr))
+	}
+}
+
+func (svc *catalogService) ListReservations(ctx context.Context, orderID string) ([]*model.SeatReservation, error) {
+	return svc.reservationRepo.ListByOrderID(ctx, orderID)
+}
+
+// reservationOutcome maps a ledger entry to the result ReserveSeats reports for it.
+func reservationOutcome(reservation *model.SeatReservation) error {
+	switch reservation.Status {
+	case model.SeatReservationStatusRejected:
+		if len(reservation.SeatIDs) > 0 {
+			return errors.ErrSeatsUnavailable
+		}
+		return errors.ErrInsufficientSeats
+	case model.SeatReservationStatusReleased:
+		return errors.ErrReservationReleased
+	default:
+		return nil
+	}
+}
+
+// seatQuantity derives the number of seats from seatIDs when given; quantity must then be zero or agree.
+func seatQuantity(quantity int32, seatIDs []string) (int32, error) {
+	if len(seatIDs) > 0 {
+		if quantity != 0 && quantity != int32(len(seatIDs)) {
+			return 0, errors.ErrInvalidQuantity
+		}
+		quantity = int32(len(seatIDs))
+	}
+	if quantity <= 0 {
+		return 0, errors.ErrInvalidQuantity
+	}
+	return quantity, nil
+}
+
+func (svc *catalogService) checkSeatArea(ctx context.Context, sessionID, seatAreaID string) error {
+	area, err := svc.seatAreaRepo.GetByID(ctx, seatAreaID)
+	if err != nil {
+		return err
+	}
+	if area.SessionID != sessionID {
+		return errors.ErrInvalidSeatArea
+	}
+	return nil
+}
@@ -0,0 +1,133 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	"go-micro.dev/v4"
+	"go-micro.dev/v4/broker"
+	"go-micro.dev/v4/transport/headers"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/metric"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+
+	"github.com/wylu1037/go-micro-boilerplate/services/catalog/internal/model"
+	"github.com/wylu1037/go-micro-boilerplate/services/catalog/internal/repository"
+)
+
+const (
+	outboxRelayInterval    = time.Second
+	outboxRelayBatchSize   = 100
+	outboxMaxAttempts      = 10
+	outboxBaseRetryBackoff = 2 * time.Second
+	outboxMaxRetryBackoff  = 10 * time.Minute
+)
+
+// OutboxRelay publishes catalog events from the outbox to the message broker, the same way the booking
+// relay does: an event is marked published only after the broker accepted it, and events that keep
+// failing are retried with exponential backoff and finally marked dead. As there, delivery is at least
+// once only with a broker that persists a message before accepting it.
+type OutboxRelay struct {
+	repo   repository.OutboxRepository
+	broker broker.Broker
+	logger *zap.Logger
+
+	deadCounter metric.Int64Counter
+
+	task *periodicTask
+}
+
+func NewOutboxRelay(logger *zap.Logger, microService micro.Service, repo repository.OutboxRepository) *OutboxRelay {
+	meter := otel.GetMeterProvider().Meter("catalog_outbox")
+	deadCounter, _ := meter.Int64Counter(
+		"catalog_outbox_dead_total",
+		metric.WithDescription("Total number of outbox events given up on after too many failed attempts"),
+		metric.WithUnit("1"),
+	)
+
+	r := &OutboxRelay{
+		repo:        repo,
+		broker:      microService.Options().Broker,
+		logger:      logger,
+		deadCounter: deadCounter,
+	}
+	r.task = &periodicTask{
+		name:     "outbox relay",
+		interval: outboxRelayInterval,
+		fn:       r.runOnce,
+		logger:   logger,
+	}
+	return r
+}
+
+// RunOutboxRelay ties the relay to the application lifecycle.
+func RunOutboxRelay(lc fx.Lifecycle, r *OutboxRelay) {
+	lc.Append(fx.Hook{
+		OnStart: func(_ context.Context) error {
+			r.task.start()
+			return nil
+		},
+		OnStop: func(ctx context.Context) error {
+			return r.task.stop(ctx)
+		},
+	})
+}
+
+func (r *OutboxRelay) runOnce(ctx context.Context) {
+	events, err := r.repo.Relay(ctx, outboxRelayBatchSize, outboxMaxAttempts, outboxBackoff, r.publish)
+	if err != nil {
+		r.logger.Error("failed to relay outbox events", zap.Error(err))
+		return
+	}
+
+	published := 0
+	for _, e := range events {
+		switch e.Status {
+		case model.OutboxStatusPublished:
+			published++
+		case model.OutboxStatusDead:
+			r.deadCounter.Add(ctx, 1)
+			r.logger.Error("outbox event dead-lettered",
+				zap.String("event_id", e.ID),
+				zap.String("event_type", e.EventType),
+				zap.Int("attempts", e.Attempts),
+				zap.Stringp("last_error", e.LastError),
+			)
+		default:
+			r.logger.Warn("failed to publish outbox event",
+				zap.String("event_id", e.ID),
+				zap.String("event_type", e.EventType),
+				zap.Int("attempts", e.Attempts),
+				zap.Time("next_attempt_at", e.NextAttemptAt),
+				zap.Stringp("last_error", e.LastError),
+			)
+		}
+	}
+	if published > 0 {
+		r.logger.Debug("Published outbox events", zap.Int("count", published))
+	}
+}
+
+func (r *OutboxRelay) publish(_ context.Context, e *model.OutboxEvent) error {
+	return r.broker.Publish(e.EventType, &broker.Message{
+		Header: map[string]string{
+			// Micro-Topic lets go-micro servers route the message to the subscriber of the topic.
+			headers.Message: e.EventType,
+			"Content-Type":  "application/json",
+			"Event-Id":      e.ID,
+			"Event-Type":    e.EventType,
+			"Aggregate-Id":  e.AggregateID,
+		},
+		Body: e.Payload,
+	})
+}
+
+// outboxBackoff doubles the retry delay with every failed attempt, up to outboxMaxRetryBackoff.
+func outboxBackoff(attempts int) time.Duration {
+	delay := outboxBaseRetryBackoff
+	for i := 1; i < attempts && delay < outboxMaxRetryBackoff; i++ {
+		delay *= 2
+	}
+	return min(delay, outboxMaxRetryBackoff)
+}
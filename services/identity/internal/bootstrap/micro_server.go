@@ -18,6 +18,7 @@ func NewMicroService(
 	cfg *config.Config,
 	logger *zap.Logger,
 	microAuth auth.Auth,
+	revocations middleware.RevocationStore,
 ) micro.Service {
 	service := micro.NewService(
 		micro.Name(cfg.Service.Name),
@@ -32,7 +33,10 @@ func NewMicroService(
 				"IdentityService.Register",
 				"IdentityService.Login",
 				"IdentityService.RefreshToken",
-			}),
+				"IdentityService.VerifyEmail",
+				"IdentityService.RequestPasswordReset",
+				"IdentityService.ResetPassword",
+			}, middleware.WithRevocationStore(revocations)),
 			middleware.NewLoggingMiddleware(logger),
 			middleware.NewValidatorMiddleware(logger),
 		),
@@ -2,6 +2,7 @@ package errors
 
 import (
 	stderrors "errors"
+	"net/http"
 
 	microerrors "go-micro.dev/v4/errors"
 )
@@ -12,14 +13,20 @@ var (
 	ErrUserNotFound       = stderrors.New("user not found")
 	ErrUserAlreadyExists  = stderrors.New("user already exists")
 	ErrInvalidCredentials = stderrors.New("invalid credentials")
+	ErrEmailVerified      = stderrors.New("email already verified")
+	ErrTooManyRequests    = stderrors.New("too many requests, try again later")
 )
 
 var (
 	ErrTokenNotFound = stderrors.New("token not found")
 	ErrTokenExpired  = stderrors.New("token expired")
 	ErrTokenUsed     = stderrors.New("token already used")
+	ErrTokenReused   = stderrors.New("refresh token reuse detected")
+	ErrTokenRevoked  = stderrors.New("token revoked")
 )
 
+var ErrSessionNotFound = stderrors.New("session not found")
+
 func ToMicroError(err error) error {
 	if err == nil {
 		return nil
@@ -36,8 +43,18 @@ func ToMicroError(err error) error {
 		return microerrors.Unauthorized(serviceName, "invalid token")
 	case stderrors.Is(err, ErrTokenExpired):
 		return microerrors.Unauthorized(serviceName, "token expired")
+	case stderrors.Is(err, ErrTokenReused):
+		return microerrors.Unauthorized(serviceName, "refresh token reuse detected, please log in again")
+	case stderrors.Is(err, ErrTokenRevoked):
+		return microerrors.Unauthorized(serviceName, "token has been revoked")
+	case stderrors.Is(err, ErrSessionNotFound):
+		return microerrors.NotFound(serviceName, "session not found")
 	case stderrors.Is(err, ErrTokenUsed):
 		return microerrors.BadRequest(serviceName, "token already used")
+	case stderrors.Is(err, ErrEmailVerified):
+		return microerrors.Conflict(serviceName, "email already verified")
+	case stderrors.Is(err, ErrTooManyRequests):
+		return microerrors.New(serviceName, "too many requests, try again later", http.StatusTooManyRequests)
 	default:
 		return microerrors.InternalServerError(serviceName, "internal server error")
 	}
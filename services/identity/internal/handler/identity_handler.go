@@ -3,8 +3,15 @@ package handler
 import (
 	"context"
 
+	"go-micro.dev/v4/auth"
+	"go-micro.dev/v4/errors"
+	"go-micro.dev/v4/metadata"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
 	identityv1 "github.com/wylu1037/go-micro-boilerplate/gen/go/identity/v1"
+	"github.com/wylu1037/go-micro-boilerplate/pkg/middleware"
 	identityerrors "github.com/wylu1037/go-micro-boilerplate/services/identity/internal/errors"
+	"github.com/wylu1037/go-micro-boilerplate/services/identity/internal/model"
 	"github.com/wylu1037/go-micro-boilerplate/services/identity/internal/service"
 )
 
@@ -30,7 +37,7 @@ func (h *microIdentityHandler) Register(ctx context.Context, req *identityv1.Reg
 }
 
 func (h *microIdentityHandler) Login(ctx context.Context, req *identityv1.LoginRequest, rsp *identityv1.LoginResponse) error {
-	result, err := h.svc.Login(ctx, req.Email, req.Password)
+	result, err := h.svc.Login(ctx, req.Email, req.Password, clientInfo(ctx))
 	if err != nil {
 		return identityerrors.ToMicroError(err)
 	}
@@ -39,17 +46,19 @@ func (h *microIdentityHandler) Login(ctx context.Context, req *identityv1.LoginR
 	rsp.RefreshToken = result.RefreshToken
 	rsp.ExpiresIn = result.ExpiresIn
 	rsp.User = &identityv1.UserProfile{
-		UserId:    result.User.ID,
-		Email:     result.User.Email,
-		Name:      result.User.Name,
-		Phone:     result.User.Phone,
-		AvatarUrl: result.User.AvatarURL,
+		UserId:        result.User.ID,
+		Email:         result.User.Email,
+		Name:          result.User.Name,
+		Phone:         result.User.Phone,
+		AvatarUrl:     result.User.AvatarURL,
+		EmailVerified: result.User.EmailVerified,
+		Role:          result.User.Role,
 	}
 	return nil
 }
 
 func (h *microIdentityHandler) RefreshToken(ctx context.Context, req *identityv1.RefreshTokenRequest, rsp *identityv1.RefreshTokenResponse) error {
-	result, err := h.svc.RefreshToken(ctx, req.RefreshToken)
+	result, err := h.svc.RefreshToken(ctx, req.RefreshToken, clientInfo(ctx))
 	if err != nil {
 		return identityerrors.ToMicroError(err)
 	}
@@ -67,11 +76,13 @@ func (h *microIdentityHandler) GetProfile(ctx context.Context, req *identityv1.G
 	}
 
 	rsp.User = &identityv1.UserProfile{
-		UserId:    user.ID,
-		Email:     user.Email,
-		Name:      user.Name,
-		Phone:     user.Phone,
-		AvatarUrl: user.AvatarURL,
+		UserId:        user.ID,
+		Email:         user.Email,
+		Name:          user.Name,
+		Phone:         user.Phone,
+		AvatarUrl:     user.AvatarURL,
+		EmailVerified: user.EmailVerified,
+		Role:          user.Role,
 	}
 	return nil
 }
@@ -83,11 +94,13 @@ func (h *microIdentityHandler) UpdateProfile(ctx context.Context, req *identityv
 	}
 
 	rsp.User = &identityv1.UserProfile{
-		UserId:    user.ID,
-		Email:     user.Email,
-		Name:      user.Name,
-		Phone:     user.Phone,
-		AvatarUrl: user.AvatarURL,
+		UserId:        user.ID,
+		Email:         user.Email,
+		Name:          user.Name,
+		Phone:         user.Phone,
+		AvatarUrl:     user.AvatarURL,
+		EmailVerified: user.EmailVerified,
+		Role:          user.Role,
 	}
 	return nil
 }
@@ -110,6 +123,29 @@ func (h *microIdentityHandler) ResetPassword(ctx context.Context, req *identityv
 	return nil
 }
 
+func (h *microIdentityHandler) VerifyEmail(ctx context.Context, req *identityv1.VerifyEmailRequest, rsp *identityv1.VerifyEmailResponse) error {
+	if err := h.svc.VerifyEmail(ctx, req.Token); err != nil {
+		return identityerrors.ToMicroError(err)
+	}
+
+	rsp.Message = "Email has been verified successfully"
+	return nil
+}
+
+func (h *microIdentityHandler) ResendVerification(ctx context.Context, req *identityv1.ResendVerificationRequest, rsp *identityv1.ResendVerificationResponse) error {
+	userID, ok := ctx.Value("userId").(string)
+	if !ok || userID == "" {
+		return errors.Unauthorized("identity", "user unauthorized")
+	}
+
+	if err := h.svc.ResendVerification(ctx, userID); err != nil {
+		return identityerrors.ToMicroError(err)
+	}
+
+	rsp.Message = "A verification email has been sent"
+	return nil
+}
+
 func (h *microIdentityHandler) ValidateToken(ctx context.Context, req *identityv1.ValidateTokenRequest, rsp *identityv1.ValidateTokenResponse) error {
 	account, err := h.svc.ValidateToken(ctx, req.AccessToken)
 	if err != nil {
@@ -122,3 +158,75 @@ func (h *microIdentityHandler) ValidateToken(ctx context.Context, req *identityv
 	rsp.Email = account.Metadata["email"]
 	return nil
 }
+
+func (h *microIdentityHandler) Logout(ctx context.Context, req *identityv1.LogoutRequest, rsp *identityv1.LogoutResponse) error {
+	userID, ok := ctx.Value("userId").(string)
+	if !ok || userID == "" {
+		return errors.Unauthorized("identity", "user unauthorized")
+	}
+
+	var sessionID string
+	if account, found := auth.AccountFromContext(ctx); found {
+		sessionID = account.Metadata[middleware.SessionIDKey]
+	}
+
+	if err := h.svc.Logout(ctx, userID, sessionID); err != nil {
+		return identityerrors.ToMicroError(err)
+	}
+
+	rsp.Message = "Logged out successfully"
+	return nil
+}
+
+func (h *microIdentityHandler) ListSessions(ctx context.Context, req *identityv1.ListSessionsRequest, rsp *identityv1.ListSessionsResponse) error {
+	userID, ok := ctx.Value("userId").(string)
+	if !ok || userID == "" {
+		return errors.Unauthorized("identity", "user unauthorized")
+	}
+
+	sessions, err := h.svc.ListSessions(ctx, userID)
+	if err != nil {
+		return identityerrors.ToMicroError(err)
+	}
+
+	var currentID string
+	if account, found := auth.AccountFromContext(ctx); found {
+		currentID = account.Metadata[middleware.SessionIDKey]
+	}
+
+	rsp.Sessions = make([]*identityv1.Session, 0, len(sessions))
+	for _, session := range sessions {
+		rsp.Sessions = append(rsp.Sessions, &identityv1.Session{
+			SessionId:    session.ID,
+			UserAgent:    session.UserAgent,
+			IpAddress:    session.IPAddress,
+			StartedAt:    timestamppb.New(session.StartedAt),
+			LastActiveAt: timestamppb.New(session.LastActiveAt),
+			ExpiresAt:    timestamppb.New(session.ExpiresAt),
+			Current:      currentID != "" && session.ID == currentID,
+		})
+	}
+	return nil
+}
+
+func (h *microIdentityHandler) RevokeSession(ctx context.Context, req *identityv1.RevokeSessionRequest, rsp *identityv1.RevokeSessionResponse) error {
+	userID, ok := ctx.Value("userId").(string)
+	if !ok || userID == "" {
+		return errors.Unauthorized("identity", "user unauthorized")
+	}
+
+	if err := h.svc.RevokeSession(ctx, userID, req.SessionId); err != nil {
+		return identityerrors.ToMicroError(err)
+	}
+
+	rsp.Message = "Session has been revoked"
+	return nil
+}
+
+// clientInfo reads the caller's device from the request metadata; the gateway forwards the HTTP headers
+// and resolves the client IP into X-Real-IP.
+func clientInfo(ctx context.Context) model.ClientInfo {
+	userAgent, _ := metadata.Get(ctx, "User-Agent")
+	ipAddress, _ := metadata.Get(ctx, "X-Real-Ip")
+	return model.ClientInfo{UserAgent: userAgent, IPAddress: ipAddress}
+}
@@ -0,0 +1,40 @@
+package model
+
+import "time"
+
+// Identity tasks, queued in the transaction of the change that needs them and run by the outbox relay
+// until they succeed. The aggregate of a task is the user it concerns.
+const (
+	// TaskRevokeUser revokes the user's access tokens issued before RevokeUserTask.NotBefore.
+	TaskRevokeUser = "ticketing.identity.task.revoke_user"
+	// TaskSendPasswordReset creates a password reset token for the user and emails them the link.
+	TaskSendPasswordReset = "ticketing.identity.task.send_password_reset"
+	// TaskSendVerification sends a new user the email verification link, unless they verified meanwhile.
+	TaskSendVerification = "ticketing.identity.task.send_verification"
+)
+
+// RevokeUserTask is the payload of TaskRevokeUser.
+type RevokeUserTask struct {
+	NotBefore time.Time `json:"notBefore"`
+}
+
+type OutboxStatus string
+
+const (
+	OutboxStatusPending   OutboxStatus = "pending"
+	OutboxStatusPublished OutboxStatus = "published" // The task ran successfully
+)
+
+// OutboxEvent is a task written in the transaction that needs it and run by the relay.
+type OutboxEvent struct {
+	ID            string
+	AggregateID   string
+	EventType     string
+	Payload       []byte
+	Status        OutboxStatus
+	Attempts      int
+	NextAttemptAt time.Time
+	LastError     *string
+	CreatedAt     time.Time
+	PublishedAt   *time.Time
+}
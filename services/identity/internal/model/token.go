@@ -6,15 +6,48 @@ import (
 	"time"
 )
 
+// RefreshToken is one link in a refresh token family. Every login starts a family and every refresh
+// rotates the live token into a new one of the same family; RotatedAt is set on the tokens left behind.
 type RefreshToken struct {
+	ID               string
+	UserID           string
+	FamilyID         string
+	TokenHash        string
+	UserAgent        string
+	IPAddress        string
+	ExpiresAt        time.Time
+	RotatedAt        *time.Time
+	SessionStartedAt time.Time
+	CreatedAt        time.Time
+}
+
+// Session is a refresh token family as shown to its user: one logged-in device.
+type Session struct {
+	ID           string
+	UserAgent    string
+	IPAddress    string
+	StartedAt    time.Time
+	LastActiveAt time.Time
+	ExpiresAt    time.Time
+}
+
+// ClientInfo describes the device a login or refresh request came from.
+type ClientInfo struct {
+	UserAgent string
+	IPAddress string
+}
+
+type PasswordResetToken struct {
 	ID        string
 	UserID    string
 	TokenHash string
 	ExpiresAt time.Time
+	Used      bool
 	CreatedAt time.Time
 }
 
-type PasswordResetToken struct {
+// EmailVerificationToken proves the owner of User.Email clicked the link sent to it.
+type EmailVerificationToken struct {
 	ID        string
 	UserID    string
 	TokenHash string
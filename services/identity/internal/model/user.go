@@ -12,6 +12,7 @@ type User struct {
 	Phone         string    `json:"phone"`
 	AvatarURL     string    `json:"avatarUrl"`
 	EmailVerified bool      `json:"emailVerified"`
+	Role          string    `json:"role"`
 	CreatedAt     time.Time `json:"createdAt"`
 	UpdatedAt     time.Time `json:"updatedAt"`
 }
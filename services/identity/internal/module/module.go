@@ -1,9 +1,12 @@
 package provider
 
 import (
+	notificationv1 "github.com/wylu1037/go-micro-boilerplate/gen/go/notification/v1"
 	"github.com/wylu1037/go-micro-boilerplate/services/identity/internal/handler"
 	"github.com/wylu1037/go-micro-boilerplate/services/identity/internal/repository"
 	"github.com/wylu1037/go-micro-boilerplate/services/identity/internal/service"
+	"github.com/wylu1037/go-micro-boilerplate/services/identity/internal/worker"
+	"go-micro.dev/v4"
 	"go.uber.org/fx"
 )
 
@@ -12,7 +15,14 @@ var Module = fx.Module(
 	fx.Provide(
 		repository.NewUserRepository,
 		repository.NewTokenRepository,
+		repository.NewOutboxRepository,
 		service.NewIdentityService,
 		handler.NewMicroIdentityHandler,
+		worker.NewOutboxRelay,
+		// Provide clients for other services
+		func(service micro.Service) notificationv1.NotificationService {
+			return notificationv1.NewNotificationService("ticketing.notification", service.Client())
+		},
 	),
+	fx.Invoke(worker.RunOutboxRelay),
 )
@@ -0,0 +1,129 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/wylu1037/go-micro-boilerplate/pkg/db"
+	"github.com/wylu1037/go-micro-boilerplate/services/identity/internal/model"
+)
+
+type OutboxRepository interface {
+	// Relay claims up to limit pending tasks that are due and calls run for each of them. Tasks that ran
+	// are marked published; a failed task is retried after backoff(attempts), for as long as it fails.
+	// Rows are locked with FOR UPDATE SKIP LOCKED, so concurrent replicas never run the same task at the
+	// same time. It returns the claimed tasks with their new status.
+	Relay(ctx context.Context, limit int, backoff func(attempts int) time.Duration, run func(ctx context.Context, task *model.OutboxEvent) error) ([]*model.OutboxEvent, error)
+}
+
+type outboxRepository struct {
+	db *db.Pool
+}
+
+func NewOutboxRepository(db *db.Pool) OutboxRepository {
+	return &outboxRepository{db: db}
+}
+
+func (r *outboxRepository) Relay(ctx context.Context, limit int, backoff func(attempts int) time.Duration, run func(ctx context.Context, task *model.OutboxEvent) error) ([]*model.OutboxEvent, error) {
+	selectQuery := `
+		SELECT id, aggregate_id, event_type, payload, status, attempts, next_attempt_at, last_error, created_at, published_at
+		FROM identity.outbox
+		WHERE status = $1 AND next_attempt_at <= NOW()
+		ORDER BY created_at
+		LIMIT $2
+		FOR UPDATE SKIP LOCKED
+	`
+
+	publishedQuery := `
+		UPDATE identity.outbox
+		SET status = $1, attempts = attempts + 1, last_error = NULL, published_at = NOW()
+		WHERE id = $2
+		RETURNING attempts, published_at
+	`
+
+	failedQuery := `
+		UPDATE identity.outbox
+		SET attempts = $1, next_attempt_at = $2, last_error = $3
+		WHERE id = $4
+	`
+
+	tasks := []*model.OutboxEvent{}
+	err := r.db.Transaction(ctx, func(tx pgx.Tx) error {
+		rows, queryErr := tx.Query(ctx, selectQuery, model.OutboxStatusPending, limit)
+		if queryErr != nil {
+			return queryErr
+		}
+
+		for rows.Next() {
+			t := &model.OutboxEvent{}
+			if scanErr := rows.Scan(
+				&t.ID,
+				&t.AggregateID,
+				&t.EventType,
+				&t.Payload,
+				&t.Status,
+				&t.Attempts,
+				&t.NextAttemptAt,
+				&t.LastError,
+				&t.CreatedAt,
+				&t.PublishedAt,
+			); scanErr != nil {
+				rows.Close()
+				return scanErr
+			}
+			tasks = append(tasks, t)
+		}
+		rows.Close()
+		if rowsErr := rows.Err(); rowsErr != nil {
+			return rowsErr
+		}
+
+		for _, t := range tasks {
+			runErr := run(ctx, t)
+			if runErr == nil {
+				if updateErr := tx.QueryRow(ctx, publishedQuery, model.OutboxStatusPublished, t.ID).Scan(&t.Attempts, &t.PublishedAt); updateErr != nil {
+					return updateErr
+				}
+				t.Status = model.OutboxStatusPublished
+				t.LastError = nil
+				continue
+			}
+
+			t.Attempts++
+			lastError := runErr.Error()
+			t.LastError = &lastError
+			t.NextAttemptAt = time.Now().Add(backoff(t.Attempts))
+			if _, updateErr := tx.Exec(ctx, failedQuery, t.Attempts, t.NextAttemptAt, t.LastError, t.ID); updateErr != nil {
+				return updateErr
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return tasks, nil
+}
+
+// writeTask queues a task about the user with a JSON payload into the outbox, inside the transaction of
+// the change that needs it.
+func writeTask(ctx context.Context, tx pgx.Tx, taskType, userID string, payload any) error {
+	query := `
+		INSERT INTO identity.outbox (id, aggregate_id, event_type, payload)
+		VALUES ($1, $2, $3, $4)
+	`
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(ctx, query, uuid.NewString(), userID, taskType, body)
+	return err
+}
@@ -13,13 +13,39 @@ import (
 )
 
 type TokenRepository interface {
+	// CreateRefreshToken stores the first token of a new family; token.FamilyID must be set.
 	CreateRefreshToken(ctx context.Context, token *model.RefreshToken) error
+	// GetRefreshTokenByHash also returns rotated tokens, callers check RotatedAt to detect reuse.
 	GetRefreshTokenByHash(ctx context.Context, tokenHash string) (*model.RefreshToken, error)
+	// RotateRefreshToken marks the token with oldHash rotated and stores next in its family. It returns
+	// ErrTokenReused if oldHash was rotated concurrently.
+	RotateRefreshToken(ctx context.Context, oldHash string, next *model.RefreshToken) error
+	// RevokeRefreshTokenFamily deletes every token of the user's family, returning ErrSessionNotFound if
+	// there were none.
+	RevokeRefreshTokenFamily(ctx context.Context, userID, familyID string) error
+	// ListSessions returns the user's unexpired families, most recently active first.
+	ListSessions(ctx context.Context, userID string) ([]*model.Session, error)
 	DeleteRefreshTokensByUserID(ctx context.Context, userID string) error
 	DeleteRefreshToken(ctx context.Context, tokenHash string) error
 	CreatePasswordResetToken(ctx context.Context, token *model.PasswordResetToken) error
 	GetPasswordResetTokenByHash(ctx context.Context, tokenHash string) (*model.PasswordResetToken, error)
-	MarkPasswordResetTokenUsed(ctx context.Context, tokenHash string) error
+	// CompletePasswordReset stores the new password hash, uses up the user's reset tokens, revokes their
+	// refresh tokens and queues a model.TaskRevokeUser task for the access tokens issued before
+	// revokeBefore in one transaction. It returns ErrTokenUsed if the token was consumed concurrently.
+	CompletePasswordReset(ctx context.Context, tokenHash, userID, passwordHash string, revokeBefore time.Time) error
+	// ClaimPasswordReset queues a model.TaskSendPasswordReset task for the user with the email, unless
+	// another reset was requested for them less than interval ago. Checking and recording the request is
+	// one conditional update, so concurrent requests cannot both pass. It reports whether a task was queued.
+	ClaimPasswordReset(ctx context.Context, email string, interval time.Duration) (bool, error)
+	CreateEmailVerificationToken(ctx context.Context, token *model.EmailVerificationToken) error
+	GetEmailVerificationTokenByHash(ctx context.Context, tokenHash string) (*model.EmailVerificationToken, error)
+	// UseEmailVerificationToken marks the unused token used and the email of its user verified in one
+	// transaction. It returns ErrTokenUsed if the token was used concurrently.
+	UseEmailVerificationToken(ctx context.Context, tokenHash string) error
+	// InvalidateEmailVerificationTokens marks the user's unused verification tokens as used.
+	InvalidateEmailVerificationTokens(ctx context.Context, userID string) error
+	// LastEmailVerificationAt returns when the user's latest verification token was created, or nil if none was.
+	LastEmailVerificationAt(ctx context.Context, userID string) (*time.Time, error)
 }
 
 type tokenRepository struct {
@@ -32,21 +58,25 @@ func NewTokenRepository(db *db.Pool) TokenRepository {
 
 func (r *tokenRepository) CreateRefreshToken(ctx context.Context, token *model.RefreshToken) error {
 	query := `
-		INSERT INTO identity.refresh_tokens (user_id, token_hash, expires_at)
-		VALUES ($1, $2, $3)
-		RETURNING id, created_at
+		INSERT INTO identity.refresh_tokens (user_id, family_id, token_hash, user_agent, ip_address, expires_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING id, session_started_at, created_at
 	`
 
 	return r.db.QueryRow(ctx, query,
 		token.UserID,
+		token.FamilyID,
 		token.TokenHash,
+		token.UserAgent,
+		token.IPAddress,
 		token.ExpiresAt,
-	).Scan(&token.ID, &token.CreatedAt)
+	).Scan(&token.ID, &token.SessionStartedAt, &token.CreatedAt)
 }
 
 func (r *tokenRepository) GetRefreshTokenByHash(ctx context.Context, tokenHash string) (*model.RefreshToken, error) {
 	query := `
-		SELECT id, user_id, token_hash, expires_at, created_at
+		SELECT id, user_id, family_id, token_hash, user_agent, ip_address, expires_at, rotated_at,
+			session_started_at, created_at
 		FROM identity.refresh_tokens
 		WHERE token_hash = $1
 	`
@@ -55,8 +85,13 @@ func (r *tokenRepository) GetRefreshTokenByHash(ctx context.Context, tokenHash s
 	err := r.db.QueryRow(ctx, query, tokenHash).Scan(
 		&token.ID,
 		&token.UserID,
+		&token.FamilyID,
 		&token.TokenHash,
+		&token.UserAgent,
+		&token.IPAddress,
 		&token.ExpiresAt,
+		&token.RotatedAt,
+		&token.SessionStartedAt,
 		&token.CreatedAt,
 	)
 
@@ -74,6 +109,84 @@ func (r *tokenRepository) GetRefreshTokenByHash(ctx context.Context, tokenHash s
 	return token, nil
 }
 
+func (r *tokenRepository) RotateRefreshToken(ctx context.Context, oldHash string, next *model.RefreshToken) error {
+	return r.db.Transaction(ctx, func(tx pgx.Tx) error {
+		rotateQuery := `
+			UPDATE identity.refresh_tokens SET rotated_at = NOW()
+			WHERE token_hash = $1 AND rotated_at IS NULL
+			RETURNING family_id, session_started_at
+		`
+		err := tx.QueryRow(ctx, rotateQuery, oldHash).Scan(&next.FamilyID, &next.SessionStartedAt)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return identityerrors.ErrTokenReused
+		}
+		if err != nil {
+			return err
+		}
+
+		insertQuery := `
+			INSERT INTO identity.refresh_tokens
+				(user_id, family_id, token_hash, user_agent, ip_address, expires_at, session_started_at)
+			VALUES ($1, $2, $3, $4, $5, $6, $7)
+			RETURNING id, created_at
+		`
+		return tx.QueryRow(ctx, insertQuery,
+			next.UserID,
+			next.FamilyID,
+			next.TokenHash,
+			next.UserAgent,
+			next.IPAddress,
+			next.ExpiresAt,
+			next.SessionStartedAt,
+		).Scan(&next.ID, &next.CreatedAt)
+	})
+}
+
+func (r *tokenRepository) RevokeRefreshTokenFamily(ctx context.Context, userID, familyID string) error {
+	query := `DELETE FROM identity.refresh_tokens WHERE user_id = $1 AND family_id = $2`
+	tag, err := r.db.Exec(ctx, query, userID, familyID)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return identityerrors.ErrSessionNotFound
+	}
+	return nil
+}
+
+func (r *tokenRepository) ListSessions(ctx context.Context, userID string) ([]*model.Session, error) {
+	query := `
+		SELECT family_id, user_agent, ip_address, session_started_at, created_at, expires_at
+		FROM identity.refresh_tokens
+		WHERE user_id = $1 AND rotated_at IS NULL AND expires_at > NOW()
+		ORDER BY created_at DESC
+	`
+
+	rows, err := r.db.Query(ctx, query, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var sessions []*model.Session
+	for rows.Next() {
+		session := &model.Session{}
+		if scanErr := rows.Scan(
+			&session.ID,
+			&session.UserAgent,
+			&session.IPAddress,
+			&session.StartedAt,
+			&session.LastActiveAt,
+			&session.ExpiresAt,
+		); scanErr != nil {
+			return nil, scanErr
+		}
+		sessions = append(sessions, session)
+	}
+
+	return sessions, rows.Err()
+}
+
 func (r *tokenRepository) DeleteRefreshTokensByUserID(ctx context.Context, userID string) error {
 	query := `DELETE FROM identity.refresh_tokens WHERE user_id = $1`
 	_, err := r.db.Exec(ctx, query, userID)
@@ -135,8 +248,149 @@ func (r *tokenRepository) GetPasswordResetTokenByHash(ctx context.Context, token
 	return token, nil
 }
 
-func (r *tokenRepository) MarkPasswordResetTokenUsed(ctx context.Context, tokenHash string) error {
-	query := `UPDATE identity.password_reset_tokens SET used = true WHERE token_hash = $1`
-	_, err := r.db.Exec(ctx, query, tokenHash)
+func (r *tokenRepository) CompletePasswordReset(ctx context.Context, tokenHash, userID, passwordHash string, revokeBefore time.Time) error {
+	return r.db.Transaction(ctx, func(tx pgx.Tx) error {
+		claimQuery := `
+			UPDATE identity.password_reset_tokens SET used = true
+			WHERE token_hash = $1 AND user_id = $2 AND NOT used
+		`
+		tag, err := tx.Exec(ctx, claimQuery, tokenHash, userID)
+		if err != nil {
+			return err
+		}
+		if tag.RowsAffected() == 0 {
+			return identityerrors.ErrTokenUsed
+		}
+
+		updateQuery := `UPDATE identity.users SET password_hash = $2, updated_at = NOW() WHERE id = $1`
+		if _, updateErr := tx.Exec(ctx, updateQuery, userID, passwordHash); updateErr != nil {
+			return updateErr
+		}
+
+		// Links from earlier requests must not reset the password a second time
+		invalidateQuery := `UPDATE identity.password_reset_tokens SET used = true WHERE user_id = $1 AND NOT used`
+		if _, invalidateErr := tx.Exec(ctx, invalidateQuery, userID); invalidateErr != nil {
+			return invalidateErr
+		}
+
+		revokeQuery := `DELETE FROM identity.refresh_tokens WHERE user_id = $1`
+		if _, revokeErr := tx.Exec(ctx, revokeQuery, userID); revokeErr != nil {
+			return revokeErr
+		}
+
+		// Access tokens issued with the old password stop working too, not just the refresh tokens
+		return writeTask(ctx, tx, model.TaskRevokeUser, userID, model.RevokeUserTask{NotBefore: revokeBefore})
+	})
+}
+
+func (r *tokenRepository) ClaimPasswordReset(ctx context.Context, email string, interval time.Duration) (bool, error) {
+	query := `
+		UPDATE identity.users SET last_password_reset_at = $2
+		WHERE email = $1 AND (last_password_reset_at IS NULL OR last_password_reset_at <= $3)
+		RETURNING id
+	`
+
+	claimed := false
+	err := r.db.Transaction(ctx, func(tx pgx.Tx) error {
+		now := time.Now()
+		var userID string
+		scanErr := tx.QueryRow(ctx, query, email, now, now.Add(-interval)).Scan(&userID)
+		if errors.Is(scanErr, pgx.ErrNoRows) {
+			return nil
+		}
+		if scanErr != nil {
+			return scanErr
+		}
+
+		claimed = true
+		return writeTask(ctx, tx, model.TaskSendPasswordReset, userID, struct{}{})
+	})
+	return claimed, err
+}
+
+func (r *tokenRepository) CreateEmailVerificationToken(ctx context.Context, token *model.EmailVerificationToken) error {
+	query := `
+		INSERT INTO identity.email_verification_tokens (user_id, token_hash, expires_at)
+		VALUES ($1, $2, $3)
+		RETURNING id, created_at
+	`
+
+	return r.db.QueryRow(ctx, query,
+		token.UserID,
+		token.TokenHash,
+		token.ExpiresAt,
+	).Scan(&token.ID, &token.CreatedAt)
+}
+
+func (r *tokenRepository) GetEmailVerificationTokenByHash(ctx context.Context, tokenHash string) (*model.EmailVerificationToken, error) {
+	query := `
+		SELECT id, user_id, token_hash, expires_at, used, created_at
+		FROM identity.email_verification_tokens
+		WHERE token_hash = $1
+	`
+
+	token := &model.EmailVerificationToken{}
+	err := r.db.QueryRow(ctx, query, tokenHash).Scan(
+		&token.ID,
+		&token.UserID,
+		&token.TokenHash,
+		&token.ExpiresAt,
+		&token.Used,
+		&token.CreatedAt,
+	)
+
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, identityerrors.ErrTokenNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if token.Used {
+		return nil, identityerrors.ErrTokenUsed
+	}
+
+	if time.Now().After(token.ExpiresAt) {
+		return nil, identityerrors.ErrTokenExpired
+	}
+
+	return token, nil
+}
+
+func (r *tokenRepository) UseEmailVerificationToken(ctx context.Context, tokenHash string) error {
+	return r.db.Transaction(ctx, func(tx pgx.Tx) error {
+		claimQuery := `
+			UPDATE identity.email_verification_tokens SET used = true
+			WHERE token_hash = $1 AND NOT used
+			RETURNING user_id
+		`
+		var userID string
+		scanErr := tx.QueryRow(ctx, claimQuery, tokenHash).Scan(&userID)
+		if errors.Is(scanErr, pgx.ErrNoRows) {
+			return identityerrors.ErrTokenUsed
+		}
+		if scanErr != nil {
+			return scanErr
+		}
+
+		verifyQuery := `UPDATE identity.users SET email_verified = true, updated_at = NOW() WHERE id = $1`
+		_, verifyErr := tx.Exec(ctx, verifyQuery, userID)
+		return verifyErr
+	})
+}
+
+func (r *tokenRepository) InvalidateEmailVerificationTokens(ctx context.Context, userID string) error {
+	query := `UPDATE identity.email_verification_tokens SET used = true WHERE user_id = $1 AND NOT used`
+	_, err := r.db.Exec(ctx, query, userID)
 	return err
 }
+
+func (r *tokenRepository) LastEmailVerificationAt(ctx context.Context, userID string) (*time.Time, error) {
+	query := `SELECT MAX(created_at) FROM identity.email_verification_tokens WHERE user_id = $1`
+
+	var last *time.Time
+	if err := r.db.QueryRow(ctx, query, userID).Scan(&last); err != nil {
+		return nil, err
+	}
+	return last, nil
+}
@@ -12,6 +12,8 @@ import (
 )
 
 type UserRepository interface {
+	// Create inserts the user and, unless their email is already verified, queues a
+	// model.TaskSendVerification task in the same transaction.
 	Create(ctx context.Context, user *model.User) error
 	GetByID(ctx context.Context, id string) (*model.User, error)
 	GetByEmail(ctx context.Context, email string) (*model.User, error)
@@ -31,28 +33,31 @@ func (r *userRepository) Create(ctx context.Context, user *model.User) error {
 	query := `
 		INSERT INTO identity.users (email, password_hash, name, phone, avatar_url, email_verified)
 		VALUES ($1, $2, $3, $4, $5, $6)
-		RETURNING id, created_at, updated_at
+		RETURNING id, role, created_at, updated_at
 	`
 
-	err := r.db.QueryRow(ctx, query,
-		user.Email,
-		user.PasswordHash,
-		user.Name,
-		user.Phone,
-		user.AvatarURL,
-		user.EmailVerified,
-	).Scan(&user.ID, &user.CreatedAt, &user.UpdatedAt)
-
-	if err != nil {
-		return err
-	}
-
-	return nil
+	return r.db.Transaction(ctx, func(tx pgx.Tx) error {
+		if scanErr := tx.QueryRow(ctx, query,
+			user.Email,
+			user.PasswordHash,
+			user.Name,
+			user.Phone,
+			user.AvatarURL,
+			user.EmailVerified,
+		).Scan(&user.ID, &user.Role, &user.CreatedAt, &user.UpdatedAt); scanErr != nil {
+			return scanErr
+		}
+
+		if user.EmailVerified {
+			return nil
+		}
+		return writeTask(ctx, tx, model.TaskSendVerification, user.ID, struct{}{})
+	})
 }
 
 func (r *userRepository) GetByID(ctx context.Context, id string) (*model.User, error) {
 	query := `
-		SELECT id, email, password_hash, name, phone, avatar_url, email_verified, created_at, updated_at
+		SELECT id, email, password_hash, name, phone, avatar_url, email_verified, role, created_at, updated_at
 		FROM identity.users
 		WHERE id = $1
 	`
@@ -66,6 +71,7 @@ func (r *userRepository) GetByID(ctx context.Context, id string) (*model.User, e
 		&user.Phone,
 		&user.AvatarURL,
 		&user.EmailVerified,
+		&user.Role,
 		&user.CreatedAt,
 		&user.UpdatedAt,
 	)
@@ -82,7 +88,7 @@ func (r *userRepository) GetByID(ctx context.Context, id string) (*model.User, e
 
 func (r *userRepository) GetByEmail(ctx context.Context, email string) (*model.User, error) {
 	query := `
-		SELECT id, email, password_hash, name, phone, avatar_url, email_verified, created_at, updated_at
+		SELECT id, email, password_hash, name, phone, avatar_url, email_verified, role, created_at, updated_at
 		FROM identity.users
 		WHERE email = $1
 	`
@@ -96,6 +102,7 @@ func (r *userRepository) GetByEmail(ctx context.Context, email string) (*model.U
 		&user.Phone,
 		&user.AvatarURL,
 		&user.EmailVerified,
+		&user.Role,
 		&user.CreatedAt,
 		&user.UpdatedAt,
 	)
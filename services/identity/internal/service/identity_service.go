@@ -4,17 +4,18 @@ import (
 	"context"
 	"crypto/rand"
 	"encoding/base64"
-	"encoding/json"
 	"errors"
-	"fmt"
+	"strconv"
 	"time"
 
 	"go-micro.dev/v4/auth"
 	"go.uber.org/zap"
 	"golang.org/x/crypto/bcrypt"
 
-	"github.com/redis/go-redis/v9"
+	"github.com/google/uuid"
+	notificationv1 "github.com/wylu1037/go-micro-boilerplate/gen/go/notification/v1"
 	"github.com/wylu1037/go-micro-boilerplate/pkg/config"
+	"github.com/wylu1037/go-micro-boilerplate/pkg/middleware"
 	identityerrors "github.com/wylu1037/go-micro-boilerplate/services/identity/internal/errors"
 	"github.com/wylu1037/go-micro-boilerplate/services/identity/internal/model"
 	"github.com/wylu1037/go-micro-boilerplate/services/identity/internal/repository"
@@ -24,29 +25,43 @@ func NewIdentityService(
 	userRepo repository.UserRepository,
 	tokenRepo repository.TokenRepository,
 	microAuth auth.Auth,
+	notificationClient notificationv1.NotificationService,
 	cfg *config.Config,
 	logger *zap.Logger,
-	cache *redis.Client,
+	revocations middleware.RevocationStore,
 ) IdentityService {
 	return &identityService{
-		userRepo:  userRepo,
-		tokenRepo: tokenRepo,
-		auth:      microAuth,
-		config:    cfg,
-		logger:    logger,
-		cache:     cache,
+		userRepo:           userRepo,
+		tokenRepo:          tokenRepo,
+		auth:               microAuth,
+		notificationClient: notificationClient,
+		config:             cfg,
+		logger:             logger,
+		revocations:        revocations,
 	}
 }
 
 type IdentityService interface {
 	Register(ctx context.Context, email, password, name, phone string) (*model.User, error)
-	Login(ctx context.Context, email, password string) (*model.LoginResult, error)
-	RefreshToken(ctx context.Context, refreshToken string) (*model.TokenResult, error)
+	Login(ctx context.Context, email, password string, client model.ClientInfo) (*model.LoginResult, error)
+	RefreshToken(ctx context.Context, refreshToken string, client model.ClientInfo) (*model.TokenResult, error)
 	GetProfile(ctx context.Context, userID string) (*model.User, error)
 	UpdateProfile(ctx context.Context, userID, name, phone, avatarURL string) (*model.User, error)
 	RequestPasswordReset(ctx context.Context, email string) error
 	ResetPassword(ctx context.Context, token, newPassword string) error
+	// VerifyEmail marks the email of the token's user as verified.
+	VerifyEmail(ctx context.Context, token string) error
+	// ResendVerification sends the user a new verification email, at most once per resend interval.
+	ResendVerification(ctx context.Context, userID string) error
+	// ListSessions returns the devices the user is logged in on.
+	ListSessions(ctx context.Context, userID string) ([]*model.Session, error)
+	// RevokeSession logs one of the user's devices out by revoking its refresh token family.
+	RevokeSession(ctx context.Context, userID, sessionID string) error
+	// Logout ends the session the caller's token belongs to, including its unexpired access tokens.
+	Logout(ctx context.Context, userID, sessionID string) error
 	ValidateToken(ctx context.Context, accessToken string) (*auth.Account, error)
+	// RunTask runs a task queued in the outbox; the relay retries it until it returns nil.
+	RunTask(ctx context.Context, task *model.OutboxEvent) error
 }
 
 type identityService struct {
@@ -55,7 +70,10 @@ type identityService struct {
 	auth      auth.Auth
 	config    *config.Config
 	logger    *zap.Logger
-	cache     *redis.Client
+
+	revocations middleware.RevocationStore
+
+	notificationClient notificationv1.NotificationService
 }
 
 func (svc *identityService) Register(ctx context.Context, email, password, name, phone string) (*model.User, error) {
@@ -83,12 +101,13 @@ func (svc *identityService) Register(ctx context.Context, email, password, name,
 		return nil, err
 	}
 
+	// The verification email is sent by the task queued with the user, retried until it goes out
 	svc.logger.Info("User registered", zap.String("user_id", user.ID), zap.String("email", email))
 
 	return user, nil
 }
 
-func (svc *identityService) Login(ctx context.Context, email, password string) (*model.LoginResult, error) {
+func (svc *identityService) Login(ctx context.Context, email, password string, client model.ClientInfo) (*model.LoginResult, error) {
 	log := svc.logger.With(zap.String("email", email))
 
 	user, err := svc.userRepo.GetByEmail(ctx, email)
@@ -105,33 +124,10 @@ func (svc *identityService) Login(ctx context.Context, email, password string) (
 		return nil, identityerrors.ErrInvalidCredentials
 	}
 
-	if svc.cache != nil {
-		cacheKey := fmt.Sprintf("auth:token:%s", user.ID)
-		if val, err := svc.cache.Get(ctx, cacheKey).Result(); err == nil {
-			var cachedRes model.LoginResult
-			if err := json.Unmarshal([]byte(val), &cachedRes); err == nil {
-				return &cachedRes, nil
-			}
-		} else {
-			log.Error("failed to get cached login result", zap.Error(err))
-		}
-	}
-
-	// 生成 auth.Account
-	account, err := svc.auth.Generate(user.ID, auth.WithMetadata(map[string]string{
-		"email": user.Email,
-		"name":  user.Name,
-	}))
-	if err != nil {
-		log.Error("failed to generate auth account", zap.Error(err))
-		return nil, err
-	}
+	// Every login is a new session (refresh token family); the ID travels in the tokens' metadata
+	sessionID := uuid.NewString()
 
-	// 生成 access token 和 refresh token
-	tokenPair, err := svc.auth.Token(
-		auth.WithCredentials(account.ID, account.Secret),
-		auth.WithExpiry(svc.config.JWT.AccessTokenTTL),
-	)
+	tokenPair, err := svc.issueTokenPair(user, sessionID)
 	if err != nil {
 		log.Error("failed to generate token pair", zap.Error(err))
 		return nil, err
@@ -140,7 +136,10 @@ func (svc *identityService) Login(ctx context.Context, email, password string) (
 	// 保存 refresh token
 	refreshTokenEntity := &model.RefreshToken{
 		UserID:    user.ID,
+		FamilyID:  sessionID,
 		TokenHash: model.HashToken(tokenPair.RefreshToken),
+		UserAgent: client.UserAgent,
+		IPAddress: client.IPAddress,
 		ExpiresAt: time.Now().Add(svc.config.JWT.RefreshTokenTTL),
 	}
 
@@ -149,28 +148,17 @@ func (svc *identityService) Login(ctx context.Context, email, password string) (
 		return nil, err
 	}
 
-	log.Info("User logged in")
+	log.Info("User logged in", zap.String("session_id", sessionID))
 
-	res := &model.LoginResult{
+	return &model.LoginResult{
 		User:         user,
 		AccessToken:  tokenPair.AccessToken,
 		RefreshToken: tokenPair.RefreshToken,
 		ExpiresIn:    int64(svc.config.JWT.AccessTokenTTL.Seconds()),
-	}
-
-	if svc.cache != nil {
-		cacheKey := fmt.Sprintf("auth:token:%s", user.ID)
-		if bytes, err := json.Marshal(res); err == nil {
-			svc.cache.Set(ctx, cacheKey, bytes, svc.config.JWT.AccessTokenTTL)
-		} else {
-			log.Error("failed to marshal login result", zap.Error(err))
-		}
-	}
-
-	return res, nil
+	}, nil
 }
 
-func (svc *identityService) RefreshToken(ctx context.Context, refreshToken string) (*model.TokenResult, error) {
+func (svc *identityService) RefreshToken(ctx context.Context, refreshToken string, client model.ClientInfo) (*model.TokenResult, error) {
 	tokenHash := model.HashToken(refreshToken)
 
 	oldToken, err := svc.tokenRepo.GetRefreshTokenByHash(ctx, tokenHash)
@@ -178,34 +166,40 @@ func (svc *identityService) RefreshToken(ctx context.Context, refreshToken strin
 		return nil, err
 	}
 
+	// A rotated token only comes back if it was copied, so neither holder of the family can be trusted
+	if oldToken.RotatedAt != nil {
+		return nil, svc.revokeReusedFamily(ctx, oldToken, client)
+	}
+
 	user, err := svc.userRepo.GetByID(ctx, oldToken.UserID)
 	if err != nil {
 		return nil, err
 	}
 
-	// Delete old refresh token
-	if err := svc.tokenRepo.DeleteRefreshToken(ctx, tokenHash); err != nil {
-		return nil, err
+	// 校验 refresh token 签名与有效期, 再为同一会话签发新的 token pair
+	if _, inspectErr := svc.auth.Inspect(refreshToken); inspectErr != nil {
+		return nil, identityerrors.ErrTokenExpired
 	}
 
-	// 使用 refresh token 生成新的 token pair
-	newTokenPair, err := svc.auth.Token(
-		auth.WithToken(refreshToken),
-		auth.WithExpiry(svc.config.JWT.AccessTokenTTL),
-	)
+	newTokenPair, err := svc.issueTokenPair(user, oldToken.FamilyID)
 	if err != nil {
 		return nil, err
 	}
 
-	// 保存新的 refresh token
+	// 轮换: 旧 token 标记为已轮换, 新 token 加入同一令牌族
 	refreshTokenEntity := &model.RefreshToken{
 		UserID:    user.ID,
 		TokenHash: model.HashToken(newTokenPair.RefreshToken),
+		UserAgent: client.UserAgent,
+		IPAddress: client.IPAddress,
 		ExpiresAt: time.Now().Add(svc.config.JWT.RefreshTokenTTL),
 	}
 
-	if err := svc.tokenRepo.CreateRefreshToken(ctx, refreshTokenEntity); err != nil {
-		return nil, err
+	if rotateErr := svc.tokenRepo.RotateRefreshToken(ctx, tokenHash, refreshTokenEntity); rotateErr != nil {
+		if errors.Is(rotateErr, identityerrors.ErrTokenReused) {
+			return nil, svc.revokeReusedFamily(ctx, oldToken, client)
+		}
+		return nil, rotateErr
 	}
 
 	return &model.TokenResult{
@@ -247,74 +241,44 @@ func (svc *identityService) UpdateProfile(ctx context.Context, userID, name, pho
 	return user, nil
 }
 
-func (svc *identityService) RequestPasswordReset(ctx context.Context, email string) error {
-	user, err := svc.userRepo.GetByEmail(ctx, email)
+func (svc *identityService) ValidateToken(ctx context.Context, accessToken string) (*auth.Account, error) {
+	account, err := svc.auth.Inspect(accessToken)
 	if err != nil {
-		if errors.Is(err, identityerrors.ErrUserNotFound) {
-			// Don't reveal if user exists
-			return nil
-		}
-		return err
+		return nil, err
 	}
 
-	token, err := svc.generateRefreshToken()
+	revoked, err := svc.revocations.IsRevoked(ctx, account)
 	if err != nil {
-		return err
-	}
-
-	resetToken := &model.PasswordResetToken{
-		UserID:    user.ID,
-		TokenHash: model.HashToken(token),
-		ExpiresAt: time.Now().Add(1 * time.Hour),
+		return nil, err
 	}
-
-	if err := svc.tokenRepo.CreatePasswordResetToken(ctx, resetToken); err != nil {
-		return err
+	if revoked {
+		return nil, identityerrors.ErrTokenRevoked
 	}
 
-	// TODO: Send email with reset link containing token
-	svc.logger.Info("Password reset requested", zap.String("user_id", user.ID))
-
-	return nil
+	return account, nil
 }
 
-func (svc *identityService) ResetPassword(ctx context.Context, token, newPassword string) error {
-	tokenHash := model.HashToken(token)
-
-	resetToken, err := svc.tokenRepo.GetPasswordResetTokenByHash(ctx, tokenHash)
-	if err != nil {
-		return err
-	}
-
-	passwordHash, err := bcrypt.GenerateFromPassword([]byte(newPassword), bcrypt.DefaultCost)
-	if err != nil {
-		return err
-	}
-
-	user, err := svc.userRepo.GetByID(ctx, resetToken.UserID)
+// issueTokenPair signs a new access and refresh token for the session. The account is generated afresh
+// every time so the tokens carry the current profile and their own issue time, which revocation by
+// not-before timestamp compares against.
+func (svc *identityService) issueTokenPair(user *model.User, sessionID string) (*auth.Token, error) {
+	// 生成 auth.Account
+	account, err := svc.auth.Generate(user.ID, auth.WithMetadata(map[string]string{
+		"email":                 user.Email,
+		"name":                  user.Name,
+		middleware.SessionIDKey: sessionID,
+		middleware.IssuedAtKey:  strconv.FormatInt(time.Now().UnixMilli(), 10),
+		middleware.RoleKey:      user.Role,
+	}))
 	if err != nil {
-		return err
-	}
-
-	user.PasswordHash = string(passwordHash)
-
-	// Mark token as used
-	if err := svc.tokenRepo.MarkPasswordResetTokenUsed(ctx, tokenHash); err != nil {
-		return err
-	}
-
-	// Invalidate all refresh tokens
-	if err := svc.tokenRepo.DeleteRefreshTokensByUserID(ctx, user.ID); err != nil {
-		return err
+		return nil, err
 	}
 
-	svc.logger.Info("Password reset completed", zap.String("user_id", user.ID))
-
-	return nil
-}
-
-func (svc *identityService) ValidateToken(ctx context.Context, accessToken string) (*auth.Account, error) {
-	return svc.auth.Inspect(accessToken)
+	// 生成 access token 和 refresh token
+	return svc.auth.Token(
+		auth.WithCredentials(account.ID, account.Secret),
+		auth.WithExpiry(svc.config.JWT.AccessTokenTTL),
+	)
 }
 
 func (svc *identityService) generateRefreshToken() (string, error) {
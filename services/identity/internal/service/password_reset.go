@@ -0,0 +1,113 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+	"golang.org/x/crypto/bcrypt"
+
+	identityerrors "github.com/wylu1037/go-micro-boilerplate/services/identity/internal/errors"
+	"github.com/wylu1037/go-micro-boilerplate/services/identity/internal/model"
+)
+
+const (
+	defaultPasswordResetTokenTTL = time.Hour
+	defaultPasswordResetInterval = time.Minute
+
+	passwordResetTemplate = "password_reset"
+)
+
+// RequestPasswordReset answers the same whether or not an account has the email, and whether or not the
+// request was throttled, so it cannot be used to find out who has an account. The email itself is sent by
+// a task queued with the request.
+func (svc *identityService) RequestPasswordReset(ctx context.Context, email string) error {
+	claimed, err := svc.tokenRepo.ClaimPasswordReset(ctx, email, svc.passwordResetInterval())
+	if err != nil {
+		return err
+	}
+	if claimed {
+		svc.logger.Info("Password reset requested")
+	}
+	return nil
+}
+
+// sendPasswordReset creates a reset token for the task's user and emails them the link. A retry after a
+// failed send creates another token; the unsent ones are never seen by anyone and expire.
+func (svc *identityService) sendPasswordReset(ctx context.Context, task *model.OutboxEvent) error {
+	user, err := svc.userRepo.GetByID(ctx, task.AggregateID)
+	if errors.Is(err, identityerrors.ErrUserNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	token, err := svc.generateRefreshToken()
+	if err != nil {
+		return err
+	}
+
+	ttl := svc.passwordResetTokenTTL()
+	resetToken := &model.PasswordResetToken{
+		UserID:    user.ID,
+		TokenHash: model.HashToken(token),
+		ExpiresAt: time.Now().Add(ttl),
+	}
+	if createErr := svc.tokenRepo.CreatePasswordResetToken(ctx, resetToken); createErr != nil {
+		return createErr
+	}
+
+	sendErr := svc.sendEmail(ctx, user, passwordResetTemplate, map[string]string{
+		"UserName":  user.Name,
+		"ResetURL":  tokenLink(svc.config.Identity.PasswordResetURL, token),
+		"ExpiresIn": formatTTL(ttl),
+	})
+	if sendErr != nil {
+		return fmt.Errorf("failed to send password reset email: %w", sendErr)
+	}
+
+	svc.logger.Info("Password reset email sent", zap.String("user_id", user.ID))
+
+	return nil
+}
+
+func (svc *identityService) ResetPassword(ctx context.Context, token, newPassword string) error {
+	tokenHash := model.HashToken(token)
+
+	resetToken, err := svc.tokenRepo.GetPasswordResetTokenByHash(ctx, tokenHash)
+	if err != nil {
+		return err
+	}
+
+	passwordHash, err := bcrypt.GenerateFromPassword([]byte(newPassword), bcrypt.DefaultCost)
+	if err != nil {
+		return err
+	}
+
+	// The revocation of the access tokens is queued with the change and runs right after it commits
+	resetErr := svc.tokenRepo.CompletePasswordReset(ctx, tokenHash, resetToken.UserID, string(passwordHash), time.Now())
+	if resetErr != nil {
+		return resetErr
+	}
+
+	svc.logger.Info("Password reset completed", zap.String("user_id", resetToken.UserID))
+
+	return nil
+}
+
+func (svc *identityService) passwordResetTokenTTL() time.Duration {
+	if svc.config.Identity.PasswordResetTokenTTL > 0 {
+		return svc.config.Identity.PasswordResetTokenTTL
+	}
+	return defaultPasswordResetTokenTTL
+}
+
+func (svc *identityService) passwordResetInterval() time.Duration {
+	if svc.config.Identity.PasswordResetInterval > 0 {
+		return svc.config.Identity.PasswordResetInterval
+	}
+	return defaultPasswordResetInterval
+}
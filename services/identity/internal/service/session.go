@@ -0,0 +1,80 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	identityerrors "github.com/wylu1037/go-micro-boilerplate/services/identity/internal/errors"
+	"github.com/wylu1037/go-micro-boilerplate/services/identity/internal/model"
+)
+
+func (svc *identityService) ListSessions(ctx context.Context, userID string) ([]*model.Session, error) {
+	return svc.tokenRepo.ListSessions(ctx, userID)
+}
+
+func (svc *identityService) RevokeSession(ctx context.Context, userID, sessionID string) error {
+	if err := svc.tokenRepo.RevokeRefreshTokenFamily(ctx, userID, sessionID); err != nil {
+		return err
+	}
+
+	if err := svc.revokeAccessTokens(ctx, sessionID); err != nil {
+		return err
+	}
+
+	svc.logger.Info("Session revoked", zap.String("user_id", userID), zap.String("session_id", sessionID))
+
+	return nil
+}
+
+func (svc *identityService) Logout(ctx context.Context, userID, sessionID string) error {
+	if sessionID == "" {
+		// Tokens issued before sessions existed cannot be matched to one
+		return identityerrors.ErrSessionNotFound
+	}
+
+	// The refresh tokens may already be gone, e.g. after a password reset; the access token still has to go
+	if err := svc.tokenRepo.RevokeRefreshTokenFamily(ctx, userID, sessionID); err != nil &&
+		!errors.Is(err, identityerrors.ErrSessionNotFound) {
+		return err
+	}
+
+	if err := svc.revokeAccessTokens(ctx, sessionID); err != nil {
+		return err
+	}
+
+	svc.logger.Info("User logged out", zap.String("user_id", userID), zap.String("session_id", sessionID))
+
+	return nil
+}
+
+// revokeAccessTokens makes AuthWrapper reject the session's access tokens until the last of them expires.
+func (svc *identityService) revokeAccessTokens(ctx context.Context, sessionID string) error {
+	if err := svc.revocations.RevokeSession(ctx, sessionID, svc.config.JWT.AccessTokenTTL); err != nil {
+		return fmt.Errorf("failed to revoke access tokens: %w", err)
+	}
+	return nil
+}
+
+// revokeReusedFamily ends the session a replayed refresh token belongs to and returns ErrTokenReused.
+func (svc *identityService) revokeReusedFamily(ctx context.Context, token *model.RefreshToken, client model.ClientInfo) error {
+	svc.logger.Warn("Refresh token reuse detected, revoking session",
+		zap.String("user_id", token.UserID),
+		zap.String("session_id", token.FamilyID),
+		zap.String("ip_address", client.IPAddress),
+		zap.String("user_agent", client.UserAgent),
+	)
+
+	if err := svc.tokenRepo.RevokeRefreshTokenFamily(ctx, token.UserID, token.FamilyID); err != nil &&
+		!errors.Is(err, identityerrors.ErrSessionNotFound) {
+		return err
+	}
+
+	if err := svc.revokeAccessTokens(ctx, token.FamilyID); err != nil {
+		return err
+	}
+
+	return identityerrors.ErrTokenReused
+}
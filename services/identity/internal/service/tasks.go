@@ -0,0 +1,45 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/wylu1037/go-micro-boilerplate/services/identity/internal/model"
+)
+
+func (svc *identityService) RunTask(ctx context.Context, task *model.OutboxEvent) error {
+	switch task.EventType {
+	case model.TaskRevokeUser:
+		return svc.revokeUser(ctx, task)
+	case model.TaskSendPasswordReset:
+		return svc.sendPasswordReset(ctx, task)
+	case model.TaskSendVerification:
+		return svc.sendVerificationTask(ctx, task)
+	default:
+		return fmt.Errorf("unknown task type %q", task.EventType)
+	}
+}
+
+// revokeUser rejects the user's access tokens issued before the task's time. The entry only has to live
+// until the last of those tokens expires, so a task that ran late needs a shorter one, or none.
+func (svc *identityService) revokeUser(ctx context.Context, task *model.OutboxEvent) error {
+	var payload model.RevokeUserTask
+	if err := json.Unmarshal(task.Payload, &payload); err != nil {
+		return fmt.Errorf("invalid revoke user task: %w", err)
+	}
+
+	ttl := svc.config.JWT.AccessTokenTTL - time.Since(payload.NotBefore)
+	if ttl <= 0 {
+		return nil
+	}
+	if err := svc.revocations.RevokeUser(ctx, task.AggregateID, payload.NotBefore, ttl); err != nil {
+		return fmt.Errorf("failed to revoke access tokens: %w", err)
+	}
+
+	svc.logger.Info("Access tokens revoked", zap.String("user_id", task.AggregateID), zap.Time("not_before", payload.NotBefore))
+	return nil
+}
@@ -0,0 +1,115 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/metric"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+
+	"github.com/wylu1037/go-micro-boilerplate/services/identity/internal/model"
+	"github.com/wylu1037/go-micro-boilerplate/services/identity/internal/repository"
+	"github.com/wylu1037/go-micro-boilerplate/services/identity/internal/service"
+)
+
+const (
+	outboxRelayInterval    = time.Second
+	outboxRelayBatchSize   = 100
+	outboxAlertAttempts    = 10
+	outboxBaseRetryBackoff = 2 * time.Second
+	outboxMaxRetryBackoff  = 10 * time.Minute
+)
+
+// OutboxRelay runs the identity tasks queued in the outbox. A task is never given up on, since dropping
+// one would e.g. leave the access tokens of a reset password valid; it is retried with exponential backoff
+// and reported as an error once it has failed outboxAlertAttempts times.
+type OutboxRelay struct {
+	repo   repository.OutboxRepository
+	svc    service.IdentityService
+	logger *zap.Logger
+
+	failingCounter metric.Int64Counter
+
+	task *periodicTask
+}
+
+func NewOutboxRelay(logger *zap.Logger, repo repository.OutboxRepository, svc service.IdentityService) *OutboxRelay {
+	meter := otel.GetMeterProvider().Meter("identity_outbox")
+	failingCounter, _ := meter.Int64Counter(
+		"identity_outbox_task_failing_total",
+		metric.WithDescription("Total number of failed runs of outbox tasks that have failed too many times"),
+		metric.WithUnit("1"),
+	)
+
+	r := &OutboxRelay{
+		repo:           repo,
+		svc:            svc,
+		logger:         logger,
+		failingCounter: failingCounter,
+	}
+	r.task = &periodicTask{
+		name:     "outbox relay",
+		interval: outboxRelayInterval,
+		fn:       r.runOnce,
+		logger:   logger,
+	}
+	return r
+}
+
+// RunOutboxRelay ties the relay to the application lifecycle.
+func RunOutboxRelay(lc fx.Lifecycle, r *OutboxRelay) {
+	lc.Append(fx.Hook{
+		OnStart: func(_ context.Context) error {
+			r.task.start()
+			return nil
+		},
+		OnStop: func(ctx context.Context) error {
+			return r.task.stop(ctx)
+		},
+	})
+}
+
+func (r *OutboxRelay) runOnce(ctx context.Context) {
+	tasks, err := r.repo.Relay(ctx, outboxRelayBatchSize, outboxBackoff, r.svc.RunTask)
+	if err != nil {
+		r.logger.Error("failed to relay outbox tasks", zap.Error(err))
+		return
+	}
+
+	done := 0
+	for _, t := range tasks {
+		if t.Status == model.OutboxStatusPublished {
+			done++
+			continue
+		}
+
+		fields := []zap.Field{
+			zap.String("task_id", t.ID),
+			zap.String("task_type", t.EventType),
+			zap.String("user_id", t.AggregateID),
+			zap.Int("attempts", t.Attempts),
+			zap.Time("next_attempt_at", t.NextAttemptAt),
+			zap.Stringp("last_error", t.LastError),
+		}
+		if t.Attempts >= outboxAlertAttempts {
+			r.failingCounter.Add(ctx, 1)
+			r.logger.Error("outbox task keeps failing", fields...)
+			continue
+		}
+		r.logger.Warn("failed to run outbox task", fields...)
+	}
+	if done > 0 {
+		r.logger.Debug("Ran outbox tasks", zap.Int("count", done))
+	}
+}
+
+// outboxBackoff doubles the retry delay with every failed attempt, up to outboxMaxRetryBackoff.
+func outboxBackoff(attempts int) time.Duration {
+	delay := outboxBaseRetryBackoff
+	for i := 1; i < attempts && delay < outboxMaxRetryBackoff; i++ {
+		delay *= 2
+	}
+	return min(delay, outboxMaxRetryBackoff)
+}
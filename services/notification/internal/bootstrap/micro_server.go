@@ -6,6 +6,7 @@ import (
 	"github.com/go-micro/plugins/v4/wrapper/trace/opentelemetry"
 	"go-micro.dev/v4"
 	"go-micro.dev/v4/auth"
+	"go-micro.dev/v4/broker"
 	"go.uber.org/fx"
 	"go.uber.org/zap"
 
@@ -14,21 +15,47 @@ import (
 	"github.com/wylu1037/go-micro-boilerplate/services/notification/internal/router"
 )
 
+// permissions keeps sending to other services, and delivery records, templates and captured messages,
+// which show other users' addresses and messages, to admins. The caller's own preferences and inbox
+// stay open.
+var permissions = middleware.Permissions{
+	"NotificationService.SendEmail":     {},
+	"NotificationService.SendSMS":       {},
+	"NotificationService.SendTemplated": {},
+
+	"NotificationService.GetNotification":   {middleware.RoleAdmin},
+	"NotificationService.ListNotifications": {middleware.RoleAdmin},
+	"NotificationService.RetryNotification": {middleware.RoleAdmin},
+
+	"NotificationService.CreateTemplate":  {middleware.RoleAdmin},
+	"NotificationService.GetTemplate":     {middleware.RoleAdmin},
+	"NotificationService.ListTemplates":   {middleware.RoleAdmin},
+	"NotificationService.UpdateTemplate":  {middleware.RoleAdmin},
+	"NotificationService.DeleteTemplate":  {middleware.RoleAdmin},
+	"NotificationService.PreviewTemplate": {middleware.RoleAdmin},
+
+	"NotificationService.ListCapturedMessages": {middleware.RoleAdmin},
+}
+
 func NewMicroService(
 	cfg *config.Config,
 	logger *zap.Logger,
 	microAuth auth.Auth,
+	revocations middleware.RevocationStore,
+	eventBroker broker.Broker,
 ) micro.Service {
 	service := micro.NewService(
 		micro.Name(cfg.Service.Name),
 		micro.Version(cfg.Service.Version),
 		micro.Address(cfg.Service.Address),
 		micro.Auth(microAuth),
+		micro.Broker(eventBroker),
 		micro.WrapHandler(
 			opentelemetry.NewHandlerWrapper(), // Add Tracing
 			middleware.NewMetricsMiddleware(), // Add Metrics
 			middleware.NewRecoveryMiddleware(logger),
-			middleware.AuthWrapper(microAuth, []string{}), // All notification routes potentially internal or as per logic
+			middleware.AuthWrapper(microAuth, []string{}, middleware.WithRevocationStore(revocations)),
+			middleware.PermissionWrapper(permissions),
 			middleware.NewLoggingMiddleware(logger),
 			middleware.NewValidatorMiddleware(logger),
 		),
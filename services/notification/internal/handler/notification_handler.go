@@ -2,41 +2,557 @@ package handler
 
 import (
 	"context"
+	stderrors "errors"
 
+	"github.com/samber/lo"
+	"go-micro.dev/v4/errors"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	commonv1 "github.com/wylu1037/go-micro-boilerplate/gen/go/common/v1"
 	notificationv1 "github.com/wylu1037/go-micro-boilerplate/gen/go/notification/v1"
+	"github.com/wylu1037/go-micro-boilerplate/services/notification/internal/model"
+	"github.com/wylu1037/go-micro-boilerplate/services/notification/internal/render"
+	"github.com/wylu1037/go-micro-boilerplate/services/notification/internal/sender"
 	"github.com/wylu1037/go-micro-boilerplate/services/notification/internal/service"
 )
 
+const serviceName = "ticketing.notification"
+
 type microNotificationGrpcHandler struct {
-	svc service.NotificationService
+	svc         service.NotificationService
+	templates   service.TemplateService
+	preferences service.PreferenceService
+	inbox       service.InboxService
+	senders     *sender.Registry
 }
 
 func NewNotificationGrpcHandler(
 	svc service.NotificationService,
+	templates service.TemplateService,
+	preferences service.PreferenceService,
+	inbox service.InboxService,
+	senders *sender.Registry,
 ) notificationv1.NotificationServiceHandler {
-	return &microNotificationGrpcHandler{svc: svc}
+	return &microNotificationGrpcHandler{svc: svc, templates: templates, preferences: preferences, inbox: inbox, senders: senders}
 }
 
 func (h *microNotificationGrpcHandler) SendEmail(ctx context.Context, req *notificationv1.SendEmailRequest, resp *notificationv1.SendEmailResponse) error {
-	msgID, err := h.svc.SendEmail(ctx, req.To, req.Subject, req.Body)
+	log, err := h.svc.SendEmail(ctx, req.UserId, fromProtoCategory(req.Category), req.To, req.Subject, req.Body)
+	resp.MessageId = log.ID
 	if err != nil {
-		resp.Success = false
-		return err
+		return toMicroError(err)
 	}
 
 	resp.Success = true
-	resp.MessageId = msgID
 	return nil
 }
 
 func (h *microNotificationGrpcHandler) SendSMS(ctx context.Context, req *notificationv1.SendSMSRequest, resp *notificationv1.SendSMSResponse) error {
-	msgID, err := h.svc.SendSMS(ctx, req.Phone, req.Message)
+	log, err := h.svc.SendSMS(ctx, req.UserId, fromProtoCategory(req.Category), req.Phone, req.Message)
+	resp.MessageId = log.ID
+	if err != nil {
+		return toMicroError(err)
+	}
+
+	resp.Success = true
+	return nil
+}
+
+func (h *microNotificationGrpcHandler) GetNotification(ctx context.Context, req *notificationv1.GetNotificationRequest, resp *notificationv1.GetNotificationResponse) error {
+	log, err := h.svc.GetNotification(ctx, req.NotificationId)
+	if err != nil {
+		return toMicroError(err)
+	}
+
+	resp.Notification = toProtoNotification(log)
+	return nil
+}
+
+func (h *microNotificationGrpcHandler) ListNotifications(ctx context.Context, req *notificationv1.ListNotificationsRequest, resp *notificationv1.ListNotificationsResponse) error {
+	page := lo.Ternary(req.Page < 1, 1, int(req.Page))
+	pageSize := lo.Ternary(req.PageSize < 1, 10, int(req.PageSize))
+
+	filter := model.NotificationFilter{UserID: req.UserId}
+	if req.Channel != nil {
+		filter.Type = fromProtoChannel(*req.Channel)
+	}
+	if req.Status != nil {
+		filter.Status = fromProtoStatus(*req.Status)
+	}
+	if req.CreatedAfter != nil {
+		filter.CreatedAfter = lo.ToPtr(req.CreatedAfter.AsTime())
+	}
+	if req.CreatedBefore != nil {
+		filter.CreatedBefore = lo.ToPtr(req.CreatedBefore.AsTime())
+	}
+
+	logs, total, err := h.svc.ListNotifications(ctx, filter, page, pageSize)
 	if err != nil {
-		resp.Success = false
 		return err
 	}
 
+	resp.Notifications = make([]*notificationv1.Notification, len(logs))
+	for i, l := range logs {
+		resp.Notifications[i] = toProtoNotification(l)
+	}
+	resp.Pagination = &commonv1.PaginationResponse{
+		TotalCount: total,
+		Page:       int32(page),
+		PageSize:   int32(pageSize),
+		TotalPages: int32((total + int64(pageSize) - 1) / int64(pageSize)),
+	}
+	return nil
+}
+
+func (h *microNotificationGrpcHandler) RetryNotification(ctx context.Context, req *notificationv1.RetryNotificationRequest, resp *notificationv1.RetryNotificationResponse) error {
+	log, err := h.svc.RetryNotification(ctx, req.NotificationId)
+	if err != nil {
+		return toMicroError(err)
+	}
+
+	resp.Notification = toProtoNotification(log)
+	return nil
+}
+
+func (h *microNotificationGrpcHandler) SendTemplated(ctx context.Context, req *notificationv1.SendTemplatedRequest, resp *notificationv1.SendTemplatedResponse) error {
+	log, rendered, err := h.svc.SendTemplated(ctx, &model.TemplatedMessage{
+		TemplateName: req.TemplateName,
+		Type:         fromProtoChannel(req.Channel),
+		UserID:       req.UserId,
+		Recipient:    req.Recipient,
+		Locale:       req.Locale,
+		Variables:    req.Variables,
+	})
+	if log != nil {
+		resp.MessageId = log.ID
+		resp.Subject = rendered.Subject
+		resp.Body = rendered.Body
+	}
+	if err != nil {
+		return toMicroError(err)
+	}
+
 	resp.Success = true
-	resp.MessageId = msgID
 	return nil
 }
+
+func (h *microNotificationGrpcHandler) CreateTemplate(ctx context.Context, req *notificationv1.CreateTemplateRequest, resp *notificationv1.CreateTemplateResponse) error {
+	tmpl := &model.Template{
+		Name:     req.Name,
+		Type:     fromProtoChannel(req.Channel),
+		Category: fromProtoCategory(req.Category),
+		Locale:   req.Locale,
+		Subject:  lo.EmptyableToPtr(req.Subject),
+		Content:  req.Content,
+		HTML:     req.Html,
+	}
+	if err := h.templates.CreateTemplate(ctx, tmpl); err != nil {
+		return toMicroError(err)
+	}
+
+	resp.Template = toProtoTemplate(tmpl)
+	return nil
+}
+
+func (h *microNotificationGrpcHandler) GetTemplate(ctx context.Context, req *notificationv1.GetTemplateRequest, resp *notificationv1.GetTemplateResponse) error {
+	tmpl, err := h.templates.GetTemplate(ctx, req.TemplateId)
+	if err != nil {
+		return toMicroError(err)
+	}
+
+	resp.Template = toProtoTemplate(tmpl)
+	return nil
+}
+
+func (h *microNotificationGrpcHandler) ListTemplates(ctx context.Context, req *notificationv1.ListTemplatesRequest, resp *notificationv1.ListTemplatesResponse) error {
+	page := lo.Ternary(req.Page < 1, 1, int(req.Page))
+	pageSize := lo.Ternary(req.PageSize < 1, 10, int(req.PageSize))
+
+	filter := model.TemplateFilter{Name: req.Name, Locale: req.Locale}
+	if req.Channel != nil {
+		filter.Type = fromProtoChannel(*req.Channel)
+	}
+
+	templates, total, err := h.templates.ListTemplates(ctx, filter, page, pageSize)
+	if err != nil {
+		return err
+	}
+
+	resp.Templates = make([]*notificationv1.Template, len(templates))
+	for i, t := range templates {
+		resp.Templates[i] = toProtoTemplate(t)
+	}
+	resp.Pagination = &commonv1.PaginationResponse{
+		TotalCount: total,
+		Page:       int32(page),
+		PageSize:   int32(pageSize),
+		TotalPages: int32((total + int64(pageSize) - 1) / int64(pageSize)),
+	}
+	return nil
+}
+
+func (h *microNotificationGrpcHandler) UpdateTemplate(ctx context.Context, req *notificationv1.UpdateTemplateRequest, resp *notificationv1.UpdateTemplateResponse) error {
+	tmpl, err := h.templates.UpdateTemplate(ctx, req.TemplateId, fromProtoCategory(req.Category), lo.EmptyableToPtr(req.Subject), req.Content, req.Html)
+	if err != nil {
+		return toMicroError(err)
+	}
+
+	resp.Template = toProtoTemplate(tmpl)
+	return nil
+}
+
+func (h *microNotificationGrpcHandler) DeleteTemplate(ctx context.Context, req *notificationv1.DeleteTemplateRequest, resp *notificationv1.DeleteTemplateResponse) error {
+	return toMicroError(h.templates.DeleteTemplate(ctx, req.TemplateId))
+}
+
+func (h *microNotificationGrpcHandler) PreviewTemplate(ctx context.Context, req *notificationv1.PreviewTemplateRequest, resp *notificationv1.PreviewTemplateResponse) error {
+	var draft *model.Template
+	if req.Content != "" {
+		draft = &model.Template{
+			Name:    "preview",
+			Locale:  req.Locale,
+			Subject: lo.EmptyableToPtr(req.Subject),
+			Content: req.Content,
+			HTML:    req.Html,
+		}
+	} else if req.TemplateName == "" {
+		return errors.BadRequest(serviceName, "template_name or content is required")
+	}
+
+	preview, err := h.templates.PreviewTemplate(ctx, req.TemplateName, req.Locale, draft, req.Variables)
+	if err != nil {
+		return toMicroError(err)
+	}
+
+	resp.Subject = preview.Subject
+	resp.Body = preview.Body
+	resp.MissingVariables = preview.MissingVariables
+	resp.Locale = preview.Locale
+	return nil
+}
+
+func (h *microNotificationGrpcHandler) GetPreferences(ctx context.Context, req *notificationv1.GetPreferencesRequest, resp *notificationv1.GetPreferencesResponse) error {
+	userID, ok := ctx.Value("userId").(string)
+	if !ok || userID == "" {
+		return errors.Unauthorized(serviceName, "user unauthorized")
+	}
+
+	prefs, err := h.preferences.GetPreferences(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	resp.Preferences = toProtoPreferences(prefs)
+	return nil
+}
+
+func (h *microNotificationGrpcHandler) UpdatePreferences(ctx context.Context, req *notificationv1.UpdatePreferencesRequest, resp *notificationv1.UpdatePreferencesResponse) error {
+	userID, ok := ctx.Value("userId").(string)
+	if !ok || userID == "" {
+		return errors.Unauthorized(serviceName, "user unauthorized")
+	}
+
+	prefs := &model.Preferences{
+		UserID:            userID,
+		EmailEnabled:      req.EmailEnabled,
+		SMSEnabled:        req.SmsEnabled,
+		MarketingEnabled:  req.MarketingEnabled,
+		QuietHoursEnabled: req.QuietHours.Enabled,
+		QuietHoursStart:   req.QuietHours.Start,
+		QuietHoursEnd:     req.QuietHours.End,
+		Timezone:          req.QuietHours.Timezone,
+	}
+	if err := h.preferences.UpdatePreferences(ctx, prefs); err != nil {
+		return toMicroError(err)
+	}
+
+	resp.Preferences = toProtoPreferences(prefs)
+	return nil
+}
+
+func (h *microNotificationGrpcHandler) ListCapturedMessages(ctx context.Context, req *notificationv1.ListCapturedMessagesRequest, resp *notificationv1.ListCapturedMessagesResponse) error {
+	capture := h.senders.Capture()
+	if capture == nil {
+		return errors.BadRequest(serviceName, "no channel is configured to use the capture provider")
+	}
+
+	limit := lo.Ternary(req.Limit < 1, 50, int(req.Limit))
+	messages := capture.List(req.Recipient, limit)
+
+	resp.Messages = make([]*notificationv1.CapturedMessage, len(messages))
+	for i, m := range messages {
+		resp.Messages[i] = &notificationv1.CapturedMessage{
+			MessageId: m.ID,
+			Channel:   toProtoChannel(m.Type),
+			Recipient: m.To,
+			Subject:   m.Subject,
+			Body:      m.Body,
+			Html:      m.HTML,
+			SentAt:    timestamppb.New(m.SentAt),
+		}
+	}
+	return nil
+}
+
+func (h *microNotificationGrpcHandler) ListInbox(ctx context.Context, req *notificationv1.ListInboxRequest, resp *notificationv1.ListInboxResponse) error {
+	userID, ok := ctx.Value("userId").(string)
+	if !ok || userID == "" {
+		return errors.Unauthorized(serviceName, "user unauthorized")
+	}
+
+	page := lo.Ternary(req.Page < 1, 1, int(req.Page))
+	pageSize := lo.Ternary(req.PageSize < 1, 10, int(req.PageSize))
+
+	messages, total, err := h.inbox.ListInbox(ctx, userID, req.UnreadOnly, page, pageSize)
+	if err != nil {
+		return err
+	}
+	unread, err := h.inbox.GetUnreadCount(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	resp.Messages = make([]*notificationv1.InboxMessage, len(messages))
+	for i, m := range messages {
+		resp.Messages[i] = toProtoInboxMessage(m)
+	}
+	resp.Pagination = &commonv1.PaginationResponse{
+		TotalCount: total,
+		Page:       int32(page),
+		PageSize:   int32(pageSize),
+		TotalPages: int32((total + int64(pageSize) - 1) / int64(pageSize)),
+	}
+	resp.UnreadCount = unread
+	return nil
+}
+
+func (h *microNotificationGrpcHandler) GetUnreadCount(ctx context.Context, req *notificationv1.GetUnreadCountRequest, resp *notificationv1.GetUnreadCountResponse) error {
+	userID, ok := ctx.Value("userId").(string)
+	if !ok || userID == "" {
+		return errors.Unauthorized(serviceName, "user unauthorized")
+	}
+
+	unread, err := h.inbox.GetUnreadCount(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	resp.UnreadCount = unread
+	return nil
+}
+
+func (h *microNotificationGrpcHandler) MarkRead(ctx context.Context, req *notificationv1.MarkReadRequest, resp *notificationv1.MarkReadResponse) error {
+	userID, ok := ctx.Value("userId").(string)
+	if !ok || userID == "" {
+		return errors.Unauthorized(serviceName, "user unauthorized")
+	}
+
+	marked, err := h.inbox.MarkRead(ctx, userID, req.MessageIds)
+	if err != nil {
+		return err
+	}
+	unread, err := h.inbox.GetUnreadCount(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	resp.MarkedCount = marked
+	resp.UnreadCount = unread
+	return nil
+}
+
+func (h *microNotificationGrpcHandler) MarkAllRead(ctx context.Context, req *notificationv1.MarkAllReadRequest, resp *notificationv1.MarkAllReadResponse) error {
+	userID, ok := ctx.Value("userId").(string)
+	if !ok || userID == "" {
+		return errors.Unauthorized(serviceName, "user unauthorized")
+	}
+
+	marked, err := h.inbox.MarkAllRead(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	resp.MarkedCount = marked
+	return nil
+}
+
+// toMicroError maps service errors to client errors; anything else is returned as is.
+func toMicroError(err error) error {
+	var missingErr *render.MissingVariablesError
+	switch {
+	case err == nil:
+		return nil
+	case stderrors.Is(err, service.ErrTemplateNotFound),
+		stderrors.Is(err, service.ErrRecipientNotFound),
+		stderrors.Is(err, service.ErrNotificationNotFound):
+		return errors.NotFound(serviceName, "%s", err.Error())
+	case stderrors.Is(err, service.ErrTemplateExists), stderrors.Is(err, service.ErrNotRetryable):
+		return errors.Conflict(serviceName, "%s", err.Error())
+	case stderrors.As(err, &missingErr),
+		stderrors.Is(err, render.ErrInvalidTemplate),
+		stderrors.Is(err, service.ErrChannelMismatch),
+		stderrors.Is(err, service.ErrNoRecipient),
+		stderrors.Is(err, service.ErrInvalidPreferences):
+		return errors.BadRequest(serviceName, "%s", err.Error())
+	default:
+		return err
+	}
+}
+
+func fromProtoChannel(channel notificationv1.NotificationChannel) model.NotificationType {
+	switch channel {
+	case notificationv1.NotificationChannel_NOTIFICATION_CHANNEL_EMAIL:
+		return model.NotificationTypeEmail
+	case notificationv1.NotificationChannel_NOTIFICATION_CHANNEL_SMS:
+		return model.NotificationTypeSMS
+	case notificationv1.NotificationChannel_NOTIFICATION_CHANNEL_IN_APP:
+		return model.NotificationTypeInApp
+	default:
+		return ""
+	}
+}
+
+func toProtoChannel(typ model.NotificationType) notificationv1.NotificationChannel {
+	switch typ {
+	case model.NotificationTypeEmail:
+		return notificationv1.NotificationChannel_NOTIFICATION_CHANNEL_EMAIL
+	case model.NotificationTypeSMS:
+		return notificationv1.NotificationChannel_NOTIFICATION_CHANNEL_SMS
+	case model.NotificationTypeInApp:
+		return notificationv1.NotificationChannel_NOTIFICATION_CHANNEL_IN_APP
+	default:
+		return notificationv1.NotificationChannel_NOTIFICATION_CHANNEL_UNSPECIFIED
+	}
+}
+
+func fromProtoCategory(category notificationv1.NotificationCategory) model.NotificationCategory {
+	switch category {
+	case notificationv1.NotificationCategory_NOTIFICATION_CATEGORY_TRANSACTIONAL:
+		return model.NotificationCategoryTransactional
+	case notificationv1.NotificationCategory_NOTIFICATION_CATEGORY_MARKETING:
+		return model.NotificationCategoryMarketing
+	default:
+		return ""
+	}
+}
+
+func toProtoCategory(category model.NotificationCategory) notificationv1.NotificationCategory {
+	switch category {
+	case model.NotificationCategoryTransactional:
+		return notificationv1.NotificationCategory_NOTIFICATION_CATEGORY_TRANSACTIONAL
+	case model.NotificationCategoryMarketing:
+		return notificationv1.NotificationCategory_NOTIFICATION_CATEGORY_MARKETING
+	default:
+		return notificationv1.NotificationCategory_NOTIFICATION_CATEGORY_UNSPECIFIED
+	}
+}
+
+func fromProtoStatus(status notificationv1.NotificationStatus) model.NotificationStatus {
+	switch status {
+	case notificationv1.NotificationStatus_NOTIFICATION_STATUS_PENDING:
+		return model.NotificationStatusPending
+	case notificationv1.NotificationStatus_NOTIFICATION_STATUS_SENT:
+		return model.NotificationStatusSent
+	case notificationv1.NotificationStatus_NOTIFICATION_STATUS_FAILED:
+		return model.NotificationStatusFailed
+	case notificationv1.NotificationStatus_NOTIFICATION_STATUS_DEAD:
+		return model.NotificationStatusDead
+	case notificationv1.NotificationStatus_NOTIFICATION_STATUS_SUPPRESSED:
+		return model.NotificationStatusSuppressed
+	default:
+		return ""
+	}
+}
+
+func toProtoStatus(status model.NotificationStatus) notificationv1.NotificationStatus {
+	switch status {
+	case model.NotificationStatusPending:
+		return notificationv1.NotificationStatus_NOTIFICATION_STATUS_PENDING
+	case model.NotificationStatusSent:
+		return notificationv1.NotificationStatus_NOTIFICATION_STATUS_SENT
+	case model.NotificationStatusFailed:
+		return notificationv1.NotificationStatus_NOTIFICATION_STATUS_FAILED
+	case model.NotificationStatusDead:
+		return notificationv1.NotificationStatus_NOTIFICATION_STATUS_DEAD
+	case model.NotificationStatusSuppressed:
+		return notificationv1.NotificationStatus_NOTIFICATION_STATUS_SUPPRESSED
+	default:
+		return notificationv1.NotificationStatus_NOTIFICATION_STATUS_UNSPECIFIED
+	}
+}
+
+func toProtoNotification(l *model.NotificationLog) *notificationv1.Notification {
+	n := &notificationv1.Notification{
+		NotificationId: l.ID,
+		TemplateId:     lo.FromPtr(l.TemplateID),
+		UserId:         lo.FromPtr(l.UserID),
+		Channel:        toProtoChannel(l.Type),
+		Category:       toProtoCategory(l.Category),
+		Recipient:      l.Recipient,
+		Subject:        lo.FromPtr(l.Subject),
+		Content:        l.Content,
+		Status:         toProtoStatus(l.Status),
+		ErrorMessage:   lo.FromPtr(l.ErrorMessage),
+		EventId:        lo.FromPtr(l.EventID),
+		CreatedAt:      timestamppb.New(l.CreatedAt),
+		Attempts:       int32(l.Attempts),
+	}
+	if l.SentAt != nil {
+		n.SentAt = timestamppb.New(*l.SentAt)
+	}
+	if l.NextAttemptAt != nil && l.Status != model.NotificationStatusSent {
+		n.NextAttemptAt = timestamppb.New(*l.NextAttemptAt)
+	}
+	return n
+}
+
+func toProtoTemplate(t *model.Template) *notificationv1.Template {
+	// Stored templates always parse; they are validated when created or updated.
+	variables, _ := render.Variables(lo.FromPtr(t.Subject), t.Content)
+
+	return &notificationv1.Template{
+		TemplateId: t.ID,
+		Name:       t.Name,
+		Channel:    toProtoChannel(t.Type),
+		Category:   toProtoCategory(t.Category),
+		Locale:     t.Locale,
+		Subject:    lo.FromPtr(t.Subject),
+		Content:    t.Content,
+		Html:       t.HTML,
+		Variables:  variables,
+		CreatedAt:  timestamppb.New(t.CreatedAt),
+		UpdatedAt:  timestamppb.New(t.UpdatedAt),
+	}
+}
+
+func toProtoPreferences(p *model.Preferences) *notificationv1.Preferences {
+	prefs := &notificationv1.Preferences{
+		EmailEnabled:     p.EmailEnabled,
+		SmsEnabled:       p.SMSEnabled,
+		MarketingEnabled: p.MarketingEnabled,
+		QuietHours: &notificationv1.QuietHours{
+			Enabled:  p.QuietHoursEnabled,
+			Start:    p.QuietHoursStart,
+			End:      p.QuietHoursEnd,
+			Timezone: p.Timezone,
+		},
+	}
+	if !p.UpdatedAt.IsZero() {
+		prefs.UpdatedAt = timestamppb.New(p.UpdatedAt)
+	}
+	return prefs
+}
+
+func toProtoInboxMessage(m *model.InboxMessage) *notificationv1.InboxMessage {
+	msg := &notificationv1.InboxMessage{
+		MessageId: m.ID,
+		Title:     m.Title,
+		Body:      m.Body,
+		Read:      m.ReadAt != nil,
+		CreatedAt: timestamppb.New(m.CreatedAt),
+	}
+	if m.ReadAt != nil {
+		msg.ReadAt = timestamppb.New(*m.ReadAt)
+	}
+	return msg
+}
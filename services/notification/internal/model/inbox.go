@@ -0,0 +1,21 @@
+package model
+
+import (
+	"time"
+)
+
+// InboxMessage is an in-app notification shown to a user until they read it.
+type InboxMessage struct {
+	ID         string     `gorm:"primaryKey;type:uuid;default:gen_random_uuid()"`
+	UserID     string     `gorm:"type:uuid;not null;index"`
+	TemplateID *string    `gorm:"type:uuid"`
+	Title      string     `gorm:"type:varchar(255);not null"`
+	Body       string     `gorm:"type:text;not null"`
+	EventID    *string    `gorm:"type:varchar(64)"` // Domain event that created the message, if any
+	ReadAt     *time.Time `gorm:"type:timestamptz"`
+	CreatedAt  time.Time  `gorm:"autoCreateTime"`
+}
+
+func (InboxMessage) TableName() string {
+	return "notification.inbox"
+}
@@ -7,20 +7,107 @@ import (
 type NotificationType string
 
 const (
-	NotificationTypeEmail NotificationType = "EMAIL"
-	NotificationTypeSMS   NotificationType = "SMS"
+	NotificationTypeEmail NotificationType = "email"
+	NotificationTypeSMS   NotificationType = "sms"
+	NotificationTypeInApp NotificationType = "in_app" // Written to the user's inbox instead of being sent
+)
+
+type NotificationStatus string
+
+const (
+	NotificationStatusPending    NotificationStatus = "pending"
+	NotificationStatusSent       NotificationStatus = "sent"
+	NotificationStatusFailed     NotificationStatus = "failed"     // Waiting to be retried at NextAttemptAt
+	NotificationStatusDead       NotificationStatus = "dead"       // Out of attempts; only retried manually
+	NotificationStatusSuppressed NotificationStatus = "suppressed" // Not sent because the user opted out
+)
+
+// NotificationCategory tells whether a message may be held back by the user's preferences.
+type NotificationCategory string
+
+const (
+	// NotificationCategoryTransactional messages, such as order confirmations, are always sent right away.
+	NotificationCategoryTransactional NotificationCategory = "transactional"
+	// NotificationCategoryMarketing messages respect opt-outs and quiet hours.
+	NotificationCategoryMarketing NotificationCategory = "marketing"
 )
 
 type NotificationLog struct {
-	ID        string           `gorm:"primaryKey;type:uuid;default:gen_random_uuid()"`
-	Type      NotificationType `gorm:"type:varchar(10);not null"`
-	Recipient string           `gorm:"not null"`
-	Subject   string           `gorm:"type:varchar(255)"` // Only for Email
-	Content   string           `gorm:"type:text;not null"`
-	Info      string           `gorm:"type:text"` // JSON or extra info
-	CreatedAt time.Time        `gorm:"autoCreateTime"`
+	ID            string               `gorm:"primaryKey;type:uuid;default:gen_random_uuid()"`
+	TemplateID    *string              `gorm:"type:uuid"`
+	UserID        *string              `gorm:"type:uuid;index"` // Empty for direct sends not tied to a user
+	Type          NotificationType     `gorm:"type:varchar(20);not null"`
+	Category      NotificationCategory `gorm:"type:varchar(20);not null;default:'transactional'"`
+	Recipient     string               `gorm:"type:varchar(255);not null"`
+	Subject       *string              `gorm:"type:varchar(255)"` // Only for email
+	Content       string               `gorm:"type:text"`
+	HTML          bool                 `gorm:"not null;default:false"`
+	Status        NotificationStatus   `gorm:"type:varchar(20);not null;default:'pending'"`
+	Attempts      int                  `gorm:"not null;default:0"`
+	NextAttemptAt *time.Time           `gorm:"type:timestamptz"` // When a failed delivery is retried; lease expiry while pending
+	SentAt        *time.Time           `gorm:"type:timestamptz"`
+	ErrorMessage  *string              `gorm:"type:text"`
+	EventID       *string              `gorm:"type:varchar(64)"` // Domain event that triggered the delivery, if any
+	CreatedAt     time.Time            `gorm:"autoCreateTime"`
 }
 
 func (NotificationLog) TableName() string {
-	return "notification_logs"
+	return "notification.logs"
+}
+
+// NotificationFilter narrows ListNotifications; empty fields match everything.
+type NotificationFilter struct {
+	UserID        string
+	Type          NotificationType
+	Status        NotificationStatus
+	CreatedAfter  *time.Time
+	CreatedBefore *time.Time
+}
+
+// DefaultLocale is the locale templates fall back to; the seeded templates are written in it.
+const DefaultLocale = "zh-CN"
+
+// Template is a message template rendered with text/template, e.g. "订单确认 - {{.OrderNo}}".
+// A name may have one variant per locale.
+type Template struct {
+	ID        string               `gorm:"primaryKey;type:uuid;default:gen_random_uuid()"`
+	Name      string               `gorm:"type:varchar(100);not null;uniqueIndex:idx_templates_name_locale"`
+	Type      NotificationType     `gorm:"type:varchar(20);not null"`
+	Category  NotificationCategory `gorm:"type:varchar(20);not null;default:'transactional'"`
+	Locale    string               `gorm:"type:varchar(10);not null;default:'zh-CN';uniqueIndex:idx_templates_name_locale"`
+	Subject   *string              `gorm:"type:varchar(255)"` // Email subject or in-app title
+	Content   string               `gorm:"type:text;not null"`
+	HTML      bool                 `gorm:"not null;default:false"` // Content is rendered with html/template
+	CreatedAt time.Time            `gorm:"type:timestamptz;default:now()"`
+	UpdatedAt time.Time            `gorm:"type:timestamptz;default:now()"`
+}
+
+func (Template) TableName() string {
+	return "notification.templates"
+}
+
+// TemplateFilter narrows ListTemplates; empty fields match everything.
+type TemplateFilter struct {
+	Name   string
+	Type   NotificationType
+	Locale string
+}
+
+// TemplatePreview is a template rendered without sending it. When variables are missing only
+// MissingVariables is set.
+type TemplatePreview struct {
+	Subject          string
+	Body             string
+	MissingVariables []string
+	Locale           string // Locale of the variant that was rendered
+}
+
+// TemplatedMessage asks to render a stored template and send it to a user.
+type TemplatedMessage struct {
+	TemplateName string
+	Type         NotificationType
+	UserID       string
+	Recipient    string // Empty sends to the address on the user's profile
+	Locale       string
+	Variables    map[string]string
 }
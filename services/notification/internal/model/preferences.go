@@ -0,0 +1,106 @@
+package model
+
+import (
+	"fmt"
+	"time"
+)
+
+// Preferences are a user's choices about which non-transactional messages they receive and when.
+// Transactional messages ignore them.
+type Preferences struct {
+	UserID            string    `gorm:"primaryKey;type:uuid"`
+	EmailEnabled      bool      `gorm:"not null;default:true"`
+	SMSEnabled        bool      `gorm:"not null;default:true"`
+	MarketingEnabled  bool      `gorm:"not null;default:true"`
+	QuietHoursEnabled bool      `gorm:"not null;default:false"`
+	QuietHoursStart   string    `gorm:"type:varchar(5);not null;default:'22:00'"` // HH:MM in Timezone
+	QuietHoursEnd     string    `gorm:"type:varchar(5);not null;default:'08:00'"` // HH:MM in Timezone; before start wraps past midnight
+	Timezone          string    `gorm:"type:varchar(64);not null;default:'Asia/Shanghai'"`
+	CreatedAt         time.Time `gorm:"type:timestamptz;default:now()"`
+	UpdatedAt         time.Time `gorm:"type:timestamptz;default:now()"`
+}
+
+func (Preferences) TableName() string {
+	return "notification.preferences"
+}
+
+// DefaultPreferences are used for users who never saved any: everything enabled, no quiet hours.
+func DefaultPreferences(userID string) *Preferences {
+	return &Preferences{
+		UserID:           userID,
+		EmailEnabled:     true,
+		SMSEnabled:       true,
+		MarketingEnabled: true,
+		QuietHoursStart:  "22:00",
+		QuietHoursEnd:    "08:00",
+		Timezone:         "Asia/Shanghai",
+	}
+}
+
+// Allows reports whether the user accepts a message of the category on the channel.
+func (p *Preferences) Allows(typ NotificationType, category NotificationCategory) bool {
+	if category == NotificationCategoryTransactional {
+		return true
+	}
+	if category == NotificationCategoryMarketing && !p.MarketingEnabled {
+		return false
+	}
+	switch typ {
+	case NotificationTypeEmail:
+		return p.EmailEnabled
+	case NotificationTypeSMS:
+		return p.SMSEnabled
+	default:
+		return true
+	}
+}
+
+// QuietUntil returns when the quiet hours that now falls in end, or false if now is outside quiet hours.
+// It fails if the stored timezone or times of day do not parse.
+func (p *Preferences) QuietUntil(now time.Time) (time.Time, bool, error) {
+	if !p.QuietHoursEnabled {
+		return time.Time{}, false, nil
+	}
+
+	loc, err := time.LoadLocation(p.Timezone)
+	if err != nil {
+		return time.Time{}, false, fmt.Errorf("invalid timezone %q: %w", p.Timezone, err)
+	}
+	start, err := ParseClock(p.QuietHoursStart)
+	if err != nil {
+		return time.Time{}, false, err
+	}
+	end, err := ParseClock(p.QuietHoursEnd)
+	if err != nil {
+		return time.Time{}, false, err
+	}
+
+	local := now.In(loc)
+	minute := local.Hour()*60 + local.Minute()
+	endOn := func(days int) time.Time {
+		return time.Date(local.Year(), local.Month(), local.Day()+days, end/60, end%60, 0, 0, loc)
+	}
+
+	switch {
+	case start == end:
+		return time.Time{}, false, nil
+	case start < end && minute >= start && minute < end:
+		return endOn(0), true, nil
+	case start > end && minute >= start:
+		// Quiet hours run past midnight and end tomorrow.
+		return endOn(1), true, nil
+	case start > end && minute < end:
+		return endOn(0), true, nil
+	default:
+		return time.Time{}, false, nil
+	}
+}
+
+// ParseClock parses a wall-clock time of day such as "22:30" into minutes after midnight.
+func ParseClock(s string) (int, error) {
+	t, err := time.Parse("15:04", s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid time of day %q, want HH:MM", s)
+	}
+	return t.Hour()*60 + t.Minute(), nil
+}
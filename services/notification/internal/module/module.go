@@ -1,15 +1,36 @@
 package module
 
 import (
+	identityv1 "github.com/wylu1037/go-micro-boilerplate/gen/go/identity/v1"
 	"github.com/wylu1037/go-micro-boilerplate/services/notification/internal/handler"
+	"github.com/wylu1037/go-micro-boilerplate/services/notification/internal/repository"
+	"github.com/wylu1037/go-micro-boilerplate/services/notification/internal/sender"
 	"github.com/wylu1037/go-micro-boilerplate/services/notification/internal/service"
+	"github.com/wylu1037/go-micro-boilerplate/services/notification/internal/subscriber"
+	"github.com/wylu1037/go-micro-boilerplate/services/notification/internal/worker"
+	"go-micro.dev/v4"
 	"go.uber.org/fx"
 )
 
 var Module = fx.Module(
 	"notification",
 	fx.Provide(
+		repository.NewTemplateRepository,
+		repository.NewLogRepository,
+		repository.NewPreferenceRepository,
+		repository.NewInboxRepository,
+		sender.NewRegistry,
+		service.NewTemplateService,
+		service.NewPreferenceService,
+		service.NewInboxService,
 		service.NewNotificationService,
 		handler.NewNotificationGrpcHandler,
+		subscriber.NewOrderEventSubscriber,
+		worker.NewRetryWorker,
+		// Provide clients for other services
+		func(service micro.Service) identityv1.IdentityService {
+			return identityv1.NewIdentityService("ticketing.identity", service.Client())
+		},
 	),
+	fx.Invoke(worker.RunRetryWorker),
 )
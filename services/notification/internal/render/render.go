@@ -0,0 +1,160 @@
+package render
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	htmltemplate "html/template"
+	"slices"
+	"strings"
+	"text/template"
+	"text/template/parse"
+)
+
+var ErrInvalidTemplate = errors.New("invalid template")
+
+// MissingVariablesError lists the variables a template uses that were not given.
+type MissingVariablesError struct {
+	Names []string
+}
+
+func (e *MissingVariablesError) Error() string {
+	return "missing template variables: " + strings.Join(e.Names, ", ")
+}
+
+// Message is a rendered template.
+type Message struct {
+	Subject string
+	Body    string
+}
+
+// Variables returns the sorted names of the top-level variables ({{.Name}}) the subject and content use.
+// Fields referenced inside range and with blocks belong to their element and are not included.
+func Variables(subject, content string) ([]string, error) {
+	names := map[string]struct{}{}
+	for _, text := range []string{subject, content} {
+		t, err := template.New("template").Parse(text)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidTemplate, err)
+		}
+		for _, defined := range t.Templates() {
+			if defined.Tree != nil && defined.Tree.Root != nil {
+				collectFields(defined.Tree.Root, names)
+			}
+		}
+	}
+
+	result := make([]string, 0, len(names))
+	for name := range names {
+		result = append(result, name)
+	}
+	slices.Sort(result)
+	return result, nil
+}
+
+// Render renders the subject with text/template and the content with html/template when html is set,
+// so variables are escaped in HTML bodies. It fails with a *MissingVariablesError, before rendering
+// anything, if vars lacks a variable the template uses.
+func Render(subject, content string, html bool, vars map[string]string) (*Message, error) {
+	used, err := Variables(subject, content)
+	if err != nil {
+		return nil, err
+	}
+
+	var missing []string
+	for _, name := range used {
+		if _, ok := vars[name]; !ok {
+			missing = append(missing, name)
+		}
+	}
+	if len(missing) > 0 {
+		return nil, &MissingVariablesError{Names: missing}
+	}
+
+	msg := &Message{}
+	if subject != "" {
+		if msg.Subject, err = renderText(subject, vars); err != nil {
+			return nil, err
+		}
+	}
+	if html {
+		msg.Body, err = renderHTML(content, vars)
+	} else {
+		msg.Body, err = renderText(content, vars)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
+
+func renderText(text string, vars map[string]string) (string, error) {
+	t, err := template.New("template").Option("missingkey=error").Parse(text)
+	if err != nil {
+		return "", fmt.Errorf("%w: %v", ErrInvalidTemplate, err)
+	}
+
+	var buf bytes.Buffer
+	if execErr := t.Execute(&buf, vars); execErr != nil {
+		return "", fmt.Errorf("%w: %v", ErrInvalidTemplate, execErr)
+	}
+	return buf.String(), nil
+}
+
+func renderHTML(text string, vars map[string]string) (string, error) {
+	t, err := htmltemplate.New("template").Option("missingkey=error").Parse(text)
+	if err != nil {
+		return "", fmt.Errorf("%w: %v", ErrInvalidTemplate, err)
+	}
+
+	var buf bytes.Buffer
+	if execErr := t.Execute(&buf, vars); execErr != nil {
+		return "", fmt.Errorf("%w: %v", ErrInvalidTemplate, execErr)
+	}
+	return buf.String(), nil
+}
+
+func collectFields(node parse.Node, names map[string]struct{}) {
+	switch n := node.(type) {
+	case *parse.ListNode:
+		for _, child := range n.Nodes {
+			collectFields(child, names)
+		}
+	case *parse.ActionNode:
+		collectFields(n.Pipe, names)
+	case *parse.PipeNode:
+		if n == nil {
+			return
+		}
+		for _, cmd := range n.Cmds {
+			collectFields(cmd, names)
+		}
+	case *parse.CommandNode:
+		for _, arg := range n.Args {
+			collectFields(arg, names)
+		}
+	case *parse.FieldNode:
+		names[n.Ident[0]] = struct{}{}
+	case *parse.ChainNode:
+		collectFields(n.Node, names)
+	case *parse.IfNode:
+		collectFields(n.Pipe, names)
+		collectFields(n.List, names)
+		if n.ElseList != nil {
+			collectFields(n.ElseList, names)
+		}
+	case *parse.RangeNode:
+		// Dot is the element inside the body, but the else branch still sees the outer data.
+		collectFields(n.Pipe, names)
+		if n.ElseList != nil {
+			collectFields(n.ElseList, names)
+		}
+	case *parse.WithNode:
+		collectFields(n.Pipe, names)
+		if n.ElseList != nil {
+			collectFields(n.ElseList, names)
+		}
+	case *parse.TemplateNode:
+		collectFields(n.Pipe, names)
+	}
+}
@@ -0,0 +1,144 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/wylu1037/go-micro-boilerplate/pkg/db"
+	"github.com/wylu1037/go-micro-boilerplate/services/notification/internal/model"
+)
+
+const inboxColumns = `id, user_id, template_id, title, body, event_id, read_at, created_at`
+
+type InboxRepository interface {
+	// Create stores a message. A user gets at most one message per event; Create reports false for such a
+	// duplicate, e.g. because the broker redelivered the event.
+	Create(ctx context.Context, msg *model.InboxMessage) (bool, error)
+	// List returns the user's messages, newest first.
+	List(ctx context.Context, userID string, unreadOnly bool, page, pageSize int) ([]*model.InboxMessage, int64, error)
+	// MarkRead marks the given messages of the user as read and returns how many were unread.
+	MarkRead(ctx context.Context, userID string, ids []string) (int64, error)
+	// MarkAllRead marks all of the user's messages as read and returns how many were unread.
+	MarkAllRead(ctx context.Context, userID string) (int64, error)
+	CountUnread(ctx context.Context, userID string) (int64, error)
+}
+
+type inboxRepository struct {
+	db *db.Pool
+}
+
+func NewInboxRepository(db *db.Pool) InboxRepository {
+	return &inboxRepository{db: db}
+}
+
+func (r *inboxRepository) Create(ctx context.Context, msg *model.InboxMessage) (bool, error) {
+	query := `
+		INSERT INTO notification.inbox (user_id, template_id, title, body, event_id)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (user_id, event_id) WHERE event_id IS NOT NULL DO NOTHING
+		RETURNING id, created_at
+	`
+
+	err := r.db.QueryRow(ctx, query,
+		msg.UserID,
+		msg.TemplateID,
+		msg.Title,
+		msg.Body,
+		msg.EventID,
+	).Scan(&msg.ID, &msg.CreatedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (r *inboxRepository) List(ctx context.Context, userID string, unreadOnly bool, page, pageSize int) ([]*model.InboxMessage, int64, error) {
+	baseQuery := `FROM notification.inbox WHERE user_id = $1`
+	args := []any{userID}
+
+	if unreadOnly {
+		baseQuery += ` AND read_at IS NULL`
+	}
+
+	var total int64
+	if err := r.db.QueryRow(ctx, `SELECT COUNT(*) `+baseQuery, args...).Scan(&total); err != nil {
+		return nil, 0, err
+	}
+
+	listQuery := `SELECT ` + inboxColumns + ` ` + baseQuery +
+		fmt.Sprintf(` ORDER BY created_at DESC, id LIMIT $%d OFFSET $%d`, len(args)+1, len(args)+2)
+	args = append(args, pageSize, (page-1)*pageSize)
+
+	rows, err := r.db.Query(ctx, listQuery, args...)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	messages := []*model.InboxMessage{}
+	for rows.Next() {
+		m := &model.InboxMessage{}
+		if scanErr := rows.Scan(
+			&m.ID,
+			&m.UserID,
+			&m.TemplateID,
+			&m.Title,
+			&m.Body,
+			&m.EventID,
+			&m.ReadAt,
+			&m.CreatedAt,
+		); scanErr != nil {
+			return nil, 0, scanErr
+		}
+		messages = append(messages, m)
+	}
+	if rowsErr := rows.Err(); rowsErr != nil {
+		return nil, 0, rowsErr
+	}
+
+	return messages, total, nil
+}
+
+func (r *inboxRepository) MarkRead(ctx context.Context, userID string, ids []string) (int64, error) {
+	query := `
+		UPDATE notification.inbox
+		SET read_at = NOW()
+		WHERE user_id = $1 AND id = ANY($2) AND read_at IS NULL
+	`
+
+	tag, err := r.db.Exec(ctx, query, userID, ids)
+	if err != nil {
+		return 0, err
+	}
+	return tag.RowsAffected(), nil
+}
+
+func (r *inboxRepository) MarkAllRead(ctx context.Context, userID string) (int64, error) {
+	query := `
+		UPDATE notification.inbox
+		SET read_at = NOW()
+		WHERE user_id = $1 AND read_at IS NULL
+	`
+
+	tag, err := r.db.Exec(ctx, query, userID)
+	if err != nil {
+		return 0, err
+	}
+	return tag.RowsAffected(), nil
+}
+
+func (r *inboxRepository) CountUnread(ctx context.Context, userID string) (int64, error) {
+	query := `SELECT COUNT(*) FROM notification.inbox WHERE user_id = $1 AND read_at IS NULL`
+
+	var count int64
+	if err := r.db.QueryRow(ctx, query, userID).Scan(&count); err != nil {
+		return 0, err
+	}
+	return count, nil
+}
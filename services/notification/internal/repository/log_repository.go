@@ -0,0 +1,226 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/wylu1037/go-micro-boilerplate/pkg/db"
+	"github.com/wylu1037/go-micro-boilerplate/services/notification/internal/model"
+)
+
+const logColumns = `id, template_id, user_id, type, category, recipient, subject, content, html, status, attempts, next_attempt_at,
+	sent_at, error_message, event_id, created_at`
+
+type LogRepository interface {
+	// Create records a pending delivery. A delivery of the same template for the same event is only recorded
+	// once; Create reports false for such a duplicate, e.g. because the broker redelivered the event.
+	Create(ctx context.Context, log *model.NotificationLog) (bool, error)
+	// UpdateStatus stores the outcome of a delivery attempt.
+	UpdateStatus(ctx context.Context, log *model.NotificationLog) error
+	// ClaimDue leases up to limit deliveries that are due for another attempt until leaseUntil: failed ones
+	// whose backoff has passed, and pending ones whose previous lease lapsed because the sender crashed.
+	// Rows are claimed with FOR UPDATE SKIP LOCKED, so concurrent workers never claim the same delivery.
+	ClaimDue(ctx context.Context, limit int, leaseUntil time.Time) ([]*model.NotificationLog, error)
+	// Requeue leases a failed or dead delivery until leaseUntil with its attempts reset, so it gets a fresh
+	// set of retries. It returns nil if the delivery does not exist or is not failed or dead.
+	Requeue(ctx context.Context, id string, leaseUntil time.Time) (*model.NotificationLog, error)
+	GetByID(ctx context.Context, id string) (*model.NotificationLog, error)
+	// List returns matching deliveries, newest first.
+	List(ctx context.Context, filter model.NotificationFilter, page, pageSize int) ([]*model.NotificationLog, int64, error)
+}
+
+type logRepository struct {
+	db *db.Pool
+}
+
+func NewLogRepository(db *db.Pool) LogRepository {
+	return &logRepository{db: db}
+}
+
+func (r *logRepository) Create(ctx context.Context, log *model.NotificationLog) (bool, error) {
+	query := `
+		INSERT INTO notification.logs (
+			template_id, user_id, type, category, recipient, subject, content, html, status, next_attempt_at, event_id
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+		ON CONFLICT (event_id, template_id) WHERE event_id IS NOT NULL DO NOTHING
+		RETURNING id, created_at
+	`
+
+	err := r.db.QueryRow(ctx, query,
+		log.TemplateID,
+		log.UserID,
+		log.Type,
+		log.Category,
+		log.Recipient,
+		log.Subject,
+		log.Content,
+		log.HTML,
+		log.Status,
+		log.NextAttemptAt,
+		log.EventID,
+	).Scan(&log.ID, &log.CreatedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (r *logRepository) UpdateStatus(ctx context.Context, log *model.NotificationLog) error {
+	query := `
+		UPDATE notification.logs
+		SET status = $1, attempts = $2, next_attempt_at = $3, sent_at = $4, error_message = $5
+		WHERE id = $6
+	`
+
+	_, err := r.db.Exec(ctx, query, log.Status, log.Attempts, log.NextAttemptAt, log.SentAt, log.ErrorMessage, log.ID)
+	return err
+}
+
+func (r *logRepository) ClaimDue(ctx context.Context, limit int, leaseUntil time.Time) ([]*model.NotificationLog, error) {
+	query := `
+		UPDATE notification.logs
+		SET status = 'pending', next_attempt_at = $1
+		WHERE id IN (
+			SELECT id FROM notification.logs
+			WHERE status IN ('pending', 'failed') AND next_attempt_at <= NOW()
+			ORDER BY next_attempt_at
+			LIMIT $2
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING ` + logColumns
+
+	rows, err := r.db.Query(ctx, query, leaseUntil, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	logs := []*model.NotificationLog{}
+	for rows.Next() {
+		l, scanErr := scanLog(rows)
+		if scanErr != nil {
+			return nil, scanErr
+		}
+		logs = append(logs, l)
+	}
+	if rowsErr := rows.Err(); rowsErr != nil {
+		return nil, rowsErr
+	}
+	return logs, nil
+}
+
+func (r *logRepository) Requeue(ctx context.Context, id string, leaseUntil time.Time) (*model.NotificationLog, error) {
+	query := `
+		UPDATE notification.logs
+		SET status = 'pending', attempts = 0, next_attempt_at = $1
+		WHERE id = $2 AND status IN ('failed', 'dead')
+		RETURNING ` + logColumns
+
+	l, err := scanLog(r.db.QueryRow(ctx, query, leaseUntil, id))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return l, nil
+}
+
+func (r *logRepository) GetByID(ctx context.Context, id string) (*model.NotificationLog, error) {
+	query := `SELECT ` + logColumns + ` FROM notification.logs WHERE id = $1`
+
+	l, err := scanLog(r.db.QueryRow(ctx, query, id))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return l, nil
+}
+
+func (r *logRepository) List(ctx context.Context, filter model.NotificationFilter, page, pageSize int) ([]*model.NotificationLog, int64, error) {
+	baseQuery := `FROM notification.logs WHERE 1=1`
+	args := []any{}
+
+	if filter.UserID != "" {
+		args = append(args, filter.UserID)
+		baseQuery += fmt.Sprintf(` AND user_id = $%d`, len(args))
+	}
+	if filter.Type != "" {
+		args = append(args, filter.Type)
+		baseQuery += fmt.Sprintf(` AND type = $%d`, len(args))
+	}
+	if filter.Status != "" {
+		args = append(args, filter.Status)
+		baseQuery += fmt.Sprintf(` AND status = $%d`, len(args))
+	}
+	if filter.CreatedAfter != nil {
+		args = append(args, *filter.CreatedAfter)
+		baseQuery += fmt.Sprintf(` AND created_at >= $%d`, len(args))
+	}
+	if filter.CreatedBefore != nil {
+		args = append(args, *filter.CreatedBefore)
+		baseQuery += fmt.Sprintf(` AND created_at < $%d`, len(args))
+	}
+
+	var total int64
+	if err := r.db.QueryRow(ctx, `SELECT COUNT(*) `+baseQuery, args...).Scan(&total); err != nil {
+		return nil, 0, err
+	}
+
+	listQuery := `SELECT ` + logColumns + ` ` + baseQuery +
+		fmt.Sprintf(` ORDER BY created_at DESC, id LIMIT $%d OFFSET $%d`, len(args)+1, len(args)+2)
+	args = append(args, pageSize, (page-1)*pageSize)
+
+	rows, err := r.db.Query(ctx, listQuery, args...)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	logs := []*model.NotificationLog{}
+	for rows.Next() {
+		l, scanErr := scanLog(rows)
+		if scanErr != nil {
+			return nil, 0, scanErr
+		}
+		logs = append(logs, l)
+	}
+	if rowsErr := rows.Err(); rowsErr != nil {
+		return nil, 0, rowsErr
+	}
+
+	return logs, total, nil
+}
+
+func scanLog(row pgx.Row) (*model.NotificationLog, error) {
+	l := &model.NotificationLog{}
+	err := row.Scan(
+		&l.ID,
+		&l.TemplateID,
+		&l.UserID,
+		&l.Type,
+		&l.Category,
+		&l.Recipient,
+		&l.Subject,
+		&l.Content,
+		&l.HTML,
+		&l.Status,
+		&l.Attempts,
+		&l.NextAttemptAt,
+		&l.SentAt,
+		&l.ErrorMessage,
+		&l.EventID,
+		&l.CreatedAt,
+	)
+	return l, err
+}
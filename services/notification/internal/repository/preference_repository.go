@@ -0,0 +1,87 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/wylu1037/go-micro-boilerplate/pkg/db"
+	"github.com/wylu1037/go-micro-boilerplate/services/notification/internal/model"
+)
+
+type PreferenceRepository interface {
+	// Get returns the user's saved preferences, or nil if the user never saved any.
+	Get(ctx context.Context, userID string) (*model.Preferences, error)
+	// Upsert saves all of the user's preferences.
+	Upsert(ctx context.Context, prefs *model.Preferences) error
+}
+
+type preferenceRepository struct {
+	db *db.Pool
+}
+
+func NewPreferenceRepository(db *db.Pool) PreferenceRepository {
+	return &preferenceRepository{db: db}
+}
+
+func (r *preferenceRepository) Get(ctx context.Context, userID string) (*model.Preferences, error) {
+	query := `
+		SELECT user_id, email_enabled, sms_enabled, marketing_enabled, quiet_hours_enabled,
+		       quiet_hours_start, quiet_hours_end, timezone, created_at, updated_at
+		FROM notification.preferences
+		WHERE user_id = $1
+	`
+
+	p := &model.Preferences{}
+	err := r.db.QueryRow(ctx, query, userID).Scan(
+		&p.UserID,
+		&p.EmailEnabled,
+		&p.SMSEnabled,
+		&p.MarketingEnabled,
+		&p.QuietHoursEnabled,
+		&p.QuietHoursStart,
+		&p.QuietHoursEnd,
+		&p.Timezone,
+		&p.CreatedAt,
+		&p.UpdatedAt,
+	)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+func (r *preferenceRepository) Upsert(ctx context.Context, prefs *model.Preferences) error {
+	query := `
+		INSERT INTO notification.preferences (
+			user_id, email_enabled, sms_enabled, marketing_enabled, quiet_hours_enabled,
+			quiet_hours_start, quiet_hours_end, timezone
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		ON CONFLICT (user_id) DO UPDATE SET
+			email_enabled = EXCLUDED.email_enabled,
+			sms_enabled = EXCLUDED.sms_enabled,
+			marketing_enabled = EXCLUDED.marketing_enabled,
+			quiet_hours_enabled = EXCLUDED.quiet_hours_enabled,
+			quiet_hours_start = EXCLUDED.quiet_hours_start,
+			quiet_hours_end = EXCLUDED.quiet_hours_end,
+			timezone = EXCLUDED.timezone,
+			updated_at = NOW()
+		RETURNING created_at, updated_at
+	`
+
+	return r.db.QueryRow(ctx, query,
+		prefs.UserID,
+		prefs.EmailEnabled,
+		prefs.SMSEnabled,
+		prefs.MarketingEnabled,
+		prefs.QuietHoursEnabled,
+		prefs.QuietHoursStart,
+		prefs.QuietHoursEnd,
+		prefs.Timezone,
+	).Scan(&prefs.CreatedAt, &prefs.UpdatedAt)
+}
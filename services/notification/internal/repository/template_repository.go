@@ -0,0 +1,185 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/wylu1037/go-micro-boilerplate/pkg/db"
+	"github.com/wylu1037/go-micro-boilerplate/services/notification/internal/model"
+)
+
+const templateColumns = `id, name, type, category, locale, subject, content, html, created_at, updated_at`
+
+type TemplateRepository interface {
+	// Find returns the variant of the named template that best matches locale: the exact locale, then another
+	// variant of the same language ("en-GB" for "en-US"), then model.DefaultLocale. It returns nil if none exists.
+	Find(ctx context.Context, name, locale string) (*model.Template, error)
+	// Create inserts the template and reports false, inserting nothing, if the name already has a variant
+	// for the locale.
+	Create(ctx context.Context, tmpl *model.Template) (bool, error)
+	GetByID(ctx context.Context, id string) (*model.Template, error)
+	List(ctx context.Context, filter model.TemplateFilter, page, pageSize int) ([]*model.Template, int64, error)
+	// Update stores the category, subject, content and html flag; it reports false if the template does not exist.
+	Update(ctx context.Context, tmpl *model.Template) (bool, error)
+	Delete(ctx context.Context, id string) (bool, error)
+}
+
+type templateRepository struct {
+	db *db.Pool
+}
+
+func NewTemplateRepository(db *db.Pool) TemplateRepository {
+	return &templateRepository{db: db}
+}
+
+func (r *templateRepository) Find(ctx context.Context, name, locale string) (*model.Template, error) {
+	query := `
+		SELECT ` + templateColumns + `
+		FROM notification.templates
+		WHERE name = $1
+		  AND (locale = $2 OR split_part(locale, '-', 1) = split_part($2, '-', 1) OR locale = $3)
+		ORDER BY locale = $2 DESC, split_part(locale, '-', 1) = split_part($2, '-', 1) DESC, locale
+		LIMIT 1
+	`
+
+	t, err := scanTemplate(r.db.QueryRow(ctx, query, name, locale, model.DefaultLocale))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+func (r *templateRepository) Create(ctx context.Context, tmpl *model.Template) (bool, error) {
+	query := `
+		INSERT INTO notification.templates (name, type, category, locale, subject, content, html)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		ON CONFLICT (name, locale) DO NOTHING
+		RETURNING id, created_at, updated_at
+	`
+
+	err := r.db.QueryRow(ctx, query,
+		tmpl.Name,
+		tmpl.Type,
+		tmpl.Category,
+		tmpl.Locale,
+		tmpl.Subject,
+		tmpl.Content,
+		tmpl.HTML,
+	).Scan(&tmpl.ID, &tmpl.CreatedAt, &tmpl.UpdatedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (r *templateRepository) GetByID(ctx context.Context, id string) (*model.Template, error) {
+	query := `SELECT ` + templateColumns + ` FROM notification.templates WHERE id = $1`
+
+	t, err := scanTemplate(r.db.QueryRow(ctx, query, id))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+func (r *templateRepository) List(ctx context.Context, filter model.TemplateFilter, page, pageSize int) ([]*model.Template, int64, error) {
+	baseQuery := `FROM notification.templates WHERE 1=1`
+	args := []any{}
+
+	if filter.Name != "" {
+		args = append(args, filter.Name)
+		baseQuery += fmt.Sprintf(` AND name = $%d`, len(args))
+	}
+	if filter.Type != "" {
+		args = append(args, filter.Type)
+		baseQuery += fmt.Sprintf(` AND type = $%d`, len(args))
+	}
+	if filter.Locale != "" {
+		args = append(args, filter.Locale)
+		baseQuery += fmt.Sprintf(` AND locale = $%d`, len(args))
+	}
+
+	var total int64
+	if err := r.db.QueryRow(ctx, `SELECT COUNT(*) `+baseQuery, args...).Scan(&total); err != nil {
+		return nil, 0, err
+	}
+
+	listQuery := `SELECT ` + templateColumns + ` ` + baseQuery +
+		fmt.Sprintf(` ORDER BY name, locale LIMIT $%d OFFSET $%d`, len(args)+1, len(args)+2)
+	args = append(args, pageSize, (page-1)*pageSize)
+
+	rows, err := r.db.Query(ctx, listQuery, args...)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	templates := []*model.Template{}
+	for rows.Next() {
+		t, scanErr := scanTemplate(rows)
+		if scanErr != nil {
+			return nil, 0, scanErr
+		}
+		templates = append(templates, t)
+	}
+	if rowsErr := rows.Err(); rowsErr != nil {
+		return nil, 0, rowsErr
+	}
+
+	return templates, total, nil
+}
+
+func (r *templateRepository) Update(ctx context.Context, tmpl *model.Template) (bool, error) {
+	query := `
+		UPDATE notification.templates
+		SET category = $1, subject = $2, content = $3, html = $4, updated_at = NOW()
+		WHERE id = $5
+		RETURNING updated_at
+	`
+
+	err := r.db.QueryRow(ctx, query, tmpl.Category, tmpl.Subject, tmpl.Content, tmpl.HTML, tmpl.ID).Scan(&tmpl.UpdatedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (r *templateRepository) Delete(ctx context.Context, id string) (bool, error) {
+	tag, err := r.db.Exec(ctx, `DELETE FROM notification.templates WHERE id = $1`, id)
+	if err != nil {
+		return false, err
+	}
+	return tag.RowsAffected() > 0, nil
+}
+
+func scanTemplate(row pgx.Row) (*model.Template, error) {
+	t := &model.Template{}
+	err := row.Scan(
+		&t.ID,
+		&t.Name,
+		&t.Type,
+		&t.Category,
+		&t.Locale,
+		&t.Subject,
+		&t.Content,
+		&t.HTML,
+		&t.CreatedAt,
+		&t.UpdatedAt,
+	)
+	return t, err
+}
@@ -1,17 +1,23 @@
 package router
 
 import (
-	notificationv1 "github.com/wylu1037/go-micro-boilerplate/gen/go/notification/v1"
 	"go-micro.dev/v4"
+	"go-micro.dev/v4/server"
+
+	notificationv1 "github.com/wylu1037/go-micro-boilerplate/gen/go/notification/v1"
+	"github.com/wylu1037/go-micro-boilerplate/services/notification/internal/service"
+	"github.com/wylu1037/go-micro-boilerplate/services/notification/internal/subscriber"
 )
 
 func NewRouter(
 	service micro.Service,
 	handler notificationv1.NotificationServiceHandler,
+	orderEvents *subscriber.OrderEventSubscriber,
 ) Router {
 	return &router{
 		microService: service,
 		handler:      handler,
+		orderEvents:  orderEvents,
 	}
 }
 
@@ -22,8 +28,15 @@ type Router interface {
 type router struct {
 	microService micro.Service
 	handler      notificationv1.NotificationServiceHandler
+	orderEvents  *subscriber.OrderEventSubscriber
 }
 
 func (r *router) Register() {
 	notificationv1.RegisterNotificationServiceHandler(r.microService.Server(), r.handler)
+
+	// Replicas share a queue, so each event is handled by one of them.
+	queue := server.SubscriberQueue(r.microService.Server().Options().Name)
+	for _, topic := range service.OrderEventTopics() {
+		micro.RegisterSubscriber(topic, r.microService.Server(), r.orderEvents.Handle, queue)
+	}
 }
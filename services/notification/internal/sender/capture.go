@@ -0,0 +1,123 @@
+package sender
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/wylu1037/go-micro-boilerplate/pkg/config"
+	"github.com/wylu1037/go-micro-boilerplate/services/notification/internal/model"
+)
+
+const defaultCaptureLimit = 1000
+
+// CapturedMessage is a message the capture sink stored instead of delivering.
+type CapturedMessage struct {
+	ID      string                 `json:"id"`
+	Type    model.NotificationType `json:"type"`
+	To      string                 `json:"to"`
+	Subject string                 `json:"subject,omitempty"`
+	Body    string                 `json:"body"`
+	HTML    bool                   `json:"html,omitempty"`
+	SentAt  time.Time              `json:"sentAt"`
+}
+
+// CaptureSink stores messages instead of delivering them, so local runs and end-to-end tests can check
+// what a user would have received. The newest messages are kept in memory; with a path configured every
+// message is also appended to that file as a JSON line.
+type CaptureSink struct {
+	path  string
+	limit int
+
+	mu       sync.Mutex
+	messages []*CapturedMessage
+}
+
+func NewCaptureSink(cfg config.CaptureConfig) (*CaptureSink, error) {
+	limit := cfg.Limit
+	if limit <= 0 {
+		limit = defaultCaptureLimit
+	}
+
+	if cfg.Path != "" {
+		f, err := os.OpenFile(cfg.Path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open capture file: %w", err)
+		}
+		if closeErr := f.Close(); closeErr != nil {
+			return nil, closeErr
+		}
+	}
+
+	return &CaptureSink{path: cfg.Path, limit: limit}, nil
+}
+
+func (s *CaptureSink) Send(_ context.Context, msg *Message) (string, error) {
+	captured := &CapturedMessage{
+		ID:      uuid.NewString(),
+		Type:    msg.Type,
+		To:      msg.To,
+		Subject: msg.Subject,
+		Body:    msg.Body,
+		HTML:    msg.HTML,
+		SentAt:  time.Now(),
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.path != "" {
+		if err := s.appendToFile(captured); err != nil {
+			return "", err
+		}
+	}
+
+	s.messages = append(s.messages, captured)
+	if len(s.messages) > s.limit {
+		s.messages = s.messages[len(s.messages)-s.limit:]
+	}
+	return captured.ID, nil
+}
+
+// List returns up to limit captured messages, newest first, optionally only those sent to recipient.
+func (s *CaptureSink) List(recipient string, limit int) []*CapturedMessage {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	result := []*CapturedMessage{}
+	for i := len(s.messages) - 1; i >= 0 && len(result) < limit; i-- {
+		if recipient == "" || s.messages[i].To == recipient {
+			result = append(result, s.messages[i])
+		}
+	}
+	return result
+}
+
+// Clear drops the captured messages kept in memory; the capture file is left alone.
+func (s *CaptureSink) Clear() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.messages = nil
+}
+
+func (s *CaptureSink) appendToFile(msg *CapturedMessage) error {
+	line, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+
+	f, err := os.OpenFile(s.path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644)
+	if err != nil {
+		return fmt.Errorf("failed to open capture file: %w", err)
+	}
+	defer f.Close()
+
+	_, err = f.Write(append(line, '\n'))
+	return err
+}
@@ -0,0 +1,28 @@
+package sender
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/wylu1037/go-micro-boilerplate/services/notification/internal/model"
+)
+
+// LogSender only logs messages. It is the default when no provider is configured.
+type LogSender struct {
+	logger *zap.Logger
+}
+
+func NewLogSender(logger *zap.Logger) *LogSender {
+	return &LogSender{logger: logger}
+}
+
+func (s *LogSender) Send(_ context.Context, msg *Message) (string, error) {
+	if msg.Type == model.NotificationTypeSMS {
+		s.logger.Info("Sending SMS", zap.String("phone", msg.To), zap.String("message", msg.Body))
+	} else {
+		s.logger.Info("Sending email", zap.String("to", msg.To), zap.String("subject", msg.Subject), zap.String("body", msg.Body))
+	}
+	return uuid.NewString(), nil
+}
@@ -0,0 +1,97 @@
+package sender
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	"github.com/wylu1037/go-micro-boilerplate/pkg/config"
+	"github.com/wylu1037/go-micro-boilerplate/services/notification/internal/model"
+)
+
+const (
+	ProviderLog     = "log"
+	ProviderCapture = "capture"
+	ProviderSMTP    = "smtp"
+	ProviderHTTP    = "http"
+)
+
+// Message is a rendered notification ready to be delivered.
+type Message struct {
+	Type    model.NotificationType
+	To      string // Email address or phone number
+	Subject string // Only for email
+	Body    string
+	HTML    bool // Body is HTML; only for email
+}
+
+// Sender delivers messages of one channel to a provider and returns the provider's message ID.
+type Sender interface {
+	Send(ctx context.Context, msg *Message) (string, error)
+}
+
+// Registry routes each message to the sender configured for its channel.
+type Registry struct {
+	senders map[model.NotificationType]Sender
+	capture *CaptureSink
+}
+
+// NewRegistry builds the senders selected by notification.email_provider and notification.sms_provider.
+func NewRegistry(cfg *config.Config, logger *zap.Logger) (*Registry, error) {
+	r := &Registry{senders: map[model.NotificationType]Sender{}}
+
+	providers := map[model.NotificationType]string{
+		model.NotificationTypeEmail: cfg.Notification.EmailProvider,
+		model.NotificationTypeSMS:   cfg.Notification.SMSProvider,
+	}
+	for typ, provider := range providers {
+		if provider == "" {
+			provider = ProviderLog
+		}
+		s, err := r.newSender(cfg, logger, typ, provider)
+		if err != nil {
+			return nil, err
+		}
+		r.senders[typ] = s
+		logger.Info("Notification sender configured", zap.String("type", string(typ)), zap.String("provider", provider))
+	}
+
+	return r, nil
+}
+
+func (r *Registry) newSender(cfg *config.Config, logger *zap.Logger, typ model.NotificationType, provider string) (Sender, error) {
+	switch {
+	case provider == ProviderLog:
+		return NewLogSender(logger), nil
+	case provider == ProviderCapture:
+		if r.capture == nil {
+			capture, err := NewCaptureSink(cfg.Notification.Capture)
+			if err != nil {
+				return nil, err
+			}
+			r.capture = capture
+		}
+		return r.capture, nil
+	case provider == ProviderSMTP && typ == model.NotificationTypeEmail:
+		return NewSMTPSender(cfg.Notification.SMTP)
+	case provider == ProviderHTTP && typ == model.NotificationTypeSMS:
+		return NewHTTPSMSSender(cfg.Notification.SMS)
+	default:
+		return nil, fmt.Errorf("unsupported %s provider %q", typ, provider)
+	}
+}
+
+// Send delivers the message with the sender of its channel.
+func (r *Registry) Send(ctx context.Context, msg *Message) (string, error) {
+	s, ok := r.senders[msg.Type]
+	if !ok {
+		return "", fmt.Errorf("unsupported notification type %q", msg.Type)
+	}
+	return s.Send(ctx, msg)
+}
+
+// Capture returns the capture sink, or nil if no channel is configured to use it.
+func (r *Registry) Capture() *CaptureSink {
+	return r.capture
+}
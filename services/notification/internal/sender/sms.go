@@ -0,0 +1,90 @@
+package sender
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/wylu1037/go-micro-boilerplate/pkg/config"
+)
+
+const defaultSMSTimeout = 10 * time.Second
+
+// HTTPSMSSender delivers SMS through an HTTP gateway. It POSTs {"to", "message", "sender"} as JSON with the
+// API key as a bearer token and expects a 2xx response, optionally carrying {"messageId"}.
+type HTTPSMSSender struct {
+	url    string
+	apiKey string
+	sender string
+	client *http.Client
+}
+
+func NewHTTPSMSSender(cfg config.SMSConfig) (Sender, error) {
+	if cfg.URL == "" {
+		return nil, errors.New("notification.sms.url is required")
+	}
+
+	timeout := cfg.Timeout
+	if timeout <= 0 {
+		timeout = defaultSMSTimeout
+	}
+
+	return &HTTPSMSSender{
+		url:    cfg.URL,
+		apiKey: cfg.APIKey,
+		sender: cfg.Sender,
+		client: &http.Client{Timeout: timeout},
+	}, nil
+}
+
+type smsRequest struct {
+	To      string `json:"to"`
+	Message string `json:"message"`
+	Sender  string `json:"sender,omitempty"`
+}
+
+type smsResponse struct {
+	MessageID string `json:"messageId"`
+}
+
+func (s *HTTPSMSSender) Send(ctx context.Context, msg *Message) (string, error) {
+	payload, err := json.Marshal(smsRequest{To: msg.To, Message: msg.Body, Sender: s.sender})
+	if err != nil {
+		return "", err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(payload))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if s.apiKey != "" {
+		req.Header.Set("Authorization", "Bearer "+s.apiKey)
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("sms gateway request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 64<<10))
+	if err != nil {
+		return "", fmt.Errorf("failed to read sms gateway response: %w", err)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return "", fmt.Errorf("sms gateway returned %d: %s", resp.StatusCode, bytes.TrimSpace(body))
+	}
+
+	var result smsResponse
+	if len(body) > 0 {
+		// Gateways that do not return JSON still accepted the message.
+		_ = json.Unmarshal(body, &result)
+	}
+	return result.MessageID, nil
+}
@@ -0,0 +1,158 @@
+package sender
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"mime"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/wylu1037/go-micro-boilerplate/pkg/config"
+)
+
+const smtpTimeout = 30 * time.Second
+
+// SMTPSender delivers email through an SMTP server, upgrading to TLS when the server offers STARTTLS.
+type SMTPSender struct {
+	host string
+	addr string
+	from *mail.Address
+	auth smtp.Auth
+}
+
+func NewSMTPSender(cfg config.SMTPConfig) (Sender, error) {
+	if cfg.Host == "" {
+		return nil, errors.New("notification.smtp.host is required")
+	}
+	from, err := mail.ParseAddress(cfg.From)
+	if err != nil {
+		return nil, fmt.Errorf("invalid notification.smtp.from: %w", err)
+	}
+
+	port := cfg.Port
+	if port == 0 {
+		port = 587
+	}
+
+	s := &SMTPSender{
+		host: cfg.Host,
+		addr: net.JoinHostPort(cfg.Host, strconv.Itoa(port)),
+		from: from,
+	}
+	if cfg.Username != "" {
+		s.auth = smtp.PlainAuth("", cfg.Username, cfg.Password, cfg.Host)
+	}
+	return s, nil
+}
+
+func (s *SMTPSender) Send(ctx context.Context, msg *Message) (string, error) {
+	if strings.ContainsAny(msg.To, "\r\n") {
+		return "", fmt.Errorf("invalid recipient %q", msg.To)
+	}
+	to, err := mail.ParseAddress(msg.To)
+	if err != nil {
+		return "", fmt.Errorf("invalid recipient %q: %w", msg.To, err)
+	}
+
+	messageID := fmt.Sprintf("<%s@%s>", uuid.NewString(), s.domain())
+	data := s.buildMessage(to, messageID, msg)
+
+	deadline := time.Now().Add(smtpTimeout)
+	if d, ok := ctx.Deadline(); ok && d.Before(deadline) {
+		deadline = d
+	}
+
+	dialer := &net.Dialer{Deadline: deadline}
+	conn, err := dialer.DialContext(ctx, "tcp", s.addr)
+	if err != nil {
+		return "", fmt.Errorf("failed to connect to smtp server: %w", err)
+	}
+	if deadlineErr := conn.SetDeadline(deadline); deadlineErr != nil {
+		conn.Close()
+		return "", deadlineErr
+	}
+
+	client, err := smtp.NewClient(conn, s.host)
+	if err != nil {
+		conn.Close()
+		return "", fmt.Errorf("failed to start smtp session: %w", err)
+	}
+	defer client.Close()
+
+	if ok, _ := client.Extension("STARTTLS"); ok {
+		if tlsErr := client.StartTLS(&tls.Config{ServerName: s.host}); tlsErr != nil {
+			return "", fmt.Errorf("smtp starttls failed: %w", tlsErr)
+		}
+	}
+	if s.auth != nil {
+		if authErr := client.Auth(s.auth); authErr != nil {
+			return "", fmt.Errorf("smtp auth failed: %w", authErr)
+		}
+	}
+
+	if mailErr := client.Mail(s.from.Address); mailErr != nil {
+		return "", fmt.Errorf("smtp MAIL FROM failed: %w", mailErr)
+	}
+	if rcptErr := client.Rcpt(to.Address); rcptErr != nil {
+		return "", fmt.Errorf("smtp RCPT TO failed: %w", rcptErr)
+	}
+
+	w, err := client.Data()
+	if err != nil {
+		return "", fmt.Errorf("smtp DATA failed: %w", err)
+	}
+	if _, writeErr := w.Write(data); writeErr != nil {
+		w.Close()
+		return "", fmt.Errorf("failed to write message: %w", writeErr)
+	}
+	if closeErr := w.Close(); closeErr != nil {
+		return "", fmt.Errorf("smtp server rejected message: %w", closeErr)
+	}
+
+	// The message is accepted once DATA completes; a failed QUIT does not undo that.
+	_ = client.Quit()
+	return messageID, nil
+}
+
+func (s *SMTPSender) buildMessage(to *mail.Address, messageID string, msg *Message) []byte {
+	contentType := "text/plain"
+	if msg.HTML {
+		contentType = "text/html"
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "From: %s\r\n", s.from.String())
+	fmt.Fprintf(&buf, "To: %s\r\n", to.String())
+	fmt.Fprintf(&buf, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", msg.Subject))
+	fmt.Fprintf(&buf, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	fmt.Fprintf(&buf, "Message-ID: %s\r\n", messageID)
+	buf.WriteString("MIME-Version: 1.0\r\n")
+	fmt.Fprintf(&buf, "Content-Type: %s; charset=UTF-8\r\n", contentType)
+	buf.WriteString("Content-Transfer-Encoding: base64\r\n\r\n")
+
+	encoded := base64.StdEncoding.EncodeToString([]byte(msg.Body))
+	for len(encoded) > 76 {
+		buf.WriteString(encoded[:76] + "\r\n")
+		encoded = encoded[76:]
+	}
+	buf.WriteString(encoded + "\r\n")
+
+	return buf.Bytes()
+}
+
+func (s *SMTPSender) domain() string {
+	if at := strings.LastIndex(s.from.Address, "@"); at >= 0 {
+		return s.from.Address[at+1:]
+	}
+	return s.host
+}
@@ -0,0 +1,62 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	"github.com/wylu1037/go-micro-boilerplate/services/notification/internal/model"
+	"github.com/wylu1037/go-micro-boilerplate/services/notification/internal/repository"
+)
+
+type InboxService interface {
+	// Deliver puts a message in the user's inbox. It reports false if the user already has a message for
+	// the same event.
+	Deliver(ctx context.Context, msg *model.InboxMessage) (bool, error)
+	ListInbox(ctx context.Context, userID string, unreadOnly bool, page, pageSize int) ([]*model.InboxMessage, int64, error)
+	// MarkRead marks the user's messages as read and returns how many were unread. IDs of other users'
+	// messages are ignored.
+	MarkRead(ctx context.Context, userID string, ids []string) (int64, error)
+	MarkAllRead(ctx context.Context, userID string) (int64, error)
+	GetUnreadCount(ctx context.Context, userID string) (int64, error)
+}
+
+type inboxService struct {
+	repo   repository.InboxRepository
+	logger *zap.Logger
+}
+
+func NewInboxService(repo repository.InboxRepository, logger *zap.Logger) InboxService {
+	return &inboxService{repo: repo, logger: logger}
+}
+
+func (s *inboxService) Deliver(ctx context.Context, msg *model.InboxMessage) (bool, error) {
+	created, err := s.repo.Create(ctx, msg)
+	if err != nil {
+		return false, fmt.Errorf("failed to store inbox message: %w", err)
+	}
+	if created {
+		s.logger.Info("Inbox message delivered",
+			zap.String("message_id", msg.ID),
+			zap.String("user_id", msg.UserID),
+		)
+	}
+	return created, nil
+}
+
+func (s *inboxService) ListInbox(ctx context.Context, userID string, unreadOnly bool, page, pageSize int) ([]*model.InboxMessage, int64, error) {
+	return s.repo.List(ctx, userID, unreadOnly, page, pageSize)
+}
+
+func (s *inboxService) MarkRead(ctx context.Context, userID string, ids []string) (int64, error) {
+	return s.repo.MarkRead(ctx, userID, ids)
+}
+
+func (s *inboxService) MarkAllRead(ctx context.Context, userID string) (int64, error) {
+	return s.repo.MarkAllRead(ctx, userID)
+}
+
+func (s *inboxService) GetUnreadCount(ctx context.Context, userID string) (int64, error) {
+	return s.repo.CountUnread(ctx, userID)
+}
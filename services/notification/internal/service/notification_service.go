@@ -2,82 +2,342 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net/http"
+	"time"
 
+	"github.com/samber/lo"
+	"go-micro.dev/v4/auth"
+	microerrors "go-micro.dev/v4/errors"
 	"go.uber.org/zap"
 
-	"github.com/wylu1037/go-micro-boilerplate/pkg/db"
+	bookingv1 "github.com/wylu1037/go-micro-boilerplate/gen/go/booking/v1"
+	identityv1 "github.com/wylu1037/go-micro-boilerplate/gen/go/identity/v1"
+	"github.com/wylu1037/go-micro-boilerplate/pkg/config"
+	"github.com/wylu1037/go-micro-boilerplate/pkg/middleware"
 	"github.com/wylu1037/go-micro-boilerplate/services/notification/internal/model"
+	"github.com/wylu1037/go-micro-boilerplate/services/notification/internal/render"
+	"github.com/wylu1037/go-micro-boilerplate/services/notification/internal/repository"
+	"github.com/wylu1037/go-micro-boilerplate/services/notification/internal/sender"
+)
+
+var (
+	ErrNotificationNotFound = errors.New("notification not found")
+	ErrRecipientNotFound    = errors.New("recipient not found")
+	ErrNoRecipient          = errors.New("user has no address for this channel")
+	ErrSendFailed           = errors.New("failed to send notification")
+	ErrNotRetryable         = errors.New("only failed or dead notifications can be retried")
 )
 
 type NotificationService interface {
-	SendEmail(ctx context.Context, to, subject, body string) (string, error)
-	SendSMS(ctx context.Context, phone, message string) (string, error)
+	// SendEmail and SendSMS send a message directly, optionally on behalf of a user, and return the
+	// recorded delivery. A delivery the provider rejects is recorded as failed and ErrSendFailed is returned.
+	// The category defaults to transactional; other categories respect the user's preferences.
+	SendEmail(ctx context.Context, userID string, category model.NotificationCategory, to, subject, body string) (*model.NotificationLog, error)
+	SendSMS(ctx context.Context, userID string, category model.NotificationCategory, phone, message string) (*model.NotificationLog, error)
+	// SendTemplated renders a stored template in the requested locale and sends it to the user,
+	// returning the recorded delivery and what was sent.
+	SendTemplated(ctx context.Context, msg *model.TemplatedMessage) (*model.NotificationLog, *render.Message, error)
+	// HandleOrderEvent sends the templates mapped to a booking event to the user who owns the order, or
+	// puts them in the user's inbox for in-app templates. Each template is delivered at most once per
+	// event, so redelivered events are safe.
+	HandleOrderEvent(ctx context.Context, event *bookingv1.OrderEvent) error
+	GetNotification(ctx context.Context, id string) (*model.NotificationLog, error)
+	ListNotifications(ctx context.Context, filter model.NotificationFilter, page, pageSize int) ([]*model.NotificationLog, int64, error)
+	// RetryNotification sends a failed or dead delivery again right away with its attempts reset, and
+	// returns it with the outcome. If that attempt fails the delivery is retried with backoff as usual.
+	RetryNotification(ctx context.Context, id string) (*model.NotificationLog, error)
+	// RetryDue claims up to limit deliveries that are due for a retry; each must be passed to Redeliver.
+	RetryDue(ctx context.Context, limit int) ([]*model.NotificationLog, error)
+	// Redeliver makes another attempt at a claimed delivery and stores the outcome.
+	Redeliver(ctx context.Context, log *model.NotificationLog) error
 }
 
 type notificationService struct {
-	db     *db.Pool
-	logger *zap.Logger
+	templates      TemplateService
+	logRepo        repository.LogRepository
+	senders        *sender.Registry
+	preferences    PreferenceService
+	inbox          InboxService
+	retry          retryPolicy
+	identityClient identityv1.IdentityService
+	auth           auth.Auth
+	serviceName    string
+	logger         *zap.Logger
 }
 
-func NewNotificationService(db *db.Pool, logger *zap.Logger) NotificationService {
-	return &notificationService{db: db, logger: logger}
+func NewNotificationService(
+	cfg *config.Config,
+	templates TemplateService,
+	logRepo repository.LogRepository,
+	senders *sender.Registry,
+	preferences PreferenceService,
+	inbox InboxService,
+	identityClient identityv1.IdentityService,
+	microAuth auth.Auth,
+	logger *zap.Logger,
+) NotificationService {
+	return &notificationService{
+		templates:      templates,
+		logRepo:        logRepo,
+		senders:        senders,
+		preferences:    preferences,
+		inbox:          inbox,
+		retry:          newRetryPolicy(cfg.Notification.Retry),
+		identityClient: identityClient,
+		auth:           microAuth,
+		serviceName:    cfg.Service.Name,
+		logger:         logger,
+	}
 }
 
-func (s *notificationService) SendEmail(ctx context.Context, to, subject, body string) (string, error) {
-	// 1. Log to stdout (Mock Sending)
-	s.logger.Info("Sending email",
-		zap.String("to", to),
-		zap.String("subject", subject),
-		zap.String("body", body),
-	)
+func (s *notificationService) SendEmail(ctx context.Context, userID string, category model.NotificationCategory, to, subject, body string) (*model.NotificationLog, error) {
+	log := &model.NotificationLog{
+		UserID:    lo.EmptyableToPtr(userID),
+		Type:      model.NotificationTypeEmail,
+		Category:  lo.CoalesceOrEmpty(category, model.NotificationCategoryTransactional),
+		Recipient: to,
+		Subject:   &subject,
+		Content:   body,
+		Status:    model.NotificationStatusPending,
+	}
+	return log, s.send(ctx, log)
+}
 
-	// 2. Save log to DB
-	query := `
-		INSERT INTO notification.notification_logs (type, recipient, subject, content)
-		VALUES ($1, $2, $3, $4)
-		RETURNING id
-	`
-
-	var id string
-	err := s.db.QueryRow(ctx, query,
-		model.NotificationTypeEmail,
-		to,
-		subject,
-		body,
-	).Scan(&id)
+func (s *notificationService) SendSMS(ctx context.Context, userID string, category model.NotificationCategory, phone, message string) (*model.NotificationLog, error) {
+	log := &model.NotificationLog{
+		UserID:    lo.EmptyableToPtr(userID),
+		Type:      model.NotificationTypeSMS,
+		Category:  lo.CoalesceOrEmpty(category, model.NotificationCategoryTransactional),
+		Recipient: phone,
+		Content:   message,
+		Status:    model.NotificationStatusPending,
+	}
+	return log, s.send(ctx, log)
+}
 
+func (s *notificationService) SendTemplated(ctx context.Context, msg *model.TemplatedMessage) (*model.NotificationLog, *render.Message, error) {
+	if msg.Type == model.NotificationTypeInApp {
+		return nil, nil, fmt.Errorf("%w: in-app messages are only created from events", ErrChannelMismatch)
+	}
+
+	recipient := msg.Recipient
+	if recipient == "" {
+		user, err := s.lookupUser(ctx, msg.UserID)
+		if err != nil {
+			return nil, nil, err
+		}
+		recipient = userAddress(user, msg.Type)
+		if recipient == "" {
+			return nil, nil, ErrNoRecipient
+		}
+	}
+
+	tmpl, rendered, err := s.templates.RenderTemplate(ctx, msg.TemplateName, msg.Locale, msg.Variables)
 	if err != nil {
-		return "", fmt.Errorf("failed to save notification log: %w", err)
+		return nil, nil, err
+	}
+	if tmpl.Type != msg.Type {
+		return nil, nil, ErrChannelMismatch
 	}
 
-	return id, nil
+	log := &model.NotificationLog{
+		TemplateID: &tmpl.ID,
+		UserID:     lo.EmptyableToPtr(msg.UserID),
+		Type:       tmpl.Type,
+		Category:   tmpl.Category,
+		Recipient:  recipient,
+		Subject:    lo.EmptyableToPtr(rendered.Subject),
+		Content:    rendered.Body,
+		HTML:       tmpl.HTML,
+		Status:     model.NotificationStatusPending,
+	}
+	return log, rendered, s.send(ctx, log)
 }
 
-func (s *notificationService) SendSMS(ctx context.Context, phone, message string) (string, error) {
-	// 1. Log to stdout (Mock Sending)
-	s.logger.Info("Sending SMS",
-		zap.String("phone", phone),
-		zap.String("message", message),
-	)
+func (s *notificationService) GetNotification(ctx context.Context, id string) (*model.NotificationLog, error) {
+	log, err := s.logRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if log == nil {
+		return nil, ErrNotificationNotFound
+	}
+	return log, nil
+}
 
-	// 2. Save log to DB
-	query := `
-		INSERT INTO notification.notification_logs (type, recipient, content)
-		VALUES ($1, $2, $3)
-		RETURNING id
-	`
+func (s *notificationService) ListNotifications(ctx context.Context, filter model.NotificationFilter, page, pageSize int) ([]*model.NotificationLog, int64, error) {
+	return s.logRepo.List(ctx, filter, page, pageSize)
+}
 
-	var id string
-	err := s.db.QueryRow(ctx, query,
-		model.NotificationTypeSMS,
-		phone,
-		message,
-	).Scan(&id)
+// send records a pending delivery and dispatches it. A failed delivery stays recorded, is queued for
+// retry and is reported as ErrSendFailed.
+func (s *notificationService) send(ctx context.Context, log *model.NotificationLog) error {
+	if _, createErr := s.createLog(ctx, log); createErr != nil {
+		return fmt.Errorf("failed to record notification: %w", createErr)
+	}
 
+	if err := s.dispatch(ctx, log); err != nil {
+		return err
+	}
+	if log.Status == model.NotificationStatusFailed || log.Status == model.NotificationStatusDead {
+		return fmt.Errorf("%w: %s", ErrSendFailed, *log.ErrorMessage)
+	}
+	return nil
+}
+
+// createLog records a pending delivery leased to this process. If the process dies before storing the
+// outcome, the retry worker picks the delivery up once the lease lapses.
+func (s *notificationService) createLog(ctx context.Context, log *model.NotificationLog) (bool, error) {
+	log.NextAttemptAt = lo.ToPtr(time.Now().Add(deliveryLease))
+	return s.logRepo.Create(ctx, log)
+}
+
+// lookupUser fetches the profile of a recipient from the identity service.
+func (s *notificationService) lookupUser(ctx context.Context, userID string) (*identityv1.UserProfile, error) {
+	callCtx, err := middleware.ServiceContext(ctx, s.auth, s.serviceName)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.identityClient.GetProfile(callCtx, &identityv1.GetProfileRequest{UserId: userID})
 	if err != nil {
-		return "", fmt.Errorf("failed to save notification log: %w", err)
+		if microerrors.FromError(err).Code == http.StatusNotFound {
+			return nil, ErrRecipientNotFound
+		}
+		return nil, fmt.Errorf("failed to look up recipient: %w", err)
 	}
+	return resp.User, nil
+}
+
+// userAddress returns the user's address for the channel, or "" if the user has none.
+func userAddress(user *identityv1.UserProfile, typ model.NotificationType) string {
+	if typ == model.NotificationTypeSMS {
+		return user.Phone
+	}
+	return user.Email
+}
 
-	return id, nil
+// dispatch sends a recorded delivery and stores its outcome in log.Status. Deliveries the user's
+// preferences hold back are suppressed or deferred instead. Only failing to store the outcome is
+// returned as an error.
+func (s *notificationService) dispatch(ctx context.Context, log *model.NotificationLog) error {
+	held, err := s.holdForPreferences(ctx, log)
+	if err != nil {
+		return err
+	}
+	if !held {
+		s.recordOutcome(log, s.deliver(ctx, &sender.Message{
+			Type:    log.Type,
+			To:      log.Recipient,
+			Subject: lo.FromPtr(log.Subject),
+			Body:    log.Content,
+			HTML:    log.HTML,
+		}))
+	}
+
+	if err := s.logRepo.UpdateStatus(ctx, log); err != nil {
+		return fmt.Errorf("failed to update notification status: %w", err)
+	}
+	return nil
+}
+
+// holdForPreferences checks a non-transactional delivery against its user's preferences. It reports true,
+// having updated log, if the user opted out (suppressed) or it is their quiet hours (deferred: left pending
+// until they end, when the retry worker picks it up).
+func (s *notificationService) holdForPreferences(ctx context.Context, log *model.NotificationLog) (bool, error) {
+	if log.Category == model.NotificationCategoryTransactional || log.UserID == nil {
+		return false, nil
+	}
+
+	prefs, err := s.preferences.GetPreferences(ctx, *log.UserID)
+	if err != nil {
+		return false, err
+	}
+
+	if !prefs.Allows(log.Type, log.Category) {
+		message := fmt.Sprintf("user opted out of %s %s messages", log.Category, log.Type)
+		log.Status = model.NotificationStatusSuppressed
+		log.ErrorMessage = &message
+		log.NextAttemptAt = nil
+		s.logger.Info("Notification suppressed by user preferences", zap.String("notification_id", log.ID))
+		return true, nil
+	}
+
+	until, quiet, err := prefs.QuietUntil(time.Now())
+	if err != nil {
+		// Preferences are validated when saved, so this only happens for rows edited by hand.
+		s.logger.Warn("ignoring invalid quiet hours", zap.String("user_id", prefs.UserID), zap.Error(err))
+		return false, nil
+	}
+	if quiet {
+		log.Status = model.NotificationStatusPending
+		log.NextAttemptAt = &until
+		s.logger.Info("Notification deferred until quiet hours end",
+			zap.String("notification_id", log.ID),
+			zap.Time("next_attempt_at", until),
+		)
+		return true, nil
+	}
+	return false, nil
+}
+
+// recordOutcome counts the attempt and updates the delivery with its outcome: sent, failed and due for a
+// retry after backoff, or dead once the attempts run out.
+func (s *notificationService) recordOutcome(log *model.NotificationLog, sendErr error) {
+	log.Attempts++
+	if sendErr == nil {
+		now := time.Now()
+		log.Status = model.NotificationStatusSent
+		log.SentAt = &now
+		log.NextAttemptAt = nil
+		log.ErrorMessage = nil
+		return
+	}
+
+	message := sendErr.Error()
+	log.ErrorMessage = &message
+	if log.Attempts >= s.retry.maxAttempts {
+		s.deadLetter(log, sendErr)
+		return
+	}
+
+	log.Status = model.NotificationStatusFailed
+	log.NextAttemptAt = lo.ToPtr(time.Now().Add(s.retry.backoff(log.Attempts)))
+	s.logger.Warn("failed to send notification, will retry",
+		zap.String("notification_id", log.ID),
+		zap.String("type", string(log.Type)),
+		zap.Int("attempts", log.Attempts),
+		zap.Time("next_attempt_at", *log.NextAttemptAt),
+		zap.Error(sendErr),
+	)
+}
+
+// deadLetter gives up on a delivery; it is only sent again through RetryNotification.
+func (s *notificationService) deadLetter(log *model.NotificationLog, err error) {
+	message := err.Error()
+	log.Status = model.NotificationStatusDead
+	log.ErrorMessage = &message
+	log.NextAttemptAt = nil
+	s.logger.Error("notification dead-lettered",
+		zap.String("notification_id", log.ID),
+		zap.String("type", string(log.Type)),
+		zap.Int("attempts", log.Attempts),
+		zap.Error(err),
+	)
+}
+
+// deliver hands a rendered message to the provider configured for its channel.
+func (s *notificationService) deliver(ctx context.Context, msg *sender.Message) error {
+	providerID, err := s.senders.Send(ctx, msg)
+	if err != nil {
+		return err
+	}
+
+	s.logger.Info("Notification delivered to provider",
+		zap.String("type", string(msg.Type)),
+		zap.String("provider_message_id", providerID),
+	)
+	return nil
 }
@@ -0,0 +1,156 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+
+	bookingv1 "github.com/wylu1037/go-micro-boilerplate/gen/go/booking/v1"
+	identityv1 "github.com/wylu1037/go-micro-boilerplate/gen/go/identity/v1"
+	"github.com/wylu1037/go-micro-boilerplate/services/notification/internal/model"
+	"github.com/wylu1037/go-micro-boilerplate/services/notification/internal/render"
+)
+
+// orderEventTemplates maps booking event types to the templates sent for them. A template is skipped when
+// the user has no address for its channel, e.g. SMS templates for users without a phone number.
+var orderEventTemplates = map[string][]string{
+	"ticketing.booking.order.created":      {"order_confirmation", "order_confirmation_sms"},
+	"ticketing.booking.order.paid":         {"payment_success", "payment_success_sms", "payment_success_inbox"},
+	"ticketing.booking.order.cancelled":    {"order_cancelled", "order_cancelled_inbox"},
+	"ticketing.booking.order.refunded":     {"order_refunded"},
+	"ticketing.booking.order.show_changed": {"show_changed", "show_changed_inbox"},
+}
+
+// OrderEventTopics returns the booking event topics the notification service subscribes to.
+func OrderEventTopics() []string {
+	topics := make([]string, 0, len(orderEventTemplates))
+	for topic := range orderEventTemplates {
+		topics = append(topics, topic)
+	}
+	return topics
+}
+
+func (s *notificationService) HandleOrderEvent(ctx context.Context, event *bookingv1.OrderEvent) error {
+	names := orderEventTemplates[event.EventType]
+	if len(names) == 0 {
+		s.logger.Debug("No templates for order event", zap.String("event_type", event.EventType))
+		return nil
+	}
+
+	user, err := s.lookupUser(ctx, event.UserId)
+	if errors.Is(err, ErrRecipientNotFound) {
+		s.logger.Warn("Recipient of order event not found",
+			zap.String("event_id", event.EventId),
+			zap.String("user_id", event.UserId),
+		)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	vars := map[string]string{
+		"OrderNo":     event.OrderNo,
+		"UserName":    user.Name,
+		"TotalAmount": event.TotalAmount,
+		"Quantity":    strconv.Itoa(int(event.Quantity)),
+		"Status":      event.Status,
+		"Reason":      event.Reason,
+		"ExpireTime":  "",
+	}
+	if event.ExpiresAt != nil {
+		vars["ExpireTime"] = event.ExpiresAt.AsTime().Local().Format("2006-01-02 15:04")
+	}
+
+	for _, name := range names {
+		if deliverErr := s.deliverOrderEvent(ctx, event, name, user, vars); deliverErr != nil {
+			return deliverErr
+		}
+	}
+	return nil
+}
+
+// deliverOrderEvent renders and sends one template for an event. Render and send failures are recorded on
+// the delivery rather than returned; only storage errors are, so the broker redelivers the event.
+func (s *notificationService) deliverOrderEvent(ctx context.Context, event *bookingv1.OrderEvent, name string, user *identityv1.UserProfile, vars map[string]string) error {
+	tmpl, rendered, err := s.templates.RenderTemplate(ctx, name, model.DefaultLocale, vars)
+	if errors.Is(err, ErrTemplateNotFound) {
+		s.logger.Warn("Notification template not found", zap.String("template", name))
+		return nil
+	}
+	if tmpl == nil {
+		return err
+	}
+	if tmpl.Type == model.NotificationTypeInApp {
+		return s.deliverToInbox(ctx, event, tmpl, rendered, err)
+	}
+
+	recipient := userAddress(user, tmpl.Type)
+	if recipient == "" {
+		return nil
+	}
+
+	log := &model.NotificationLog{
+		TemplateID: &tmpl.ID,
+		UserID:     &event.UserId,
+		Type:       tmpl.Type,
+		Category:   tmpl.Category,
+		Recipient:  recipient,
+		HTML:       tmpl.HTML,
+		Status:     model.NotificationStatusPending,
+		EventID:    &event.EventId,
+	}
+	if rendered != nil {
+		log.Subject = lo.EmptyableToPtr(rendered.Subject)
+		log.Content = rendered.Body
+	}
+
+	created, createErr := s.createLog(ctx, log)
+	if createErr != nil {
+		return fmt.Errorf("failed to record notification: %w", createErr)
+	}
+	if !created {
+		s.logger.Info("Duplicate order event ignored", zap.String("event_id", event.EventId), zap.String("template", name))
+		return nil
+	}
+
+	if err != nil {
+		// The template does not render with the event's variables and retrying will not change that.
+		s.deadLetter(log, err)
+		return s.logRepo.UpdateStatus(ctx, log)
+	}
+
+	return s.dispatch(ctx, log)
+}
+
+// deliverToInbox puts a rendered in-app template in the order owner's inbox. A template that does not render
+// is logged and skipped, as there is no delivery record to dead-letter it on.
+func (s *notificationService) deliverToInbox(ctx context.Context, event *bookingv1.OrderEvent, tmpl *model.Template, rendered *render.Message, renderErr error) error {
+	if renderErr != nil {
+		s.logger.Error("Failed to render inbox template",
+			zap.String("event_id", event.EventId),
+			zap.String("template", tmpl.Name),
+			zap.Error(renderErr),
+		)
+		return nil
+	}
+
+	created, err := s.inbox.Deliver(ctx, &model.InboxMessage{
+		UserID:     event.UserId,
+		TemplateID: &tmpl.ID,
+		Title:      rendered.Subject,
+		Body:       rendered.Body,
+		EventID:    &event.EventId,
+	})
+	if err != nil {
+		return err
+	}
+	if !created {
+		s.logger.Info("Duplicate order event ignored", zap.String("event_id", event.EventId), zap.String("template", tmpl.Name))
+	}
+	return nil
+}
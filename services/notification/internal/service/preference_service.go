@@ -0,0 +1,60 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/wylu1037/go-micro-boilerplate/services/notification/internal/model"
+	"github.com/wylu1037/go-micro-boilerplate/services/notification/internal/repository"
+)
+
+var ErrInvalidPreferences = errors.New("invalid notification preferences")
+
+type PreferenceService interface {
+	// GetPreferences returns the user's preferences, or the defaults if the user never saved any.
+	GetPreferences(ctx context.Context, userID string) (*model.Preferences, error)
+	UpdatePreferences(ctx context.Context, prefs *model.Preferences) error
+}
+
+type preferenceService struct {
+	repo   repository.PreferenceRepository
+	logger *zap.Logger
+}
+
+func NewPreferenceService(repo repository.PreferenceRepository, logger *zap.Logger) PreferenceService {
+	return &preferenceService{repo: repo, logger: logger}
+}
+
+func (s *preferenceService) GetPreferences(ctx context.Context, userID string) (*model.Preferences, error) {
+	prefs, err := s.repo.Get(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load preferences: %w", err)
+	}
+	if prefs == nil {
+		return model.DefaultPreferences(userID), nil
+	}
+	return prefs, nil
+}
+
+func (s *preferenceService) UpdatePreferences(ctx context.Context, prefs *model.Preferences) error {
+	if _, err := time.LoadLocation(prefs.Timezone); err != nil || prefs.Timezone == "" {
+		return fmt.Errorf("%w: unknown timezone %q", ErrInvalidPreferences, prefs.Timezone)
+	}
+	if _, err := model.ParseClock(prefs.QuietHoursStart); err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidPreferences, err)
+	}
+	if _, err := model.ParseClock(prefs.QuietHoursEnd); err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidPreferences, err)
+	}
+
+	if err := s.repo.Upsert(ctx, prefs); err != nil {
+		return fmt.Errorf("failed to save preferences: %w", err)
+	}
+
+	s.logger.Info("Notification preferences updated", zap.String("user_id", prefs.UserID))
+	return nil
+}
@@ -0,0 +1,96 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"math/rand/v2"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/wylu1037/go-micro-boilerplate/pkg/config"
+	"github.com/wylu1037/go-micro-boilerplate/services/notification/internal/model"
+)
+
+// deliveryLease is how long a delivery being sent stays claimed. It outlasts the provider timeouts, so
+// a delivery is only picked up again when the process sending it died.
+const deliveryLease = 2 * time.Minute
+
+const (
+	defaultMaxAttempts = 5
+	defaultBaseDelay   = 30 * time.Second
+	defaultMaxDelay    = 1 * time.Hour
+)
+
+// retryPolicy spaces out attempts at a failed delivery with exponential backoff and jitter.
+type retryPolicy struct {
+	maxAttempts int
+	baseDelay   time.Duration
+	maxDelay    time.Duration
+}
+
+func newRetryPolicy(cfg config.RetryConfig) retryPolicy {
+	p := retryPolicy{
+		maxAttempts: cfg.MaxAttempts,
+		baseDelay:   cfg.BaseDelay,
+		maxDelay:    cfg.MaxDelay,
+	}
+	if p.maxAttempts <= 0 {
+		p.maxAttempts = defaultMaxAttempts
+	}
+	if p.baseDelay <= 0 {
+		p.baseDelay = defaultBaseDelay
+	}
+	if p.maxDelay <= 0 {
+		p.maxDelay = defaultMaxDelay
+	}
+	return p
+}
+
+// backoff returns the delay before the next attempt after attempts failed ones: baseDelay doubled per
+// attempt up to maxDelay, of which a random half is taken off so failures that happened together (e.g.
+// during a provider outage) are not all retried at once.
+func (p retryPolicy) backoff(attempts int) time.Duration {
+	delay := p.baseDelay
+	for i := 1; i < attempts && delay < p.maxDelay; i++ {
+		delay *= 2
+	}
+	delay = min(delay, p.maxDelay)
+
+	half := delay / 2
+	return half + rand.N(half+1)
+}
+
+func (s *notificationService) RetryNotification(ctx context.Context, id string) (*model.NotificationLog, error) {
+	existing, err := s.GetNotification(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if existing.Content == "" {
+		// The template never rendered for this delivery, so there is nothing to send.
+		return nil, fmt.Errorf("%w: the notification has no rendered content", ErrNotRetryable)
+	}
+
+	log, err := s.logRepo.Requeue(ctx, id, time.Now().Add(deliveryLease))
+	if err != nil {
+		return nil, fmt.Errorf("failed to requeue notification: %w", err)
+	}
+	if log == nil {
+		// Sent or already being sent, possibly by the retry worker since it was loaded above.
+		return nil, ErrNotRetryable
+	}
+
+	s.logger.Info("Retrying notification manually", zap.String("notification_id", log.ID))
+	if dispatchErr := s.dispatch(ctx, log); dispatchErr != nil {
+		return nil, dispatchErr
+	}
+	return log, nil
+}
+
+func (s *notificationService) RetryDue(ctx context.Context, limit int) ([]*model.NotificationLog, error) {
+	return s.logRepo.ClaimDue(ctx, limit, time.Now().Add(deliveryLease))
+}
+
+func (s *notificationService) Redeliver(ctx context.Context, log *model.NotificationLog) error {
+	return s.dispatch(ctx, log)
+}
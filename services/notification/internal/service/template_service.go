@@ -0,0 +1,190 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	"github.com/wylu1037/go-micro-boilerplate/services/notification/internal/model"
+	"github.com/wylu1037/go-micro-boilerplate/services/notification/internal/render"
+	"github.com/wylu1037/go-micro-boilerplate/services/notification/internal/repository"
+)
+
+var (
+	ErrTemplateNotFound = errors.New("template not found")
+	ErrTemplateExists   = errors.New("template already exists for this locale")
+	ErrChannelMismatch  = errors.New("template is not for this channel")
+)
+
+type TemplateService interface {
+	CreateTemplate(ctx context.Context, tmpl *model.Template) error
+	GetTemplate(ctx context.Context, id string) (*model.Template, error)
+	ListTemplates(ctx context.Context, filter model.TemplateFilter, page, pageSize int) ([]*model.Template, int64, error)
+	// UpdateTemplate replaces the template's content; an empty category keeps the current one.
+	UpdateTemplate(ctx context.Context, id string, category model.NotificationCategory, subject *string, content string, html bool) (*model.Template, error)
+	DeleteTemplate(ctx context.Context, id string) error
+	// PreviewTemplate renders the stored template name in locale, or draft when it is not nil, without
+	// sending it. Missing variables are reported in the preview instead of failing.
+	PreviewTemplate(ctx context.Context, name, locale string, draft *model.Template, vars map[string]string) (*model.TemplatePreview, error)
+	// RenderTemplate renders the variant of the named template that best matches locale.
+	RenderTemplate(ctx context.Context, name, locale string, vars map[string]string) (*model.Template, *render.Message, error)
+}
+
+type templateService struct {
+	repo   repository.TemplateRepository
+	logger *zap.Logger
+}
+
+func NewTemplateService(repo repository.TemplateRepository, logger *zap.Logger) TemplateService {
+	return &templateService{repo: repo, logger: logger}
+}
+
+func (s *templateService) CreateTemplate(ctx context.Context, tmpl *model.Template) error {
+	if tmpl.Locale == "" {
+		tmpl.Locale = model.DefaultLocale
+	}
+	if tmpl.Category == "" {
+		tmpl.Category = model.NotificationCategoryTransactional
+	}
+	if err := validateTemplate(tmpl); err != nil {
+		return err
+	}
+
+	created, err := s.repo.Create(ctx, tmpl)
+	if err != nil {
+		return fmt.Errorf("failed to create template: %w", err)
+	}
+	if !created {
+		return ErrTemplateExists
+	}
+
+	s.logger.Info("Template created",
+		zap.String("template_id", tmpl.ID),
+		zap.String("template", tmpl.Name),
+		zap.String("locale", tmpl.Locale),
+	)
+	return nil
+}
+
+func (s *templateService) GetTemplate(ctx context.Context, id string) (*model.Template, error) {
+	tmpl, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if tmpl == nil {
+		return nil, ErrTemplateNotFound
+	}
+	return tmpl, nil
+}
+
+func (s *templateService) ListTemplates(ctx context.Context, filter model.TemplateFilter, page, pageSize int) ([]*model.Template, int64, error) {
+	return s.repo.List(ctx, filter, page, pageSize)
+}
+
+func (s *templateService) UpdateTemplate(ctx context.Context, id string, category model.NotificationCategory, subject *string, content string, html bool) (*model.Template, error) {
+	tmpl, err := s.GetTemplate(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if category != "" {
+		tmpl.Category = category
+	}
+	tmpl.Subject = subject
+	tmpl.Content = content
+	tmpl.HTML = html
+	if validateErr := validateTemplate(tmpl); validateErr != nil {
+		return nil, validateErr
+	}
+
+	updated, err := s.repo.Update(ctx, tmpl)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update template: %w", err)
+	}
+	if !updated {
+		return nil, ErrTemplateNotFound
+	}
+
+	s.logger.Info("Template updated", zap.String("template_id", tmpl.ID), zap.String("template", tmpl.Name))
+	return tmpl, nil
+}
+
+func (s *templateService) DeleteTemplate(ctx context.Context, id string) error {
+	deleted, err := s.repo.Delete(ctx, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete template: %w", err)
+	}
+	if !deleted {
+		return ErrTemplateNotFound
+	}
+
+	s.logger.Info("Template deleted", zap.String("template_id", id))
+	return nil
+}
+
+func (s *templateService) PreviewTemplate(ctx context.Context, name, locale string, draft *model.Template, vars map[string]string) (*model.TemplatePreview, error) {
+	tmpl := draft
+	if tmpl == nil {
+		found, err := s.repo.Find(ctx, name, locale)
+		if err != nil {
+			return nil, err
+		}
+		if found == nil {
+			return nil, ErrTemplateNotFound
+		}
+		tmpl = found
+	}
+
+	preview := &model.TemplatePreview{Locale: tmpl.Locale}
+	msg, err := render.Render(templateSubject(tmpl), tmpl.Content, tmpl.HTML, vars)
+	var missingErr *render.MissingVariablesError
+	if errors.As(err, &missingErr) {
+		preview.MissingVariables = missingErr.Names
+		return preview, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	preview.Subject = msg.Subject
+	preview.Body = msg.Body
+	return preview, nil
+}
+
+func (s *templateService) RenderTemplate(ctx context.Context, name, locale string, vars map[string]string) (*model.Template, *render.Message, error) {
+	tmpl, err := s.repo.Find(ctx, name, locale)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to load template %s: %w", name, err)
+	}
+	if tmpl == nil {
+		return nil, nil, ErrTemplateNotFound
+	}
+
+	msg, err := render.Render(templateSubject(tmpl), tmpl.Content, tmpl.HTML, vars)
+	if err != nil {
+		return tmpl, nil, err
+	}
+	return tmpl, msg, nil
+}
+
+// validateTemplate checks that the template parses; SMS templates have no subject and in-app messages
+// are plain text.
+func validateTemplate(tmpl *model.Template) error {
+	switch tmpl.Type {
+	case model.NotificationTypeSMS:
+		tmpl.Subject = nil
+	case model.NotificationTypeInApp:
+		tmpl.HTML = false
+	}
+	_, err := render.Variables(templateSubject(tmpl), tmpl.Content)
+	return err
+}
+
+func templateSubject(tmpl *model.Template) string {
+	if tmpl.Subject == nil {
+		return ""
+	}
+	return *tmpl.Subject
+}
@@ -0,0 +1,34 @@
+package subscriber
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	bookingv1 "github.com/wylu1037/go-micro-boilerplate/gen/go/booking/v1"
+	"github.com/wylu1037/go-micro-boilerplate/services/notification/internal/service"
+)
+
+// OrderEventSubscriber consumes the order events the booking service publishes through its outbox.
+type OrderEventSubscriber struct {
+	svc    service.NotificationService
+	logger *zap.Logger
+}
+
+func NewOrderEventSubscriber(svc service.NotificationService, logger *zap.Logger) *OrderEventSubscriber {
+	return &OrderEventSubscriber{svc: svc, logger: logger}
+}
+
+// Handle is registered for every order event topic. Returning an error leaves redelivery to the broker.
+func (s *OrderEventSubscriber) Handle(ctx context.Context, event *bookingv1.OrderEvent) error {
+	if err := s.svc.HandleOrderEvent(ctx, event); err != nil {
+		s.logger.Error("failed to handle order event",
+			zap.String("event_id", event.EventId),
+			zap.String("event_type", event.EventType),
+			zap.String("booking_id", event.BookingId),
+			zap.Error(err),
+		)
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,158 @@
+package worker
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/metric"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+
+	"github.com/wylu1037/go-micro-boilerplate/pkg/config"
+	"github.com/wylu1037/go-micro-boilerplate/services/notification/internal/model"
+	"github.com/wylu1037/go-micro-boilerplate/services/notification/internal/service"
+)
+
+const (
+	defaultRetryInterval    = 10 * time.Second
+	defaultRetryBatchSize   = 100
+	defaultRetryConcurrency = 4
+)
+
+// RetryWorker periodically re-sends failed notifications whose backoff has passed, with a pool of
+// goroutines so one slow provider call does not hold up the rest of the batch. It is safe to run on
+// several replicas at once: due notifications are claimed with SELECT ... FOR UPDATE SKIP LOCKED and
+// leased, so each attempt is made by exactly one replica.
+type RetryWorker struct {
+	svc         service.NotificationService
+	batchSize   int
+	concurrency int
+	logger      *zap.Logger
+
+	sentCounter metric.Int64Counter
+	deadCounter metric.Int64Counter
+
+	task *periodicTask
+}
+
+func NewRetryWorker(
+	cfg *config.Config,
+	logger *zap.Logger,
+	svc service.NotificationService,
+) *RetryWorker {
+	meter := otel.GetMeterProvider().Meter("notification_retry")
+
+	sentCounter, _ := meter.Int64Counter(
+		"notification_retries_sent_total",
+		metric.WithDescription("Total number of failed notifications sent on a retry"),
+		metric.WithUnit("1"),
+	)
+
+	deadCounter, _ := meter.Int64Counter(
+		"notification_dead_letters_total",
+		metric.WithDescription("Total number of notifications dead-lettered after running out of attempts"),
+		metric.WithUnit("1"),
+	)
+
+	retryCfg := cfg.Notification.Retry
+	interval := retryCfg.Interval
+	if interval <= 0 {
+		interval = defaultRetryInterval
+	}
+	batchSize := retryCfg.BatchSize
+	if batchSize <= 0 {
+		batchSize = defaultRetryBatchSize
+	}
+	concurrency := retryCfg.Concurrency
+	if concurrency <= 0 {
+		concurrency = defaultRetryConcurrency
+	}
+
+	w := &RetryWorker{
+		svc:         svc,
+		batchSize:   batchSize,
+		concurrency: concurrency,
+		logger:      logger,
+		sentCounter: sentCounter,
+		deadCounter: deadCounter,
+	}
+	w.task = &periodicTask{
+		name:     "notification retry worker",
+		interval: interval,
+		fn:       w.runOnce,
+		logger:   logger,
+	}
+	return w
+}
+
+// RunRetryWorker ties the worker to the application lifecycle.
+func RunRetryWorker(lc fx.Lifecycle, w *RetryWorker) {
+	lc.Append(fx.Hook{
+		OnStart: func(_ context.Context) error {
+			w.task.start()
+			return nil
+		},
+		OnStop: func(ctx context.Context) error {
+			return w.task.stop(ctx)
+		},
+	})
+}
+
+// runOnce drains due notifications in batches until a claim comes back short.
+func (w *RetryWorker) runOnce(ctx context.Context) {
+	for ctx.Err() == nil {
+		logs, err := w.svc.RetryDue(ctx, w.batchSize)
+		if err != nil {
+			w.logger.Error("failed to claim notifications for retry", zap.Error(err))
+			return
+		}
+
+		w.redeliver(ctx, logs)
+
+		if len(logs) < w.batchSize {
+			return
+		}
+	}
+}
+
+// redeliver sends the claimed notifications with up to concurrency goroutines and waits for all of them.
+func (w *RetryWorker) redeliver(ctx context.Context, logs []*model.NotificationLog) {
+	jobs := make(chan *model.NotificationLog)
+
+	var wg sync.WaitGroup
+	for range min(w.concurrency, len(logs)) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for log := range jobs {
+				w.redeliverOne(ctx, log)
+			}
+		}()
+	}
+
+	for _, log := range logs {
+		jobs <- log
+	}
+	close(jobs)
+	wg.Wait()
+}
+
+func (w *RetryWorker) redeliverOne(ctx context.Context, log *model.NotificationLog) {
+	if err := w.svc.Redeliver(ctx, log); err != nil {
+		// The lease lapses and the notification is claimed again on a later scan.
+		w.logger.Error("failed to store notification retry outcome",
+			zap.String("notification_id", log.ID),
+			zap.Error(err),
+		)
+		return
+	}
+
+	switch log.Status {
+	case model.NotificationStatusSent:
+		w.sentCounter.Add(ctx, 1)
+	case model.NotificationStatusDead:
+		w.deadCounter.Add(ctx, 1)
+	}
+}